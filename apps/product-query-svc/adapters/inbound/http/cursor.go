@@ -0,0 +1,99 @@
+package httpadapter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// Cursors are opaque to clients: base64url-encoded JSON of the last item's sort key.
+
+type productCursorToken struct {
+	Sort  domain.ProductSortField `json:"s"`
+	Order domain.SortOrder        `json:"o"`
+	ID    int64                   `json:"id"`
+	Name  string                  `json:"n,omitempty"`
+	Price int64                   `json:"p,omitempty"`
+	Score float64                 `json:"r,omitempty"`
+}
+
+type userCursorToken struct {
+	Sort      domain.UserSortField `json:"s"`
+	Order     domain.SortOrder     `json:"o"`
+	ID        int64                `json:"id"`
+	Name      string               `json:"n,omitempty"`
+	Email     string               `json:"e,omitempty"`
+	CreatedAt time.Time            `json:"t"`
+}
+
+type commentCursorToken struct {
+	CreatedAt time.Time `json:"t"`
+	ID        int64     `json:"id"`
+}
+
+func encodeProductCursor(c *domain.ProductCursor) *string {
+	if c == nil {
+		return nil
+	}
+	return encodeCursor(productCursorToken{Sort: c.SortBy, Order: c.Order, ID: c.ID, Name: c.Name, Price: c.Price, Score: c.Score})
+}
+
+func decodeProductCursor(raw string) (*domain.ProductCursor, error) {
+	var t productCursorToken
+	if err := decodeCursor(raw, &t); err != nil {
+		return nil, err
+	}
+	return &domain.ProductCursor{SortBy: t.Sort, Order: t.Order, ID: t.ID, Name: t.Name, Price: t.Price, Score: t.Score}, nil
+}
+
+func encodeUserCursor(c *domain.UserCursor) *string {
+	if c == nil {
+		return nil
+	}
+	return encodeCursor(userCursorToken{Sort: c.SortBy, Order: c.Order, ID: c.ID, Name: c.Name, Email: c.Email, CreatedAt: c.CreatedAt})
+}
+
+func decodeUserCursor(raw string) (*domain.UserCursor, error) {
+	var t userCursorToken
+	if err := decodeCursor(raw, &t); err != nil {
+		return nil, err
+	}
+	return &domain.UserCursor{SortBy: t.Sort, Order: t.Order, ID: t.ID, Name: t.Name, Email: t.Email, CreatedAt: t.CreatedAt}, nil
+}
+
+func encodeCommentCursor(c *domain.CommentCursor) *string {
+	if c == nil {
+		return nil
+	}
+	return encodeCursor(commentCursorToken{CreatedAt: c.CreatedAt, ID: c.ID})
+}
+
+func decodeCommentCursor(raw string) (*domain.CommentCursor, error) {
+	var t commentCursorToken
+	if err := decodeCursor(raw, &t); err != nil {
+		return nil, err
+	}
+	return &domain.CommentCursor{CreatedAt: t.CreatedAt, ID: t.ID}, nil
+}
+
+func encodeCursor(token any) *string {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return nil
+	}
+	s := base64.RawURLEncoding.EncodeToString(b)
+	return &s
+}
+
+func decodeCursor(raw string, token any) error {
+	b, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return domain.ValidationError("invalid cursor")
+	}
+	if err := json.Unmarshal(b, token); err != nil {
+		return domain.ValidationError("invalid cursor")
+	}
+	return nil
+}
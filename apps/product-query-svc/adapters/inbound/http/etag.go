@@ -0,0 +1,85 @@
+package httpadapter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// ETags are strong and carry the resource version in quotes, e.g. "3". Representations that
+// do not follow from the version alone (converted prices, search pages) use a content hash.
+
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// contentETag hashes the JSON encoding of a response body.
+func contentETag(body any) (string, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return strconv.Quote(hex.EncodeToString(sum[:16])), nil
+}
+
+func formatHTTPDate(t time.Time) string {
+	return t.UTC().Format(http.TimeFormat)
+}
+
+// notModified evaluates the read preconditions of RFC 9110 §13.2.2: If-None-Match when sent,
+// otherwise If-Modified-Since against lastModified (a zero lastModified never matches).
+func notModified(ifNoneMatch, ifModifiedSince *string, etag string, lastModified time.Time) bool {
+	if ifNoneMatch != nil {
+		return etagListMatches(*ifNoneMatch, etag)
+	}
+	if ifModifiedSince == nil || lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(*ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	// HTTP dates have whole-second precision
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+// etagListMatches applies the weak comparison If-None-Match uses: `*` or any listed tag matches,
+// ignoring W/ prefixes.
+func etagListMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion turns an If-Match header into the version a write is conditional on.
+// A missing header or `*` yields 0 (no check); weak, listed or unknown tags can never
+// match the current version, so they fail the precondition.
+func ifMatchVersion(header *string) (int64, error) {
+	if header == nil {
+		return 0, nil
+	}
+	raw := strings.TrimSpace(*header)
+	if raw == "*" {
+		return 0, nil
+	}
+	unquoted, err := strconv.Unquote(raw)
+	if err != nil || !strings.HasPrefix(raw, `"`) {
+		return 0, domain.PreconditionFailedError("If-Match must be a single strong ETag")
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, domain.PreconditionFailedError("resource has been modified")
+	}
+	return version, nil
+}
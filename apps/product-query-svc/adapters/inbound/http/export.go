@@ -0,0 +1,140 @@
+package httpadapter
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
+)
+
+// exportCSVHeader names the columns written by exportCSVRecord.
+var exportCSVHeader = []string{"id", "name", "priceCents", "currency", "tags", "status", "publishedAt", "updatedAt", "version"}
+
+// exportProductsStream is returned by ExportProducts instead of the generated 200 responses,
+// which need the whole body up front: it runs the export while writing the response, so the
+// catalog never sits in memory.
+type exportProductsStream struct {
+	ctx      context.Context
+	products inbound.ProductUseCases
+	criteria domain.ProductSearch
+	format   ExportProductsParamsFormat
+}
+
+func (s exportProductsStream) VisitExportProductsResponse(w http.ResponseWriter) error {
+	out := &exportWriter{w: w, format: s.format}
+	err := s.products.Export(s.ctx, s.criteria, func(p *domain.Product) error {
+		return out.write(presentProduct(p))
+	})
+	if err == nil {
+		return out.finish()
+	}
+	if !out.started {
+		if resp, handled := exportProductsError(err); handled {
+			return resp.VisitExportProductsResponse(w)
+		}
+		return err
+	}
+	// The status line is gone; abort the connection so the client sees a truncated dump
+	// rather than a complete-looking one with an error appended.
+	panic(http.ErrAbortHandler)
+}
+
+// exportWriter encodes presented products one at a time. Nothing is sent before the first
+// product, or the end of an empty export, so earlier failures still get an error status.
+type exportWriter struct {
+	w       http.ResponseWriter
+	format  ExportProductsParamsFormat
+	started bool
+	count   int
+	csv     *csv.Writer
+	ndjson  *json.Encoder
+}
+
+func (e *exportWriter) start() error {
+	e.started = true
+	switch e.format {
+	case ExportProductsParamsFormatCsv:
+		e.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		e.w.WriteHeader(http.StatusOK)
+		e.csv = csv.NewWriter(e.w)
+		return e.csv.Write(exportCSVHeader)
+	case ExportProductsParamsFormatJson:
+		e.w.Header().Set("Content-Type", "application/json")
+		e.w.WriteHeader(http.StatusOK)
+		_, err := io.WriteString(e.w, "[")
+		return err
+	default:
+		e.w.Header().Set("Content-Type", "application/x-ndjson")
+		e.w.WriteHeader(http.StatusOK)
+		e.ndjson = json.NewEncoder(e.w)
+		return nil
+	}
+}
+
+func (e *exportWriter) write(p Product) error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	e.count++
+	switch e.format {
+	case ExportProductsParamsFormatCsv:
+		return e.csv.Write(exportCSVRecord(p))
+	case ExportProductsParamsFormatJson:
+		raw, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		if e.count > 1 {
+			raw = append([]byte(","), raw...)
+		}
+		_, err = e.w.Write(raw)
+		return err
+	default:
+		return e.ndjson.Encode(p)
+	}
+}
+
+func (e *exportWriter) finish() error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	switch e.format {
+	case ExportProductsParamsFormatCsv:
+		e.csv.Flush()
+		return e.csv.Error()
+	case ExportProductsParamsFormatJson:
+		_, err := io.WriteString(e.w, "]\n")
+		return err
+	default:
+		return nil
+	}
+}
+
+func exportCSVRecord(p Product) []string {
+	publishedAt := ""
+	if p.PublishedAt != nil {
+		publishedAt = p.PublishedAt.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		strconv.FormatInt(p.Id, 10),
+		p.Name,
+		strconv.FormatInt(p.PriceCents, 10),
+		p.Currency,
+		strings.Join(p.Tags, "|"),
+		string(p.Status),
+		publishedAt,
+		p.UpdatedAt.UTC().Format(time.RFC3339),
+		strconv.FormatInt(p.Version, 10),
+	}
+}
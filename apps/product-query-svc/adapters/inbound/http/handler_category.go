@@ -0,0 +1,84 @@
+package httpadapter
+
+import "context"
+
+func (s *Server) ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error) {
+	categories, err := s.categories.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return okListCategories(categories), nil
+}
+
+func (s *Server) CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error) {
+	name, parentID, err := categoryInput(request.Body)
+	if err != nil {
+		if resp, handled := createCategoryError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	category, err := s.categories.Create(ctx, name, parentID)
+	if err != nil {
+		if resp, handled := createCategoryError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okCreateCategory(category), nil
+}
+
+func (s *Server) GetCategory(ctx context.Context, request GetCategoryRequestObject) (GetCategoryResponseObject, error) {
+	category, err := s.categories.FetchByID(ctx, request.Id)
+	if err != nil {
+		if resp, handled := getCategoryError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okGetCategory(category), nil
+}
+
+func (s *Server) UpdateCategory(ctx context.Context, request UpdateCategoryRequestObject) (UpdateCategoryResponseObject, error) {
+	name, parentID, err := categoryUpdateInput(request.Body)
+	if err != nil {
+		if resp, handled := updateCategoryError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		if resp, handled := updateCategoryError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	updated, err := s.categories.Update(ctx, request.Id, name, parentID, version)
+	if err != nil {
+		if resp, handled := updateCategoryError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okUpdateCategory(updated), nil
+}
+
+func (s *Server) DeleteCategory(ctx context.Context, request DeleteCategoryRequestObject) (DeleteCategoryResponseObject, error) {
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err == nil {
+		err = s.categories.Delete(ctx, request.Id, version)
+	}
+	if err != nil {
+		if resp, handled := deleteCategoryError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okDeleteCategory(), nil
+}
@@ -7,7 +7,15 @@ import (
 )
 
 func (s *Server) ListProductComments(ctx context.Context, request ListProductCommentsRequestObject) (ListProductCommentsResponseObject, error) {
-	comments, err := s.comments.ListByProduct(ctx, request.ProductId)
+	page, err := commentPageInput(request.Params)
+	if err != nil {
+		if resp, handled := listCommentsError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	comments, err := s.comments.ListByProduct(ctx, request.ProductId, page)
 	if err != nil {
 		if resp, handled := listCommentsError(err); handled {
 			return resp, nil
@@ -53,7 +61,15 @@ func (s *Server) UpdateProductComment(ctx context.Context, request UpdateProduct
 		return nil, domain.ValidationError("user id mismatch")
 	}
 
-	updated, err := s.comments.Update(ctx, request.ProductId, request.CommentId, userID, content)
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		if resp, handled := updateCommentError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	updated, err := s.comments.Update(ctx, request.ProductId, request.CommentId, userID, content, version)
 	if err != nil {
 		if resp, handled := updateCommentError(err); handled {
 			return resp, nil
@@ -65,7 +81,11 @@ func (s *Server) UpdateProductComment(ctx context.Context, request UpdateProduct
 }
 
 func (s *Server) DeleteProductComment(ctx context.Context, request DeleteProductCommentRequestObject) (DeleteProductCommentResponseObject, error) {
-	if err := s.comments.Delete(ctx, request.ProductId, request.CommentId, request.Params.UserId); err != nil {
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err == nil {
+		err = s.comments.Delete(ctx, request.ProductId, request.CommentId, request.Params.UserId, version)
+	}
+	if err != nil {
 		if resp, handled := deleteCommentError(err); handled {
 			return resp, nil
 		}
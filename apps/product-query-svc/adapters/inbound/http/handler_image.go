@@ -0,0 +1,126 @@
+package httpadapter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+func (s *Server) ListProductImages(ctx context.Context, request ListProductImagesRequestObject) (ListProductImagesResponseObject, error) {
+	images, err := s.images.List(ctx, request.Id)
+	if err != nil {
+		if resp, handled := listImagesError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okListImages(images), nil
+}
+
+func (s *Server) UploadProductImage(ctx context.Context, request UploadProductImageRequestObject) (UploadProductImageResponseObject, error) {
+	in, err := imageUploadInput(request.Body)
+	if err != nil {
+		if resp, handled := uploadImageError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	image, err := s.images.Upload(ctx, request.Id, in.contentType, in.altText, in.data)
+	if err != nil {
+		if resp, handled := uploadImageError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okUploadImage(image), nil
+}
+
+func (s *Server) GetProductImage(ctx context.Context, request GetProductImageRequestObject) (GetProductImageResponseObject, error) {
+	image, err := s.images.FetchByID(ctx, request.Id, request.ImageId)
+	if err != nil {
+		if resp, handled := getImageError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okGetImage(image), nil
+}
+
+func (s *Server) UpdateProductImage(ctx context.Context, request UpdateProductImageRequestObject) (UpdateProductImageResponseObject, error) {
+	altText, position, err := imageUpdateInput(request.Body)
+	if err != nil {
+		if resp, handled := updateImageError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	image, err := s.images.Update(ctx, request.Id, request.ImageId, altText, position)
+	if err != nil {
+		if resp, handled := updateImageError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okUpdateImage(image), nil
+}
+
+func (s *Server) DeleteProductImage(ctx context.Context, request DeleteProductImageRequestObject) (DeleteProductImageResponseObject, error) {
+	if err := s.images.Delete(ctx, request.Id, request.ImageId); err != nil {
+		if resp, handled := deleteImageError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okDeleteImage(), nil
+}
+
+func (s *Server) GetProductImageContent(ctx context.Context, request GetProductImageContentRequestObject) (GetProductImageContentResponseObject, error) {
+	image, content, err := s.images.Open(ctx, request.Id, request.ImageId)
+	if err != nil {
+		if resp, handled := getImageContentError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return imageContentStream{image: image, content: content, params: request.Params}, nil
+}
+
+// imageContentStream is returned by GetProductImageContent instead of the generated 200/206
+// responses: http.ServeContent answers the Range, If-Range and If-None-Match headers from the
+// seekable content, with the checksum as the ETag.
+type imageContentStream struct {
+	image   *domain.ProductImage
+	content io.ReadSeekCloser
+	params  GetProductImageContentParams
+}
+
+func (s imageContentStream) VisitGetProductImageContentResponse(w http.ResponseWriter) error {
+	defer s.content.Close()
+
+	// ServeContent reads the preconditions from a request; only the ones the operation
+	// declares are passed on.
+	req := &http.Request{Method: http.MethodGet, Header: make(http.Header)}
+	for name, value := range map[string]*string{
+		"Range":         s.params.Range,
+		"If-Range":      s.params.IfRange,
+		"If-None-Match": s.params.IfNoneMatch,
+	} {
+		if value != nil {
+			req.Header.Set(name, *value)
+		}
+	}
+
+	w.Header().Set("Content-Type", s.image.ContentType)
+	w.Header().Set("ETag", strconv.Quote(s.image.Checksum))
+	// the content never changes once uploaded, so the ETag alone validates it
+	http.ServeContent(w, req, "", time.Time{}, s.content)
+	return nil
+}
@@ -0,0 +1,87 @@
+package httpadapter
+
+import "context"
+
+func (s *Server) GetProductStock(ctx context.Context, request GetProductStockRequestObject) (GetProductStockResponseObject, error) {
+	levels, err := s.inventory.Stock(ctx, request.Id)
+	if err != nil {
+		if resp, handled := getStockError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okGetStock(levels), nil
+}
+
+func (s *Server) AdjustProductStock(ctx context.Context, request AdjustProductStockRequestObject) (AdjustProductStockResponseObject, error) {
+	variantID, delta, err := stockAdjustmentInput(request.Body)
+	if err != nil {
+		if resp, handled := adjustStockError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	level, err := s.inventory.AdjustStock(ctx, request.Id, variantID, delta)
+	if err != nil {
+		if resp, handled := adjustStockError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okAdjustStock(level), nil
+}
+
+func (s *Server) CreateReservation(ctx context.Context, request CreateReservationRequestObject) (CreateReservationResponseObject, error) {
+	productID, variantID, quantity, err := reservationCreateInput(request.Body)
+	if err != nil {
+		if resp, handled := createReservationError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	reservation, err := s.inventory.Reserve(ctx, productID, variantID, quantity)
+	if err != nil {
+		if resp, handled := createReservationError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okCreateReservation(reservation), nil
+}
+
+func (s *Server) GetReservation(ctx context.Context, request GetReservationRequestObject) (GetReservationResponseObject, error) {
+	reservation, err := s.inventory.FetchReservation(ctx, request.Id)
+	if err != nil {
+		if resp, handled := getReservationError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okGetReservation(reservation), nil
+}
+
+func (s *Server) CommitReservation(ctx context.Context, request CommitReservationRequestObject) (CommitReservationResponseObject, error) {
+	reservation, err := s.inventory.CommitReservation(ctx, request.Id)
+	if err != nil {
+		if resp, handled := commitReservationError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okCommitReservation(reservation), nil
+}
+
+func (s *Server) ReleaseReservation(ctx context.Context, request ReleaseReservationRequestObject) (ReleaseReservationResponseObject, error) {
+	reservation, err := s.inventory.ReleaseReservation(ctx, request.Id)
+	if err != nil {
+		if resp, handled := releaseReservationError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okReleaseReservation(reservation), nil
+}
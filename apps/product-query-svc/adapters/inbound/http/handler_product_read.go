@@ -1,9 +1,15 @@
 package httpadapter
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
 
 func (s *Server) GetProductByID(ctx context.Context, request GetProductByIDRequestObject) (GetProductByIDResponseObject, error) {
-	product, err := s.products.FetchByID(ctx, request.Id)
+	currency := productCurrencyInput(request.Params)
+	product, err := s.products.FetchByID(ctx, request.Id, currency, localesInput(request.Params.AcceptLanguage))
 	if err != nil {
 		if resp, handled := getProductError(err); handled {
 			return resp, nil
@@ -11,13 +17,70 @@ func (s *Server) GetProductByID(ctx context.Context, request GetProductByIDReque
 		return nil, err
 	}
 
-	return okGetProduct(product), nil
+	body, headers, err := s.productRead(product, currency)
+	if err != nil {
+		return nil, err
+	}
+	if notModified(request.Params.IfNoneMatch, request.Params.IfModifiedSince, headers.ETag, product.UpdatedAt) {
+		return notModifiedGetProduct(headers), nil
+	}
+	return okGetProduct(body, headers), nil
+}
+
+func (s *Server) GetProductBySlug(ctx context.Context, request GetProductBySlugRequestObject) (GetProductBySlugResponseObject, error) {
+	currency := productCurrencyInput(GetProductByIDParams(request.Params))
+	product, err := s.products.FetchBySlug(ctx, request.Slug, currency, localesInput(request.Params.AcceptLanguage))
+	if err != nil {
+		if resp, handled := getProductBySlugError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	// former slugs and other letter cases point clients and crawlers at the canonical URL
+	if product.Slug != request.Slug {
+		return redirectProductSlug(product, productSlugLocation(product.Slug, currency)), nil
+	}
+
+	body, headers, err := s.productRead(product, currency)
+	if err != nil {
+		return nil, err
+	}
+	if notModified(request.Params.IfNoneMatch, request.Params.IfModifiedSince, headers.ETag, product.UpdatedAt) {
+		return notModifiedGetProductBySlug(headers), nil
+	}
+	return okGetProductBySlug(body, headers), nil
+}
+
+// productRead presents a single product with the caching headers both product reads send.
+func (s *Server) productRead(product *domain.Product, currency string) (Product, GetProductByID200ResponseHeaders, error) {
+	body := presentProduct(product)
+	etag := formatETag(product.Version)
+	if currency != "" || product.Locale != "" {
+		// converted prices move with exchange rates, not only with the stored version, and each
+		// translation is a representation of its own
+		var err error
+		if etag, err = contentETag(body); err != nil {
+			return Product{}, GetProductByID200ResponseHeaders{}, err
+		}
+	}
+	return body, GetProductByID200ResponseHeaders{
+		CacheControl: s.cache.Product,
+		ETag:         etag,
+		LastModified: formatHTTPDate(product.UpdatedAt),
+		Vary:         "Accept-Language",
+	}, nil
 }
 
 func (s *Server) SearchProducts(ctx context.Context, request SearchProductsRequestObject) (SearchProductsResponseObject, error) {
-	filters := newSearchFilters(request.Params)
+	criteria, err := newSearchCriteria(request.Params)
+	if err != nil {
+		if resp, handled := searchProductsError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
 
-	items, total, err := s.products.Search(ctx, filters.query, filters.page, filters.pageSize)
+	result, err := s.products.Search(ctx, criteria)
 	if err != nil {
 		if resp, handled := searchProductsError(err); handled {
 			return resp, nil
@@ -25,5 +88,61 @@ func (s *Server) SearchProducts(ctx context.Context, request SearchProductsReque
 		return nil, err
 	}
 
-	return okSearchProducts(items, filters.page, filters.pageSize, total), nil
+	body := presentProductList(criteria, result)
+	etag, err := contentETag(body)
+	if err != nil {
+		return nil, err
+	}
+	headers := SearchProducts200ResponseHeaders{CacheControl: s.cache.Search, ETag: etag, Vary: "Accept-Language"}
+	if notModified(request.Params.IfNoneMatch, nil, etag, time.Time{}) {
+		return notModifiedSearchProducts(headers), nil
+	}
+	return okSearchProducts(body, headers), nil
+}
+
+// ExportProducts validates the filters and runs the export only once the response is written;
+// see exportProductsStream.
+func (s *Server) ExportProducts(ctx context.Context, request ExportProductsRequestObject) (ExportProductsResponseObject, error) {
+	criteria, format := newExportCriteria(request.Params)
+	return exportProductsStream{ctx: ctx, products: s.products, criteria: criteria, format: format}, nil
+}
+
+func (s *Server) SuggestProducts(ctx context.Context, request SuggestProductsRequestObject) (SuggestProductsResponseObject, error) {
+	prefix, limit := suggestInput(request.Params)
+
+	suggestions, err := s.products.Suggest(ctx, prefix, limit)
+	if err != nil {
+		if resp, handled := suggestProductsError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okSuggestProducts(suggestions), nil
+}
+
+func (s *Server) ListTrashedProducts(ctx context.Context, request ListTrashedProductsRequestObject) (ListTrashedProductsResponseObject, error) {
+	page := trashPageInput(request.Params)
+
+	list, err := s.products.Trash(ctx, page)
+	if err != nil {
+		if resp, handled := listTrashedProductsError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okListTrashedProducts(page, list), nil
+}
+
+func (s *Server) ListProductPriceHistory(ctx context.Context, request ListProductPriceHistoryRequestObject) (ListProductPriceHistoryResponseObject, error) {
+	history, err := s.products.PriceHistory(ctx, request.Id)
+	if err != nil {
+		if resp, handled := listPriceHistoryError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okListPriceHistory(history), nil
 }
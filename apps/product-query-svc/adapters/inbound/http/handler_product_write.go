@@ -24,8 +24,31 @@ func (s *Server) CreateProduct(ctx context.Context, request CreateProductRequest
 	return okCreateProduct(product), nil
 }
 
+func (s *Server) ImportProducts(ctx context.Context, request ImportProductsRequestObject) (ImportProductsResponseObject, error) {
+	opts, err := importOptionsInput(request.ContentType, request.Params)
+	if err != nil {
+		if resp, handled := importProductsError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	result, err := s.products.Import(ctx, request.Body, opts)
+	if err != nil {
+		if resp, handled := importProductsError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okImportProducts(result), nil
+}
+
 func (s *Server) UpdateProduct(ctx context.Context, request UpdateProductRequestObject) (UpdateProductResponseObject, error) {
 	product, err := newProductFromUpdateBody(request.Id, request.Body)
+	if err == nil {
+		product.Version, err = ifMatchVersion(request.Params.IfMatch)
+	}
 	if err != nil {
 		if resp, handled := updateProductError(err); handled {
 			return resp, nil
@@ -44,8 +67,39 @@ func (s *Server) UpdateProduct(ctx context.Context, request UpdateProductRequest
 	return okUpdateProduct(updated), nil
 }
 
+func (s *Server) PatchProduct(ctx context.Context, request PatchProductRequestObject) (PatchProductResponseObject, error) {
+	patch, err := productPatchInput(request.Body)
+	if err != nil {
+		if resp, handled := patchProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		if resp, handled := patchProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	patched, err := s.products.Patch(ctx, request.Id, patch, version)
+	if err != nil {
+		if resp, handled := patchProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okPatchProduct(patched), nil
+}
+
 func (s *Server) DeleteProductByID(ctx context.Context, request DeleteProductByIDRequestObject) (DeleteProductByIDResponseObject, error) {
-	if err := s.products.Remove(ctx, request.Id); err != nil {
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err == nil {
+		err = s.products.Remove(ctx, request.Id, version)
+	}
+	if err != nil {
 		if resp, handled := deleteProductError(err); handled {
 			return resp, nil
 		}
@@ -54,3 +108,143 @@ func (s *Server) DeleteProductByID(ctx context.Context, request DeleteProductByI
 
 	return okDeleteProduct(), nil
 }
+
+func (s *Server) AddProductTag(ctx context.Context, request AddProductTagRequestObject) (AddProductTagResponseObject, error) {
+	tag, err := productTagInput(request.Body)
+	if err != nil {
+		if resp, handled := addProductTagError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		if resp, handled := addProductTagError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	product, err := s.products.AddTag(ctx, request.Id, tag, version)
+	if err != nil {
+		if resp, handled := addProductTagError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okAddProductTag(product), nil
+}
+
+func (s *Server) RemoveProductTag(ctx context.Context, request RemoveProductTagRequestObject) (RemoveProductTagResponseObject, error) {
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		if resp, handled := removeProductTagError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	product, err := s.products.RemoveTag(ctx, request.Id, request.Tag, version)
+	if err != nil {
+		if resp, handled := removeProductTagError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okRemoveProductTag(product), nil
+}
+
+func (s *Server) ScheduleProductPrice(ctx context.Context, request ScheduleProductPriceRequestObject) (ScheduleProductPriceResponseObject, error) {
+	priceCents, effectiveFrom, err := priceScheduleInput(request.Body)
+	if err != nil {
+		if resp, handled := schedulePriceError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	change, err := s.products.SchedulePrice(ctx, request.Id, priceCents, effectiveFrom)
+	if err != nil {
+		if resp, handled := schedulePriceError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okSchedulePrice(change), nil
+}
+
+func (s *Server) PublishProduct(ctx context.Context, request PublishProductRequestObject) (PublishProductResponseObject, error) {
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		if resp, handled := publishProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	product, err := s.products.Publish(ctx, request.Id, version)
+	if err != nil {
+		if resp, handled := publishProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okPublishProduct(product), nil
+}
+
+func (s *Server) ArchiveProduct(ctx context.Context, request ArchiveProductRequestObject) (ArchiveProductResponseObject, error) {
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		if resp, handled := archiveProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	product, err := s.products.Archive(ctx, request.Id, version)
+	if err != nil {
+		if resp, handled := archiveProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okArchiveProduct(product), nil
+}
+
+func (s *Server) UnarchiveProduct(ctx context.Context, request UnarchiveProductRequestObject) (UnarchiveProductResponseObject, error) {
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		if resp, handled := unarchiveProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	product, err := s.products.Unarchive(ctx, request.Id, version)
+	if err != nil {
+		if resp, handled := unarchiveProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okUnarchiveProduct(product), nil
+}
+
+func (s *Server) RestoreProduct(ctx context.Context, request RestoreProductRequestObject) (RestoreProductResponseObject, error) {
+	product, err := s.products.Restore(ctx, request.Id)
+	if err != nil {
+		if resp, handled := restoreProductError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okRestoreProduct(product), nil
+}
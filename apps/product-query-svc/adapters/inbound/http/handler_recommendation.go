@@ -0,0 +1,14 @@
+package httpadapter
+
+import "context"
+
+func (s *Server) ListRelatedProducts(ctx context.Context, request ListRelatedProductsRequestObject) (ListRelatedProductsResponseObject, error) {
+	related, err := s.recommendations.Related(ctx, relatedQueryInput(request.Id, request.Params))
+	if err != nil {
+		if resp, handled := listRelatedError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okListRelated(related), nil
+}
@@ -0,0 +1,46 @@
+package httpadapter
+
+import (
+	"context"
+)
+
+func (s *Server) ListProductTranslations(ctx context.Context, request ListProductTranslationsRequestObject) (ListProductTranslationsResponseObject, error) {
+	translations, err := s.translations.List(ctx, request.Id)
+	if err != nil {
+		if resp, handled := listTranslationsError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okListTranslations(translations), nil
+}
+
+func (s *Server) PutProductTranslation(ctx context.Context, request PutProductTranslationRequestObject) (PutProductTranslationResponseObject, error) {
+	name, description, err := translationInput(request.Body)
+	if err != nil {
+		if resp, handled := putTranslationError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	translation, created, err := s.translations.Put(ctx, request.Id, request.Locale, name, description)
+	if err != nil {
+		if resp, handled := putTranslationError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okPutTranslation(translation, created), nil
+}
+
+func (s *Server) DeleteProductTranslation(ctx context.Context, request DeleteProductTranslationRequestObject) (DeleteProductTranslationResponseObject, error) {
+	if err := s.translations.Delete(ctx, request.Id, request.Locale); err != nil {
+		if resp, handled := deleteTranslationError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okDeleteTranslation(), nil
+}
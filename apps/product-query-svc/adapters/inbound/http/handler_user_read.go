@@ -13,3 +13,23 @@ func (s *Server) GetUserByID(ctx context.Context, request GetUserByIDRequestObje
 
 	return okGetUser(user), nil
 }
+
+func (s *Server) ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error) {
+	criteria, err := newUserSearchCriteria(request.Params)
+	if err != nil {
+		if resp, handled := listUsersError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	result, err := s.users.Search(ctx, criteria)
+	if err != nil {
+		if resp, handled := listUsersError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okListUsers(criteria, result), nil
+}
@@ -0,0 +1,53 @@
+package httpadapter
+
+import "context"
+
+func (s *Server) CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error) {
+	name, email, err := userInput(request.Body)
+	if err != nil {
+		if resp, handled := createUserError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	user, err := s.users.Create(ctx, name, email)
+	if err != nil {
+		if resp, handled := createUserError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okCreateUser(user), nil
+}
+
+func (s *Server) UpdateUser(ctx context.Context, request UpdateUserRequestObject) (UpdateUserResponseObject, error) {
+	name, email, err := userUpdateInput(request.Body)
+	if err != nil {
+		if resp, handled := updateUserError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	user, err := s.users.Update(ctx, request.Id, name, email)
+	if err != nil {
+		if resp, handled := updateUserError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okUpdateUser(user), nil
+}
+
+func (s *Server) DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error) {
+	if err := s.users.Delete(ctx, request.Id); err != nil {
+		if resp, handled := deleteUserError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okDeleteUser(), nil
+}
@@ -0,0 +1,87 @@
+package httpadapter
+
+import "context"
+
+func (s *Server) ListProductVariants(ctx context.Context, request ListProductVariantsRequestObject) (ListProductVariantsResponseObject, error) {
+	variants, err := s.variants.List(ctx, request.Id)
+	if err != nil {
+		if resp, handled := listVariantsError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okListVariants(variants), nil
+}
+
+func (s *Server) CreateProductVariant(ctx context.Context, request CreateProductVariantRequestObject) (CreateProductVariantResponseObject, error) {
+	in, err := variantCreateInput(request.Body)
+	if err != nil {
+		if resp, handled := createVariantError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	variant, err := s.variants.Create(ctx, request.Id, in.sku, in.attributes, in.priceCents)
+	if err != nil {
+		if resp, handled := createVariantError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okCreateVariant(variant), nil
+}
+
+func (s *Server) GetProductVariant(ctx context.Context, request GetProductVariantRequestObject) (GetProductVariantResponseObject, error) {
+	variant, err := s.variants.FetchByID(ctx, request.Id, request.VariantId)
+	if err != nil {
+		if resp, handled := getVariantError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	return okGetVariant(variant), nil
+}
+
+func (s *Server) UpdateProductVariant(ctx context.Context, request UpdateProductVariantRequestObject) (UpdateProductVariantResponseObject, error) {
+	in, err := variantUpdateInput(request.Body)
+	if err != nil {
+		if resp, handled := updateVariantError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		if resp, handled := updateVariantError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	updated, err := s.variants.Update(ctx, request.Id, request.VariantId, in.sku, in.attributes, in.priceCents, version)
+	if err != nil {
+		if resp, handled := updateVariantError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okUpdateVariant(updated), nil
+}
+
+func (s *Server) DeleteProductVariant(ctx context.Context, request DeleteProductVariantRequestObject) (DeleteProductVariantResponseObject, error) {
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err == nil {
+		err = s.variants.Delete(ctx, request.Id, request.VariantId, version)
+	}
+	if err != nil {
+		if resp, handled := deleteVariantError(err); handled {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	return okDeleteVariant(), nil
+}
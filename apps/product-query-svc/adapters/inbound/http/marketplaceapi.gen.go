@@ -10,6 +10,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"path"
@@ -23,6 +25,108 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ExportProductsParamsFormat.
+const (
+	ExportProductsParamsFormatCsv    ExportProductsParamsFormat = "csv"
+	ExportProductsParamsFormatJson   ExportProductsParamsFormat = "json"
+	ExportProductsParamsFormatNdjson ExportProductsParamsFormat = "ndjson"
+)
+
+// Defines values for ExportProductsParamsTagMatch.
+const (
+	ExportProductsParamsTagMatchAll ExportProductsParamsTagMatch = "all"
+	ExportProductsParamsTagMatchAny ExportProductsParamsTagMatch = "any"
+)
+
+// Defines values for ListUsersParamsOrder.
+const (
+	ListUsersParamsOrderAsc  ListUsersParamsOrder = "asc"
+	ListUsersParamsOrderDesc ListUsersParamsOrder = "desc"
+)
+
+// Defines values for ListUsersParamsSort.
+const (
+	ListUsersParamsSortCreatedAt ListUsersParamsSort = "createdAt"
+	ListUsersParamsSortEmail     ListUsersParamsSort = "email"
+	ListUsersParamsSortId        ListUsersParamsSort = "id"
+	ListUsersParamsSortName      ListUsersParamsSort = "name"
+)
+
+// Defines values for PriceChangeStatus.
+const (
+	PriceChangeStatusApplied PriceChangeStatus = "applied"
+	PriceChangeStatusPending PriceChangeStatus = "pending"
+	PriceChangeStatusSkipped PriceChangeStatus = "skipped"
+)
+
+// Defines values for ProductStatus.
+const (
+	ProductStatusArchived  ProductStatus = "archived"
+	ProductStatusDraft     ProductStatus = "draft"
+	ProductStatusPublished ProductStatus = "published"
+)
+
+// Defines values for ReservationStatus.
+const (
+	ReservationStatusCommitted ReservationStatus = "committed"
+	ReservationStatusPending   ReservationStatus = "pending"
+	ReservationStatusReleased  ReservationStatus = "released"
+)
+
+// Defines values for SearchProductsParamsOrder.
+const (
+	SearchProductsParamsOrderAsc  SearchProductsParamsOrder = "asc"
+	SearchProductsParamsOrderDesc SearchProductsParamsOrder = "desc"
+)
+
+// Defines values for SearchProductsParamsSort.
+const (
+	SearchProductsParamsSortId        SearchProductsParamsSort = "id"
+	SearchProductsParamsSortName      SearchProductsParamsSort = "name"
+	SearchProductsParamsSortPrice     SearchProductsParamsSort = "price"
+	SearchProductsParamsSortRelevance SearchProductsParamsSort = "relevance"
+)
+
+// Defines values for SearchProductsParamsTagMatch.
+const (
+	SearchProductsParamsTagMatchAll SearchProductsParamsTagMatch = "all"
+	SearchProductsParamsTagMatchAny SearchProductsParamsTagMatch = "any"
+)
+
+// Defines values for SuggestionKind.
+const (
+	SuggestionKindName SuggestionKind = "name"
+	SuggestionKindTag  SuggestionKind = "tag"
+)
+
+// Breadcrumb One level of a category path.
+type Breadcrumb struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// Category defines model for Category.
+type Category struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+
+	// ParentId Absent for top-level categories.
+	ParentId *int64 `json:"parentId,omitempty"`
+
+	// Path Breadcrumb from the top-level category down to this one.
+	Path      []Breadcrumb `json:"path"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+
+	// Version Optimistic concurrency version, bumped by every write; the ETag header carries the same value.
+	Version int64 `json:"version"`
+}
+
+// CategoryList defines model for CategoryList.
+type CategoryList struct {
+	// Items Every category ordered by id.
+	Items []Category `json:"items"`
+}
+
 // Comment defines model for Comment.
 type Comment struct {
 	Content   string    `json:"content"`
@@ -31,1226 +135,8566 @@ type Comment struct {
 	ProductId int64     `json:"productId"`
 	UpdatedAt time.Time `json:"updatedAt"`
 	UserId    int64     `json:"userId"`
+
+	// Version Optimistic concurrency version, bumped by every edit; the ETag header carries the same value.
+	Version int64 `json:"version"`
 }
 
 // CommentList defines model for CommentList.
 type CommentList struct {
 	Items []Comment `json:"items"`
-}
 
-// Product defines model for Product.
-type Product struct {
-	Id    int64   `json:"id"`
-	Name  string  `json:"name"`
-	Price float32 `json:"price"`
+	// NextCursor Pass as cursor to fetch the next page; absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
-// ProductList defines model for ProductList.
-type ProductList struct {
-	Items    []Product `json:"items"`
-	Page     int       `json:"page"`
-	PageSize int       `json:"pageSize"`
-	Total    int       `json:"total"`
-}
+// ImportRowError defines model for ImportRowError.
+type ImportRowError struct {
+	// Id Product id of an update row.
+	Id *int64 `json:"id,omitempty"`
 
-// User User profile returned by the API.
-type User struct {
-	CreatedAt *time.Time          `json:"createdAt,omitempty"`
-	Email     openapi_types.Email `json:"email"`
-	Id        *int64              `json:"id,omitempty"`
-	Name      string              `json:"name"`
+	// Line Line of the row in the uploaded file, starting at 1.
+	Line    int    `json:"line"`
+	Message string `json:"message"`
 }
 
-// CreateProductJSONBody defines parameters for CreateProduct.
-type CreateProductJSONBody struct {
-	Name  string  `json:"name"`
-	Price float32 `json:"price"`
-}
+// ImportSummary defines model for ImportSummary.
+type ImportSummary struct {
+	// Created Products created, or that would be created on a dry run.
+	Created int  `json:"created"`
+	DryRun  bool `json:"dryRun"`
 
-// SearchProductsParams defines parameters for SearchProducts.
-type SearchProductsParams struct {
-	Q        *string `form:"q,omitempty" json:"q,omitempty"`
-	Page     *int    `form:"page,omitempty" json:"page,omitempty"`
-	PageSize *int    `form:"pageSize,omitempty" json:"pageSize,omitempty"`
-}
+	// Errors Rejected rows ordered by line; they are never written.
+	Errors []ImportRowError `json:"errors"`
+	Failed int              `json:"failed"`
 
-// UpdateProductJSONBody defines parameters for UpdateProduct.
-type UpdateProductJSONBody struct {
-	Name  string  `json:"name"`
-	Price float32 `json:"price"`
+	// Updated Products updated, or that would be updated on a dry run.
+	Updated int `json:"updated"`
 }
 
-// CreateProductCommentJSONBody defines parameters for CreateProductComment.
-type CreateProductCommentJSONBody struct {
-	Content string `json:"content"`
-	UserId  int64  `json:"userId"`
-}
+// PriceChange One entry of a product's price history.
+type PriceChange struct {
+	// AppliedAt When the price actually took effect; absent while pending.
+	AppliedAt     *time.Time `json:"appliedAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	Currency      string     `json:"currency"`
+	EffectiveFrom time.Time  `json:"effectiveFrom"`
+	Id            int64      `json:"id"`
 
-// DeleteProductCommentParams defines parameters for DeleteProductComment.
-type DeleteProductCommentParams struct {
-	UserId int64 `form:"userId" json:"userId"`
-}
+	// PriceCents Price in minor units of currency.
+	PriceCents int64 `json:"priceCents"`
+	ProductId  int64 `json:"productId"`
 
-// UpdateProductCommentJSONBody defines parameters for UpdateProductComment.
-type UpdateProductCommentJSONBody struct {
-	Content string `json:"content"`
-	UserId  int64  `json:"userId"`
+	// Status pending until effectiveFrom passes; skipped when the product changed currency before then.
+	Status PriceChangeStatus `json:"status"`
 }
 
-// UpdateProductCommentParams defines parameters for UpdateProductComment.
-type UpdateProductCommentParams struct {
-	UserId int64 `form:"userId" json:"userId"`
+// PriceChangeStatus pending until effectiveFrom passes; skipped when the product changed currency before then.
+type PriceChangeStatus string
+
+// PriceHistory defines model for PriceHistory.
+type PriceHistory struct {
+	// Items Past and pending prices ordered by effectiveFrom.
+	Items []PriceChange `json:"items"`
 }
 
-// CreateProductJSONRequestBody defines body for CreateProduct for application/json ContentType.
-type CreateProductJSONRequestBody CreateProductJSONBody
+// Product defines model for Product.
+type Product struct {
+	// CategoryId Category the product is filed under; absent for uncategorised products.
+	CategoryId *int64 `json:"categoryId,omitempty"`
 
-// UpdateProductJSONRequestBody defines body for UpdateProduct for application/json ContentType.
-type UpdateProductJSONRequestBody UpdateProductJSONBody
+	// CategoryPath Breadcrumb from the top-level category down to categoryId; empty for uncategorised products.
+	CategoryPath []Breadcrumb `json:"categoryPath"`
 
-// CreateProductCommentJSONRequestBody defines body for CreateProductComment for application/json ContentType.
-type CreateProductCommentJSONRequestBody CreateProductCommentJSONBody
+	// Currency ISO-4217 code of priceCents; the requested currency when one was given.
+	Currency string `json:"currency"`
 
-// UpdateProductCommentJSONRequestBody defines body for UpdateProductComment for application/json ContentType.
-type UpdateProductCommentJSONRequestBody UpdateProductCommentJSONBody
+	// DeletedAt When the product was moved to the trash; only present in the trash listing.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+	// Description Free-text description; empty when none was given.
+	Description string `json:"description"`
+	Id          int64  `json:"id"`
 
-	// (POST /products)
-	CreateProduct(w http.ResponseWriter, r *http.Request)
+	// InStock Whether the product or any of its variants has stock available; only present in search results.
+	InStock *bool `json:"inStock,omitempty"`
 
-	// (GET /products/search)
-	SearchProducts(w http.ResponseWriter, r *http.Request, params SearchProductsParams)
+	// Locale BCP 47 tag of the language name and description are in, picked through Accept-Language; en for the product's own text.
+	Locale string `json:"locale"`
+	Name   string `json:"name"`
 
-	// (DELETE /products/{id})
-	DeleteProductByID(w http.ResponseWriter, r *http.Request, id int64)
+	// Price Decimal amount in major units of currency, kept for one version; use priceCents and currency instead.
+	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
+	Price float64 `json:"price"`
 
-	// (GET /products/{id})
-	GetProductByID(w http.ResponseWriter, r *http.Request, id int64)
+	// PriceCents Price in minor units (cents) of currency.
+	PriceCents int64 `json:"priceCents"`
 
-	// (PUT /products/{id})
-	UpdateProduct(w http.ResponseWriter, r *http.Request, id int64)
+	// PriceOverrides Fixed prices in minor units keyed by ISO-4217 code; they win over exchange-rate conversion.
+	PriceOverrides map[string]int64 `json:"priceOverrides"`
 
-	// (GET /products/{productId}/comments)
-	ListProductComments(w http.ResponseWriter, r *http.Request, productId int64)
+	// PublishedAt When the product was last published; absent for products that were never published.
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
 
-	// (POST /products/{productId}/comments)
-	CreateProductComment(w http.ResponseWriter, r *http.Request, productId int64)
+	// Score Relevance score; only present in search results sorted by relevance.
+	Score *float64 `json:"score,omitempty"`
 
-	// (DELETE /products/{productId}/comments/{commentId})
-	DeleteProductComment(w http.ResponseWriter, r *http.Request, productId int64, commentId int64, params DeleteProductCommentParams)
+	// Slug URL-safe identifier generated from the name; a rename moves the product to a new slug and the old one redirects.
+	Slug string `json:"slug"`
 
-	// (PUT /products/{productId}/comments/{commentId})
-	UpdateProductComment(w http.ResponseWriter, r *http.Request, productId int64, commentId int64, params UpdateProductCommentParams)
+	// Status Lifecycle status; only published products appear in search and suggestions by default.
+	Status ProductStatus `json:"status"`
+	Tags   []string      `json:"tags"`
 
-	// (GET /users/{id})
-	GetUserByID(w http.ResponseWriter, r *http.Request, id int64)
-}
+	// UpdatedAt When the product was last written; the Last-Modified header carries the same instant.
+	UpdatedAt time.Time `json:"updatedAt"`
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	// Version Optimistic concurrency version, bumped by every write and by renames or moves of a category on categoryPath; the ETag header carries the same value.
+	Version int64 `json:"version"`
+}
 
-type Unimplemented struct{}
+// ProductStatus Lifecycle status; only published products appear in search and suggestions by default.
+type ProductStatus string
 
-// (POST /products)
-func (_ Unimplemented) CreateProduct(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProductFacets Aggregations over the full result set, not only the current page.
+type ProductFacets struct {
+	Tags []TagFacet `json:"tags"`
 }
 
-// (GET /products/search)
-func (_ Unimplemented) SearchProducts(w http.ResponseWriter, r *http.Request, params SearchProductsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ProductImage defines model for ProductImage.
+type ProductImage struct {
+	// AltText Text alternative for the image; empty when none was given.
+	AltText string `json:"altText"`
 
-// (DELETE /products/{id})
-func (_ Unimplemented) DeleteProductByID(w http.ResponseWriter, r *http.Request, id int64) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Checksum Hex-encoded SHA-256 of the content; the content's ETag carries the same value.
+	Checksum string `json:"checksum"`
 
-// (GET /products/{id})
-func (_ Unimplemented) GetProductByID(w http.ResponseWriter, r *http.Request, id int64) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ContentType One of image/jpeg, image/png, image/gif or image/webp, detected from the content.
+	ContentType string    `json:"contentType"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Id          int64     `json:"id"`
 
-// (PUT /products/{id})
-func (_ Unimplemented) UpdateProduct(w http.ResponseWriter, r *http.Request, id int64) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Position Place in the product's gallery, from 0; the image at 0 is the main image.
+	Position  int   `json:"position"`
+	ProductId int64 `json:"productId"`
 
-// (GET /products/{productId}/comments)
-func (_ Unimplemented) ListProductComments(w http.ResponseWriter, r *http.Request, productId int64) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Size Content length in bytes.
+	Size      int64     `json:"size"`
+	UpdatedAt time.Time `json:"updatedAt"`
 
-// (POST /products/{productId}/comments)
-func (_ Unimplemented) CreateProductComment(w http.ResponseWriter, r *http.Request, productId int64) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Url Where the content is served, relative to the API root.
+	Url string `json:"url"`
 }
 
-// (DELETE /products/{productId}/comments/{commentId})
-func (_ Unimplemented) DeleteProductComment(w http.ResponseWriter, r *http.Request, productId int64, commentId int64, params DeleteProductCommentParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProductImageList defines model for ProductImageList.
+type ProductImageList struct {
+	// Items Every image of the product ordered by position.
+	Items []ProductImage `json:"items"`
 }
 
-// (PUT /products/{productId}/comments/{commentId})
-func (_ Unimplemented) UpdateProductComment(w http.ResponseWriter, r *http.Request, productId int64, commentId int64, params UpdateProductCommentParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProductList defines model for ProductList.
+type ProductList struct {
+	// Facets Aggregations over the full result set, not only the current page.
+	Facets *ProductFacets `json:"facets,omitempty"`
+	Items  []Product      `json:"items"`
+
+	// NextCursor Pass as cursor to fetch the next page; absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+	Page       int     `json:"page"`
+	PageSize   int     `json:"pageSize"`
+
+	// Total Omitted when includeTotal=false.
+	Total *int `json:"total,omitempty"`
 }
 
-// (GET /users/{id})
-func (_ Unimplemented) GetUserByID(w http.ResponseWriter, r *http.Request, id int64) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProductTranslation defines model for ProductTranslation.
+type ProductTranslation struct {
+	Description string `json:"description"`
+
+	// Locale Normalized BCP 47 tag of the translation.
+	Locale    string    `json:"locale"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// ProductTranslationList defines model for ProductTranslationList.
+type ProductTranslationList struct {
+	// Items Every translation of the product ordered by locale.
+	Items []ProductTranslation `json:"items"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// RelatedProduct defines model for RelatedProduct.
+type RelatedProduct struct {
+	// CoPurchases Customers who ordered both products; 0 unless includeOrders is set.
+	CoPurchases int     `json:"coPurchases"`
+	Product     Product `json:"product"`
 
-// CreateProduct operation middleware
-func (siw *ServerInterfaceWrapper) CreateProduct(w http.ResponseWriter, r *http.Request) {
+	// SameCategory Whether both products are filed under the same category.
+	SameCategory bool `json:"sameCategory"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProduct(w, r)
-	}))
+	// Score 2 per shared tag, 3 for the same category and 1 per customer who bought both products; higher is more related.
+	Score int `json:"score"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// SharedTags Tags both products carry, lowercased and sorted.
+	SharedTags []string `json:"sharedTags"`
+}
 
-	handler.ServeHTTP(w, r)
+// RelatedProductList defines model for RelatedProductList.
+type RelatedProductList struct {
+	// Items Ordered by score, highest first, then by product id.
+	Items []RelatedProduct `json:"items"`
 }
 
-// SearchProducts operation middleware
-func (siw *ServerInterfaceWrapper) SearchProducts(w http.ResponseWriter, r *http.Request) {
+// Reservation defines model for Reservation.
+type Reservation struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Id        int64     `json:"id"`
+	ProductId int64     `json:"productId"`
+	Quantity  int64     `json:"quantity"`
 
-	var err error
+	// Status pending until the reservation is committed (stock shipped) or released (stock returned).
+	Status    ReservationStatus `json:"status"`
+	UpdatedAt time.Time         `json:"updatedAt"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params SearchProductsParams
+	// VariantId Reserved variant; absent when the product's own stock is reserved.
+	VariantId *int64 `json:"variantId,omitempty"`
+}
 
-	// ------------- Optional query parameter "q" -------------
+// ReservationStatus pending until the reservation is committed (stock shipped) or released (stock returned).
+type ReservationStatus string
 
-	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
-		return
-	}
+// SlugRedirect Returned with 301 when a product is looked up by a slug it no longer uses.
+type SlugRedirect struct {
+	Id int64 `json:"id"`
 
-	// ------------- Optional query parameter "page" -------------
+	// Location Path to request instead; the Location header carries the same value.
+	Location string `json:"location"`
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+	// Slug The product's current slug.
+	Slug string `json:"slug"`
+}
 
-	// ------------- Optional query parameter "pageSize" -------------
+// StockLevel defines model for StockLevel.
+type StockLevel struct {
+	// Available Units that can still be reserved, onHand minus reserved.
+	Available int64 `json:"available"`
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
-		return
-	}
+	// OnHand Units in the warehouse, reserved ones included.
+	OnHand int64 `json:"onHand"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SearchProducts(w, r, params)
-	}))
+	// Reserved Units held by pending reservations.
+	Reserved  int64     `json:"reserved"`
+	UpdatedAt time.Time `json:"updatedAt"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// VariantId Variant the stock belongs to; absent for the product's own stock.
+	VariantId *int64 `json:"variantId,omitempty"`
+}
 
-	handler.ServeHTTP(w, r)
+// StockList defines model for StockList.
+type StockList struct {
+	// Available Units available across the product and all of its variants.
+	Available int64 `json:"available"`
+
+	// Items The product's own stock first, then its variants' by variant id; never-stocked items are absent.
+	Items []StockLevel `json:"items"`
 }
 
-// DeleteProductByID operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProductByID(w http.ResponseWriter, r *http.Request) {
+// Suggestion defines model for Suggestion.
+type Suggestion struct {
+	Kind SuggestionKind `json:"kind"`
 
-	var err error
+	// ProductId Set for name suggestions only.
+	ProductId *int64 `json:"productId,omitempty"`
+	Text      string `json:"text"`
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	// Weight Number of products the suggestion leads to.
+	Weight int `json:"weight"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// SuggestionKind defines model for Suggestion.Kind.
+type SuggestionKind string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProductByID(w, r, id)
-	}))
+// SuggestionList defines model for SuggestionList.
+type SuggestionList struct {
+	Items []Suggestion `json:"items"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// TagFacet defines model for TagFacet.
+type TagFacet struct {
+	Count int    `json:"count"`
+	Tag   string `json:"tag"`
+}
 
-	handler.ServeHTTP(w, r)
+// User User profile returned by the API.
+type User struct {
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	Email     openapi_types.Email `json:"email"`
+	Id        *int64              `json:"id,omitempty"`
+	Name      string              `json:"name"`
 }
 
-// GetProductByID operation middleware
-func (siw *ServerInterfaceWrapper) GetProductByID(w http.ResponseWriter, r *http.Request) {
+// UserList defines model for UserList.
+type UserList struct {
+	Items []User `json:"items"`
 
-	var err error
+	// NextCursor Pass as cursor to fetch the next page; absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+	Page       int     `json:"page"`
+	PageSize   int     `json:"pageSize"`
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	// Total Omitted when includeTotal=false.
+	Total *int `json:"total,omitempty"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// Variant defines model for Variant.
+type Variant struct {
+	// Attributes What sets the variant apart, e.g. size and color; names are lower-cased.
+	Attributes map[string]string `json:"attributes"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	Id         int64             `json:"id"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProductByID(w, r, id)
-	}))
+	// PriceCents Price in minor units of the product's currency; absent when the variant sells at the product's price.
+	PriceCents *int64 `json:"priceCents,omitempty"`
+	ProductId  int64  `json:"productId"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Sku Stock keeping unit, upper-cased; unique across all products.
+	Sku       string    `json:"sku"`
+	UpdatedAt time.Time `json:"updatedAt"`
 
-	handler.ServeHTTP(w, r)
+	// Version Optimistic concurrency version, bumped by every write; the ETag header carries the same value.
+	Version int64 `json:"version"`
 }
 
-// UpdateProduct operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProduct(w http.ResponseWriter, r *http.Request) {
+// VariantList defines model for VariantList.
+type VariantList struct {
+	// Items Every variant of the product ordered by id.
+	Items []Variant `json:"items"`
+}
 
-	var err error
+// CreateCategoryJSONBody defines parameters for CreateCategory.
+type CreateCategoryJSONBody struct {
+	// Name Unique among the parent's subcategories, case-insensitively.
+	Name string `json:"name"`
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	// ParentId Parent category; omit for a top-level category. On update the subcategories move along.
+	ParentId *int64 `json:"parentId,omitempty"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// DeleteCategoryParams defines parameters for DeleteCategory.
+type DeleteCategoryParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProduct(w, r, id)
-	}))
+// UpdateCategoryJSONBody defines parameters for UpdateCategory.
+type UpdateCategoryJSONBody struct {
+	// Name Unique among the parent's subcategories, case-insensitively.
+	Name string `json:"name"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ParentId Parent category; omit for a top-level category. On update the subcategories move along.
+	ParentId *int64 `json:"parentId,omitempty"`
+}
 
-	handler.ServeHTTP(w, r)
+// UpdateCategoryParams defines parameters for UpdateCategory.
+type UpdateCategoryParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-// ListProductComments operation middleware
-func (siw *ServerInterfaceWrapper) ListProductComments(w http.ResponseWriter, r *http.Request) {
+// CreateProductJSONBody defines parameters for CreateProduct.
+type CreateProductJSONBody struct {
+	// CategoryId Category to file the product under; on update, omitting it leaves the product uncategorised.
+	CategoryId *int64 `json:"categoryId,omitempty"`
 
-	var err error
+	// Currency ISO-4217 code of priceCents; defaults to USD.
+	Currency *string `json:"currency,omitempty"`
 
-	// ------------- Path parameter "productId" -------------
-	var productId int64
+	// Description Description in en, the product's own language; translations are managed separately.
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
-		return
-	}
+	// Price Decimal amount in major units of currency, kept for one version; send priceCents instead.
+	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
+	Price *float64 `json:"price,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProductComments(w, r, productId)
-	}))
+	// PriceCents Price in minor units (cents) of currency; takes precedence over price.
+	PriceCents *int64 `json:"priceCents,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// PriceOverrides Fixed prices in minor units keyed by ISO-4217 code, used instead of converting priceCents.
+	PriceOverrides *map[string]int64 `json:"priceOverrides,omitempty"`
+	Tags           *[]string         `json:"tags,omitempty"`
+}
 
-	handler.ServeHTTP(w, r)
+// GetProductBySlugParams defines parameters for GetProductBySlug.
+type GetProductBySlugParams struct {
+	// Currency ISO-4217 code to quote prices in. Uses the product's override for that currency when set, otherwise converts at the current exchange rate; omitted means each product's own currency.
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// IfNoneMatch ETags from earlier responses; 304 Not Modified is returned when one still matches. Takes precedence over If-Modified-Since.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince HTTP date; 304 Not Modified is returned when the resource has not been written since. Ignored when If-None-Match is sent.
+	IfModifiedSince *string `json:"If-Modified-Since,omitempty"`
+
+	// AcceptLanguage Preferred locales (RFC 9110). Names and descriptions come from the first translation found along the list, each tag falling back to its shorter forms (de-CH, then de); without one the product's own en text is shown.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
 }
 
-// CreateProductComment operation middleware
-func (siw *ServerInterfaceWrapper) CreateProductComment(w http.ResponseWriter, r *http.Request) {
+// SearchProductsParams defines parameters for SearchProducts.
+type SearchProductsParams struct {
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
-	var err error
+	// Tags Only return products carrying these tags (case-insensitive).
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
 
-	// ------------- Path parameter "productId" -------------
-	var productId int64
+	// TagMatch Whether a product must carry any or all of the requested tags.
+	TagMatch *SearchProductsParamsTagMatch `form:"tagMatch,omitempty" json:"tagMatch,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
-		return
-	}
+	// Category Only return products filed under this category or any of its subcategories.
+	Category *int64 `form:"category,omitempty" json:"category,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProductComment(w, r, productId)
-	}))
+	// MinPrice Inclusive lower price bound in cents.
+	MinPrice *int64 `form:"minPrice,omitempty" json:"minPrice,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// MaxPrice Inclusive upper price bound in cents.
+	MaxPrice *int64 `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Sort Field used to order the results; ties are broken by id. relevance requires q and switches matching to full-text with typo tolerance.
+	Sort *SearchProductsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-// DeleteProductComment operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProductComment(w http.ResponseWriter, r *http.Request) {
+	// Order Sort direction; defaults to desc for relevance and asc otherwise.
+	Order    *SearchProductsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Page     *int                       `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int                       `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 
-	var err error
+	// Cursor Opaque cursor taken from nextCursor of the previous page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-	// ------------- Path parameter "productId" -------------
-	var productId int64
+	// IncludeTotal Set to false to skip counting; total and facets are then omitted.
+	IncludeTotal *bool `form:"includeTotal,omitempty" json:"includeTotal,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
-		return
-	}
+	// Currency ISO-4217 code to quote prices in. Uses the product's override for that currency when set, otherwise converts at the current exchange rate; omitted means each product's own currency.
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-	// ------------- Path parameter "commentId" -------------
-	var commentId int64
+	// IncludeUnpublished Admin flag; set to true to include draft and archived products.
+	IncludeUnpublished *bool `form:"includeUnpublished,omitempty" json:"includeUnpublished,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
-		return
-	}
+	// IfNoneMatch ETags from earlier responses; 304 Not Modified is returned when one still matches. Takes precedence over If-Modified-Since.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteProductCommentParams
+	// AcceptLanguage Preferred locales (RFC 9110). Names and descriptions come from the first translation found along the list, each tag falling back to its shorter forms (de-CH, then de); without one the product's own en text is shown.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
 
-	// ------------- Required query parameter "userId" -------------
+// SearchProductsParamsTagMatch defines parameters for SearchProducts.
+type SearchProductsParamsTagMatch string
 
-	if paramValue := r.URL.Query().Get("userId"); paramValue != "" {
+// SearchProductsParamsSort defines parameters for SearchProducts.
+type SearchProductsParamsSort string
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "userId"})
-		return
-	}
+// SearchProductsParamsOrder defines parameters for SearchProducts.
+type SearchProductsParamsOrder string
 
-	err = runtime.BindQueryParameter("form", true, true, "userId", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
-	}
+// SuggestProductsParams defines parameters for SuggestProducts.
+type SuggestProductsParams struct {
+	// Prefix Case-insensitive prefix typed so far.
+	Prefix string `form:"prefix" json:"prefix"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProductComment(w, r, productId, commentId, params)
-	}))
+	// Limit Maximum number of suggestions.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ListTrashedProductsParams defines parameters for ListTrashedProducts.
+type ListTrashedProductsParams struct {
+	Page     *int `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+}
 
-	handler.ServeHTTP(w, r)
+// DeleteProductByIDParams defines parameters for DeleteProductByID.
+type DeleteProductByIDParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-// UpdateProductComment operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProductComment(w http.ResponseWriter, r *http.Request) {
+// GetProductByIDParams defines parameters for GetProductByID.
+type GetProductByIDParams struct {
+	// Currency ISO-4217 code to quote prices in. Uses the product's override for that currency when set, otherwise converts at the current exchange rate; omitted means each product's own currency.
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-	var err error
+	// IfNoneMatch ETags from earlier responses; 304 Not Modified is returned when one still matches. Takes precedence over If-Modified-Since.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
 
-	// ------------- Path parameter "productId" -------------
-	var productId int64
+	// IfModifiedSince HTTP date; 304 Not Modified is returned when the resource has not been written since. Ignored when If-None-Match is sent.
+	IfModifiedSince *string `json:"If-Modified-Since,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
-		return
-	}
+	// AcceptLanguage Preferred locales (RFC 9110). Names and descriptions come from the first translation found along the list, each tag falling back to its shorter forms (de-CH, then de); without one the product's own en text is shown.
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
 
-	// ------------- Path parameter "commentId" -------------
-	var commentId int64
+// PatchProductApplicationMergePatchPlusJSONBody defines parameters for PatchProduct.
+type PatchProductApplicationMergePatchPlusJSONBody struct {
+	// CategoryId Category to file the product under; null removes it from its category.
+	CategoryId NullableInt64 `json:"categoryId,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
-		return
-	}
+	// Currency New ISO-4217 code of priceCents; the stored amount is kept unless priceCents is sent too.
+	Currency *string `json:"currency,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params UpdateProductCommentParams
+	// Description New description; an empty string clears it.
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
 
-	// ------------- Required query parameter "userId" -------------
+	// PriceCents New price in minor units of the product's currency (after any currency change in the same patch).
+	PriceCents *int64 `json:"priceCents,omitempty"`
 
-	if paramValue := r.URL.Query().Get("userId"); paramValue != "" {
+	// PriceOverrides Merged into the stored overrides by currency; a null value removes that currency's override.
+	PriceOverrides *map[string]*int64 `json:"priceOverrides,omitempty"`
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "userId"})
-		return
-	}
+	// Tags Replaces all tags; send an empty array to clear them.
+	Tags *[]string `json:"tags,omitempty"`
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "userId", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
-	}
+// PatchProductParams defines parameters for PatchProduct.
+type PatchProductParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProductComment(w, r, productId, commentId, params)
-	}))
+// UpdateProductJSONBody defines parameters for UpdateProduct.
+type UpdateProductJSONBody struct {
+	// CategoryId Category to file the product under; on update, omitting it leaves the product uncategorised.
+	CategoryId *int64 `json:"categoryId,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Currency ISO-4217 code of priceCents; defaults to USD.
+	Currency *string `json:"currency,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Description Description in en, the product's own language; translations are managed separately.
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
 
-// GetUserByID operation middleware
-func (siw *ServerInterfaceWrapper) GetUserByID(w http.ResponseWriter, r *http.Request) {
+	// Price Decimal amount in major units of currency, kept for one version; send priceCents instead.
+	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
+	Price *float64 `json:"price,omitempty"`
 
-	var err error
+	// PriceCents Price in minor units (cents) of currency; takes precedence over price.
+	PriceCents *int64 `json:"priceCents,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	// PriceOverrides Fixed prices in minor units keyed by ISO-4217 code, used instead of converting priceCents.
+	PriceOverrides *map[string]int64 `json:"priceOverrides,omitempty"`
+	Tags           *[]string         `json:"tags,omitempty"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// UpdateProductParams defines parameters for UpdateProduct.
+type UpdateProductParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserByID(w, r, id)
-	}))
+// ArchiveProductParams defines parameters for ArchiveProduct.
+type ArchiveProductParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// UploadProductImageMultipartBody defines parameters for UploadProductImage.
+type UploadProductImageMultipartBody struct {
+	AltText *string `json:"altText,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// File JPEG, PNG, GIF or WebP content of at most 5 MiB; the declared part type must match the content.
+	File openapi_types.File `json:"file"`
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// UpdateProductImageJSONBody defines parameters for UpdateProductImage.
+type UpdateProductImageJSONBody struct {
+	// AltText Replaces the alt text; omit or send an empty string to clear it.
+	AltText *string `json:"altText,omitempty"`
+
+	// Position Moves the image there, shifting the others; positions past the last image move it to the end.
+	Position *int `json:"position,omitempty"`
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+// GetProductImageContentParams defines parameters for GetProductImageContent.
+type GetProductImageContentParams struct {
+	// Range Byte ranges to return, e.g. bytes=0-1023; answered with 206 Partial Content, or 416 when none can be satisfied.
+	Range *string `json:"Range,omitempty"`
+
+	// IfRange ETag the client's partial copy has; Range is only honoured while it still matches, otherwise the whole content is returned.
+	IfRange *string `json:"If-Range,omitempty"`
+
+	// IfNoneMatch ETags from earlier responses; 304 Not Modified is returned when one still matches. Takes precedence over If-Modified-Since.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+// ScheduleProductPriceJSONBody defines parameters for ScheduleProductPrice.
+type ScheduleProductPriceJSONBody struct {
+	// EffectiveFrom Future instant from which the price applies.
+	EffectiveFrom time.Time `json:"effectiveFrom"`
+
+	// PriceCents New price in minor units of the product's current currency.
+	PriceCents int64 `json:"priceCents"`
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+// PublishProductParams defines parameters for PublishProduct.
+type PublishProductParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+// ListRelatedProductsParams defines parameters for ListRelatedProducts.
+type ListRelatedProductsParams struct {
+	// Limit Maximum number of related products.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// IncludeOrders Also score products bought by the same customers, from the orders table.
+	IncludeOrders *bool `form:"includeOrders,omitempty" json:"includeOrders,omitempty"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+// AdjustProductStockJSONBody defines parameters for AdjustProductStock.
+type AdjustProductStockJSONBody struct {
+	// Delta Units booked in (positive) or out (negative); taking out reserved units yields 409.
+	Delta int64 `json:"delta"`
+
+	// VariantId Variant to adjust; omit to adjust the product's own stock.
+	VariantId *int64 `json:"variantId,omitempty"`
 }
 
-type RequiredParamError struct {
-	ParamName string
+// AddProductTagJSONBody defines parameters for AddProductTag.
+type AddProductTagJSONBody struct {
+	Tag string `json:"tag"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+// AddProductTagParams defines parameters for AddProductTag.
+type AddProductTagParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+// RemoveProductTagParams defines parameters for RemoveProductTag.
+type RemoveProductTagParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+// PutProductTranslationJSONBody defines parameters for PutProductTranslation.
+type PutProductTranslationJSONBody struct {
+	// Description Omit or send an empty string for no description.
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// UnarchiveProductParams defines parameters for UnarchiveProduct.
+type UnarchiveProductParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// CreateProductVariantJSONBody defines parameters for CreateProductVariant.
+type CreateProductVariantJSONBody struct {
+	// Attributes Names are case-insensitive; another variant of the product with the same attributes yields 409.
+	Attributes *map[string]string `json:"attributes,omitempty"`
+
+	// PriceCents Price in minor units of the product's currency; omit to sell at the product's price.
+	PriceCents *int64 `json:"priceCents,omitempty"`
+
+	// Sku Case-insensitive; taken SKUs, on this or any other product, yield 409.
+	Sku string `json:"sku"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// DeleteProductVariantParams defines parameters for DeleteProductVariant.
+type DeleteProductVariantParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// UpdateProductVariantJSONBody defines parameters for UpdateProductVariant.
+type UpdateProductVariantJSONBody struct {
+	// Attributes Names are case-insensitive; another variant of the product with the same attributes yields 409.
+	Attributes *map[string]string `json:"attributes,omitempty"`
+
+	// PriceCents Price in minor units of the product's currency; omit to sell at the product's price.
+	PriceCents *int64 `json:"priceCents,omitempty"`
+
+	// Sku Case-insensitive; taken SKUs, on this or any other product, yield 409.
+	Sku string `json:"sku"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// UpdateProductVariantParams defines parameters for UpdateProductVariant.
+type UpdateProductVariantParams struct {
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// ListProductCommentsParams defines parameters for ListProductComments.
+type ListProductCommentsParams struct {
+	// Cursor Opaque cursor taken from nextCursor of the previous page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of items to return; all items are returned when omitted.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// CreateProductCommentJSONBody defines parameters for CreateProductComment.
+type CreateProductCommentJSONBody struct {
+	Content string `json:"content"`
+	UserId  int64  `json:"userId"`
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// DeleteProductCommentParams defines parameters for DeleteProductComment.
+type DeleteProductCommentParams struct {
+	UserId int64 `form:"userId" json:"userId"`
+
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// UpdateProductCommentJSONBody defines parameters for UpdateProductComment.
+type UpdateProductCommentJSONBody struct {
+	Content string `json:"content"`
+	UserId  int64  `json:"userId"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// UpdateProductCommentParams defines parameters for UpdateProductComment.
+type UpdateProductCommentParams struct {
+	UserId int64 `form:"userId" json:"userId"`
+
+	// IfMatch ETag from a previous response. The write only happens if the resource is still at that version, otherwise 412 is returned; `*` or no header writes unconditionally.
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// ExportProductsParams defines parameters for ExportProducts.
+type ExportProductsParams struct {
+	// Format Encoding of the dump; ndjson and csv carry one product per line.
+	Format *ExportProductsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+	Q      *string                     `form:"q,omitempty" json:"q,omitempty"`
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	// Tags Only return products carrying these tags (case-insensitive).
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/products", wrapper.CreateProduct)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/products/search", wrapper.SearchProducts)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/products/{id}", wrapper.DeleteProductByID)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/products/{id}", wrapper.GetProductByID)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/products/{id}", wrapper.UpdateProduct)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/products/{productId}/comments", wrapper.ListProductComments)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/products/{productId}/comments", wrapper.CreateProductComment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/products/{productId}/comments/{commentId}", wrapper.DeleteProductComment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/products/{productId}/comments/{commentId}", wrapper.UpdateProductComment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/{id}", wrapper.GetUserByID)
-	})
+	// TagMatch Whether a product must carry any or all of the requested tags.
+	TagMatch *ExportProductsParamsTagMatch `form:"tagMatch,omitempty" json:"tagMatch,omitempty"`
 
-	return r
-}
+	// Category Only return products filed under this category or any of its subcategories.
+	Category *int64 `form:"category,omitempty" json:"category,omitempty"`
 
-type CreateProductRequestObject struct {
-	Body *CreateProductJSONRequestBody
-}
+	// MinPrice Inclusive lower price bound in cents.
+	MinPrice *int64 `form:"minPrice,omitempty" json:"minPrice,omitempty"`
 
-type CreateProductResponseObject interface {
-	VisitCreateProductResponse(w http.ResponseWriter) error
+	// MaxPrice Inclusive upper price bound in cents.
+	MaxPrice *int64 `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// Currency ISO-4217 code to quote prices in. Uses the product's override for that currency when set, otherwise converts at the current exchange rate; omitted means each product's own currency.
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// IncludeUnpublished Admin flag; set to true to include draft and archived products.
+	IncludeUnpublished *bool `form:"includeUnpublished,omitempty" json:"includeUnpublished,omitempty"`
 }
 
-type CreateProduct201JSONResponse Product
+// ExportProductsParamsFormat defines parameters for ExportProducts.
+type ExportProductsParamsFormat string
 
-func (response CreateProduct201JSONResponse) VisitCreateProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// ExportProductsParamsTagMatch defines parameters for ExportProducts.
+type ExportProductsParamsTagMatch string
 
-	return json.NewEncoder(w).Encode(response)
+// ImportProductsParams defines parameters for ImportProducts.
+type ImportProductsParams struct {
+	// DryRun Validate every row and report what would change without writing anything.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
 }
 
-type CreateProduct400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+// CreateReservationJSONBody defines parameters for CreateReservation.
+type CreateReservationJSONBody struct {
+	ProductId int64 `json:"productId"`
 
-func (response CreateProduct400JSONResponse) VisitCreateProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Quantity Units to hold; more than are available yields 409.
+	Quantity int64 `json:"quantity"`
 
-	return json.NewEncoder(w).Encode(response)
+	// VariantId Variant to reserve; omit to reserve the product's own stock.
+	VariantId *int64 `json:"variantId,omitempty"`
 }
 
-type SearchProductsRequestObject struct {
-	Params SearchProductsParams
-}
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	// Q Case-insensitive substring of the user's name or email.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
-type SearchProductsResponseObject interface {
-	VisitSearchProductsResponse(w http.ResponseWriter) error
-}
+	// CreatedFrom Only return users created at or after this instant.
+	CreatedFrom *time.Time `form:"createdFrom,omitempty" json:"createdFrom,omitempty"`
 
-type SearchProducts200JSONResponse ProductList
+	// CreatedTo Only return users created before this instant; must be later than createdFrom.
+	CreatedTo *time.Time `form:"createdTo,omitempty" json:"createdTo,omitempty"`
 
-func (response SearchProducts200JSONResponse) VisitSearchProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Sort Field used to order the results; ties are broken by id. name and email compare case-insensitively.
+	Sort *ListUsersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Order Sort direction; defaults to desc for relevance and asc otherwise.
+	Order    *ListUsersParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Page     *int                  `form:"page,omitempty" json:"page,omitempty"`
+	PageSize *int                  `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 
-type SearchProducts400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+	// Cursor Opaque cursor taken from nextCursor of the previous page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Set to false to skip counting; total and facets are then omitted.
+	IncludeTotal *bool `form:"includeTotal,omitempty" json:"includeTotal,omitempty"`
 }
 
-func (response SearchProducts400JSONResponse) VisitSearchProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ListUsersParamsSort defines parameters for ListUsers.
+type ListUsersParamsSort string
 
-	return json.NewEncoder(w).Encode(response)
-}
+// ListUsersParamsOrder defines parameters for ListUsers.
+type ListUsersParamsOrder string
 
-type DeleteProductByIDRequestObject struct {
-	Id int64 `json:"id"`
+// CreateUserJSONBody defines parameters for CreateUser.
+type CreateUserJSONBody struct {
+	// Email Unique across users, case-insensitively.
+	Email openapi_types.Email `json:"email"`
+	Name  string              `json:"name"`
 }
 
-type DeleteProductByIDResponseObject interface {
-	VisitDeleteProductByIDResponse(w http.ResponseWriter) error
+// UpdateUserJSONBody defines parameters for UpdateUser.
+type UpdateUserJSONBody struct {
+	// Email Unique across users, case-insensitively.
+	Email openapi_types.Email `json:"email"`
+	Name  string              `json:"name"`
 }
 
-type DeleteProductByID204Response struct {
-}
+// CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
+type CreateCategoryJSONRequestBody CreateCategoryJSONBody
 
-func (response DeleteProductByID204Response) VisitDeleteProductByIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// UpdateCategoryJSONRequestBody defines body for UpdateCategory for application/json ContentType.
+type UpdateCategoryJSONRequestBody UpdateCategoryJSONBody
 
-type DeleteProductByID400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+// CreateProductJSONRequestBody defines body for CreateProduct for application/json ContentType.
+type CreateProductJSONRequestBody CreateProductJSONBody
 
-func (response DeleteProductByID400JSONResponse) VisitDeleteProductByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// PatchProductApplicationMergePatchPlusJSONRequestBody defines body for PatchProduct for application/merge-patch+json ContentType.
+type PatchProductApplicationMergePatchPlusJSONRequestBody PatchProductApplicationMergePatchPlusJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// UpdateProductJSONRequestBody defines body for UpdateProduct for application/json ContentType.
+type UpdateProductJSONRequestBody UpdateProductJSONBody
 
-type DeleteProductByID404JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+// UploadProductImageMultipartRequestBody defines body for UploadProductImage for multipart/form-data ContentType.
+type UploadProductImageMultipartRequestBody UploadProductImageMultipartBody
 
-func (response DeleteProductByID404JSONResponse) VisitDeleteProductByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// UpdateProductImageJSONRequestBody defines body for UpdateProductImage for application/json ContentType.
+type UpdateProductImageJSONRequestBody UpdateProductImageJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// ScheduleProductPriceJSONRequestBody defines body for ScheduleProductPrice for application/json ContentType.
+type ScheduleProductPriceJSONRequestBody ScheduleProductPriceJSONBody
 
-type GetProductByIDRequestObject struct {
-	Id int64 `json:"id"`
-}
+// AdjustProductStockJSONRequestBody defines body for AdjustProductStock for application/json ContentType.
+type AdjustProductStockJSONRequestBody AdjustProductStockJSONBody
 
-type GetProductByIDResponseObject interface {
-	VisitGetProductByIDResponse(w http.ResponseWriter) error
-}
+// AddProductTagJSONRequestBody defines body for AddProductTag for application/json ContentType.
+type AddProductTagJSONRequestBody AddProductTagJSONBody
 
-type GetProductByID200JSONResponse Product
+// PutProductTranslationJSONRequestBody defines body for PutProductTranslation for application/json ContentType.
+type PutProductTranslationJSONRequestBody PutProductTranslationJSONBody
 
-func (response GetProductByID200JSONResponse) VisitGetProductByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// CreateProductVariantJSONRequestBody defines body for CreateProductVariant for application/json ContentType.
+type CreateProductVariantJSONRequestBody CreateProductVariantJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// UpdateProductVariantJSONRequestBody defines body for UpdateProductVariant for application/json ContentType.
+type UpdateProductVariantJSONRequestBody UpdateProductVariantJSONBody
 
-type GetProductByID400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+// CreateProductCommentJSONRequestBody defines body for CreateProductComment for application/json ContentType.
+type CreateProductCommentJSONRequestBody CreateProductCommentJSONBody
 
-func (response GetProductByID400JSONResponse) VisitGetProductByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// UpdateProductCommentJSONRequestBody defines body for UpdateProductComment for application/json ContentType.
+type UpdateProductCommentJSONRequestBody UpdateProductCommentJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// CreateReservationJSONRequestBody defines body for CreateReservation for application/json ContentType.
+type CreateReservationJSONRequestBody CreateReservationJSONBody
 
-type GetProductByID404JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody CreateUserJSONBody
 
-func (response GetProductByID404JSONResponse) VisitGetProductByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody UpdateUserJSONBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
 
-type UpdateProductRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *UpdateProductJSONRequestBody
-}
+	// (GET /categories)
+	ListCategories(w http.ResponseWriter, r *http.Request)
 
-type UpdateProductResponseObject interface {
-	VisitUpdateProductResponse(w http.ResponseWriter) error
-}
+	// (POST /categories)
+	CreateCategory(w http.ResponseWriter, r *http.Request)
 
-type UpdateProduct200JSONResponse Product
+	// (DELETE /categories/{id})
+	DeleteCategory(w http.ResponseWriter, r *http.Request, id int64, params DeleteCategoryParams)
 
-func (response UpdateProduct200JSONResponse) VisitUpdateProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (GET /categories/{id})
+	GetCategory(w http.ResponseWriter, r *http.Request, id int64)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (PUT /categories/{id})
+	UpdateCategory(w http.ResponseWriter, r *http.Request, id int64, params UpdateCategoryParams)
 
-type UpdateProduct400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+	// (POST /products)
+	CreateProduct(w http.ResponseWriter, r *http.Request)
 
-func (response UpdateProduct400JSONResponse) VisitUpdateProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (GET /products/by-slug/{slug})
+	GetProductBySlug(w http.ResponseWriter, r *http.Request, slug string, params GetProductBySlugParams)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /products/search)
+	SearchProducts(w http.ResponseWriter, r *http.Request, params SearchProductsParams)
 
-type UpdateProduct404JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+	// (GET /products/suggest)
+	SuggestProducts(w http.ResponseWriter, r *http.Request, params SuggestProductsParams)
 
-func (response UpdateProduct404JSONResponse) VisitUpdateProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// (GET /products/trash)
+	ListTrashedProducts(w http.ResponseWriter, r *http.Request, params ListTrashedProductsParams)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /products/{id})
+	DeleteProductByID(w http.ResponseWriter, r *http.Request, id int64, params DeleteProductByIDParams)
 
-type ListProductCommentsRequestObject struct {
-	ProductId int64 `json:"productId"`
-}
+	// (GET /products/{id})
+	GetProductByID(w http.ResponseWriter, r *http.Request, id int64, params GetProductByIDParams)
 
-type ListProductCommentsResponseObject interface {
-	VisitListProductCommentsResponse(w http.ResponseWriter) error
-}
+	// (PATCH /products/{id})
+	PatchProduct(w http.ResponseWriter, r *http.Request, id int64, params PatchProductParams)
 
-type ListProductComments200JSONResponse CommentList
+	// (PUT /products/{id})
+	UpdateProduct(w http.ResponseWriter, r *http.Request, id int64, params UpdateProductParams)
 
-func (response ListProductComments200JSONResponse) VisitListProductCommentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (POST /products/{id}/archive)
+	ArchiveProduct(w http.ResponseWriter, r *http.Request, id int64, params ArchiveProductParams)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /products/{id}/images)
+	ListProductImages(w http.ResponseWriter, r *http.Request, id int64)
 
-type ListProductComments400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+	// (POST /products/{id}/images)
+	UploadProductImage(w http.ResponseWriter, r *http.Request, id int64)
 
-func (response ListProductComments400JSONResponse) VisitListProductCommentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (DELETE /products/{id}/images/{imageId})
+	DeleteProductImage(w http.ResponseWriter, r *http.Request, id int64, imageId int64)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /products/{id}/images/{imageId})
+	GetProductImage(w http.ResponseWriter, r *http.Request, id int64, imageId int64)
 
-type ListProductComments404JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+	// (PUT /products/{id}/images/{imageId})
+	UpdateProductImage(w http.ResponseWriter, r *http.Request, id int64, imageId int64)
 
-func (response ListProductComments404JSONResponse) VisitListProductCommentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// (GET /products/{id}/images/{imageId}/content)
+	GetProductImageContent(w http.ResponseWriter, r *http.Request, id int64, imageId int64, params GetProductImageContentParams)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /products/{id}/price-history)
+	ListProductPriceHistory(w http.ResponseWriter, r *http.Request, id int64)
 
-type CreateProductCommentRequestObject struct {
-	ProductId int64 `json:"productId"`
-	Body      *CreateProductCommentJSONRequestBody
-}
+	// (POST /products/{id}/price-history)
+	ScheduleProductPrice(w http.ResponseWriter, r *http.Request, id int64)
 
-type CreateProductCommentResponseObject interface {
-	VisitCreateProductCommentResponse(w http.ResponseWriter) error
-}
+	// (POST /products/{id}/publish)
+	PublishProduct(w http.ResponseWriter, r *http.Request, id int64, params PublishProductParams)
 
-type CreateProductComment201JSONResponse Comment
+	// (GET /products/{id}/related)
+	ListRelatedProducts(w http.ResponseWriter, r *http.Request, id int64, params ListRelatedProductsParams)
 
-func (response CreateProductComment201JSONResponse) VisitCreateProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// (POST /products/{id}/restore)
+	RestoreProduct(w http.ResponseWriter, r *http.Request, id int64)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /products/{id}/stock)
+	GetProductStock(w http.ResponseWriter, r *http.Request, id int64)
 
-type CreateProductComment400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
-}
+	// (POST /products/{id}/stock/adjustments)
+	AdjustProductStock(w http.ResponseWriter, r *http.Request, id int64)
 
-func (response CreateProductComment400JSONResponse) VisitCreateProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (POST /products/{id}/tags)
+	AddProductTag(w http.ResponseWriter, r *http.Request, id int64, params AddProductTagParams)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /products/{id}/tags/{tag})
+	RemoveProductTag(w http.ResponseWriter, r *http.Request, id int64, tag string, params RemoveProductTagParams)
 
-type CreateProductComment404JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+	// (GET /products/{id}/translations)
+	ListProductTranslations(w http.ResponseWriter, r *http.Request, id int64)
+
+	// (DELETE /products/{id}/translations/{locale})
+	DeleteProductTranslation(w http.ResponseWriter, r *http.Request, id int64, locale string)
+
+	// (PUT /products/{id}/translations/{locale})
+	PutProductTranslation(w http.ResponseWriter, r *http.Request, id int64, locale string)
+
+	// (POST /products/{id}/unarchive)
+	UnarchiveProduct(w http.ResponseWriter, r *http.Request, id int64, params UnarchiveProductParams)
+
+	// (GET /products/{id}/variants)
+	ListProductVariants(w http.ResponseWriter, r *http.Request, id int64)
+
+	// (POST /products/{id}/variants)
+	CreateProductVariant(w http.ResponseWriter, r *http.Request, id int64)
+
+	// (DELETE /products/{id}/variants/{variantId})
+	DeleteProductVariant(w http.ResponseWriter, r *http.Request, id int64, variantId int64, params DeleteProductVariantParams)
+
+	// (GET /products/{id}/variants/{variantId})
+	GetProductVariant(w http.ResponseWriter, r *http.Request, id int64, variantId int64)
+
+	// (PUT /products/{id}/variants/{variantId})
+	UpdateProductVariant(w http.ResponseWriter, r *http.Request, id int64, variantId int64, params UpdateProductVariantParams)
+
+	// (GET /products/{productId}/comments)
+	ListProductComments(w http.ResponseWriter, r *http.Request, productId int64, params ListProductCommentsParams)
+
+	// (POST /products/{productId}/comments)
+	CreateProductComment(w http.ResponseWriter, r *http.Request, productId int64)
+
+	// (DELETE /products/{productId}/comments/{commentId})
+	DeleteProductComment(w http.ResponseWriter, r *http.Request, productId int64, commentId int64, params DeleteProductCommentParams)
+
+	// (PUT /products/{productId}/comments/{commentId})
+	UpdateProductComment(w http.ResponseWriter, r *http.Request, productId int64, commentId int64, params UpdateProductCommentParams)
+
+	// (GET /products:export)
+	ExportProducts(w http.ResponseWriter, r *http.Request, params ExportProductsParams)
+
+	// (POST /products:import)
+	ImportProducts(w http.ResponseWriter, r *http.Request, params ImportProductsParams)
+
+	// (POST /reservations)
+	CreateReservation(w http.ResponseWriter, r *http.Request)
+
+	// (GET /reservations/{id})
+	GetReservation(w http.ResponseWriter, r *http.Request, id int64)
+
+	// (POST /reservations/{id}/commit)
+	CommitReservation(w http.ResponseWriter, r *http.Request, id int64)
+
+	// (POST /reservations/{id}/release)
+	ReleaseReservation(w http.ResponseWriter, r *http.Request, id int64)
+
+	// (GET /users)
+	ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams)
+
+	// (POST /users)
+	CreateUser(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /users/{id})
+	DeleteUser(w http.ResponseWriter, r *http.Request, id int64)
+
+	// (GET /users/{id})
+	GetUserByID(w http.ResponseWriter, r *http.Request, id int64)
+
+	// (PUT /users/{id})
+	UpdateUser(w http.ResponseWriter, r *http.Request, id int64)
 }
 
-func (response CreateProductComment404JSONResponse) VisitCreateProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
-	return json.NewEncoder(w).Encode(response)
+type Unimplemented struct{}
+
+// (GET /categories)
+func (_ Unimplemented) ListCategories(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteProductCommentRequestObject struct {
-	ProductId int64 `json:"productId"`
-	CommentId int64 `json:"commentId"`
-	Params    DeleteProductCommentParams
+// (POST /categories)
+func (_ Unimplemented) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteProductCommentResponseObject interface {
-	VisitDeleteProductCommentResponse(w http.ResponseWriter) error
+// (DELETE /categories/{id})
+func (_ Unimplemented) DeleteCategory(w http.ResponseWriter, r *http.Request, id int64, params DeleteCategoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteProductComment204Response struct {
+// (GET /categories/{id})
+func (_ Unimplemented) GetCategory(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response DeleteProductComment204Response) VisitDeleteProductCommentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+// (PUT /categories/{id})
+func (_ Unimplemented) UpdateCategory(w http.ResponseWriter, r *http.Request, id int64, params UpdateCategoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteProductComment400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+// (POST /products)
+func (_ Unimplemented) CreateProduct(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response DeleteProductComment400JSONResponse) VisitDeleteProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// (GET /products/by-slug/{slug})
+func (_ Unimplemented) GetProductBySlug(w http.ResponseWriter, r *http.Request, slug string, params GetProductBySlugParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (GET /products/search)
+func (_ Unimplemented) SearchProducts(w http.ResponseWriter, r *http.Request, params SearchProductsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteProductComment403JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+// (GET /products/suggest)
+func (_ Unimplemented) SuggestProducts(w http.ResponseWriter, r *http.Request, params SuggestProductsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response DeleteProductComment403JSONResponse) VisitDeleteProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// (GET /products/trash)
+func (_ Unimplemented) ListTrashedProducts(w http.ResponseWriter, r *http.Request, params ListTrashedProductsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (DELETE /products/{id})
+func (_ Unimplemented) DeleteProductByID(w http.ResponseWriter, r *http.Request, id int64, params DeleteProductByIDParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type DeleteProductComment404JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+// (GET /products/{id})
+func (_ Unimplemented) GetProductByID(w http.ResponseWriter, r *http.Request, id int64, params GetProductByIDParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response DeleteProductComment404JSONResponse) VisitDeleteProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// (PATCH /products/{id})
+func (_ Unimplemented) PatchProduct(w http.ResponseWriter, r *http.Request, id int64, params PatchProductParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (PUT /products/{id})
+func (_ Unimplemented) UpdateProduct(w http.ResponseWriter, r *http.Request, id int64, params UpdateProductParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UpdateProductCommentRequestObject struct {
-	ProductId int64 `json:"productId"`
-	CommentId int64 `json:"commentId"`
-	Params    UpdateProductCommentParams
-	Body      *UpdateProductCommentJSONRequestBody
+// (POST /products/{id}/archive)
+func (_ Unimplemented) ArchiveProduct(w http.ResponseWriter, r *http.Request, id int64, params ArchiveProductParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UpdateProductCommentResponseObject interface {
-	VisitUpdateProductCommentResponse(w http.ResponseWriter) error
+// (GET /products/{id}/images)
+func (_ Unimplemented) ListProductImages(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UpdateProductComment200JSONResponse Comment
+// (POST /products/{id}/images)
+func (_ Unimplemented) UploadProductImage(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response UpdateProductComment200JSONResponse) VisitUpdateProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// (DELETE /products/{id}/images/{imageId})
+func (_ Unimplemented) DeleteProductImage(w http.ResponseWriter, r *http.Request, id int64, imageId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (GET /products/{id}/images/{imageId})
+func (_ Unimplemented) GetProductImage(w http.ResponseWriter, r *http.Request, id int64, imageId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UpdateProductComment400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+// (PUT /products/{id}/images/{imageId})
+func (_ Unimplemented) UpdateProductImage(w http.ResponseWriter, r *http.Request, id int64, imageId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response UpdateProductComment400JSONResponse) VisitUpdateProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// (GET /products/{id}/images/{imageId}/content)
+func (_ Unimplemented) GetProductImageContent(w http.ResponseWriter, r *http.Request, id int64, imageId int64, params GetProductImageContentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (GET /products/{id}/price-history)
+func (_ Unimplemented) ListProductPriceHistory(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UpdateProductComment403JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+// (POST /products/{id}/price-history)
+func (_ Unimplemented) ScheduleProductPrice(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response UpdateProductComment403JSONResponse) VisitUpdateProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// (POST /products/{id}/publish)
+func (_ Unimplemented) PublishProduct(w http.ResponseWriter, r *http.Request, id int64, params PublishProductParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (GET /products/{id}/related)
+func (_ Unimplemented) ListRelatedProducts(w http.ResponseWriter, r *http.Request, id int64, params ListRelatedProductsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UpdateProductComment404JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+// (POST /products/{id}/restore)
+func (_ Unimplemented) RestoreProduct(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response UpdateProductComment404JSONResponse) VisitUpdateProductCommentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// (GET /products/{id}/stock)
+func (_ Unimplemented) GetProductStock(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (POST /products/{id}/stock/adjustments)
+func (_ Unimplemented) AdjustProductStock(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetUserByIDRequestObject struct {
-	Id int64 `json:"id"`
+// (POST /products/{id}/tags)
+func (_ Unimplemented) AddProductTag(w http.ResponseWriter, r *http.Request, id int64, params AddProductTagParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetUserByIDResponseObject interface {
-	VisitGetUserByIDResponse(w http.ResponseWriter) error
+// (DELETE /products/{id}/tags/{tag})
+func (_ Unimplemented) RemoveProductTag(w http.ResponseWriter, r *http.Request, id int64, tag string, params RemoveProductTagParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetUserByID200JSONResponse User
+// (GET /products/{id}/translations)
+func (_ Unimplemented) ListProductTranslations(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-func (response GetUserByID200JSONResponse) VisitGetUserByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// (DELETE /products/{id}/translations/{locale})
+func (_ Unimplemented) DeleteProductTranslation(w http.ResponseWriter, r *http.Request, id int64, locale string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (PUT /products/{id}/translations/{locale})
+func (_ Unimplemented) PutProductTranslation(w http.ResponseWriter, r *http.Request, id int64, locale string) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetUserByID400JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+// (POST /products/{id}/unarchive)
+func (_ Unimplemented) UnarchiveProduct(w http.ResponseWriter, r *http.Request, id int64, params UnarchiveProductParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response GetUserByID400JSONResponse) VisitGetUserByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// (GET /products/{id}/variants)
+func (_ Unimplemented) ListProductVariants(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (POST /products/{id}/variants)
+func (_ Unimplemented) CreateProductVariant(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetUserByID404JSONResponse struct {
-	Code    string `json:"code"`
-	Details *[]struct {
-		Field  *string `json:"field,omitempty"`
-		Reason *string `json:"reason,omitempty"`
-	} `json:"details,omitempty"`
-	Message string `json:"message"`
+// (DELETE /products/{id}/variants/{variantId})
+func (_ Unimplemented) DeleteProductVariant(w http.ResponseWriter, r *http.Request, id int64, variantId int64, params DeleteProductVariantParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (response GetUserByID404JSONResponse) VisitGetUserByIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// (GET /products/{id}/variants/{variantId})
+func (_ Unimplemented) GetProductVariant(w http.ResponseWriter, r *http.Request, id int64, variantId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// (PUT /products/{id}/variants/{variantId})
+func (_ Unimplemented) UpdateProductVariant(w http.ResponseWriter, r *http.Request, id int64, variantId int64, params UpdateProductVariantParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+// (GET /products/{productId}/comments)
+func (_ Unimplemented) ListProductComments(w http.ResponseWriter, r *http.Request, productId int64, params ListProductCommentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// (POST /products)
-	CreateProduct(ctx context.Context, request CreateProductRequestObject) (CreateProductResponseObject, error)
+// (POST /products/{productId}/comments)
+func (_ Unimplemented) CreateProductComment(w http.ResponseWriter, r *http.Request, productId int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// (GET /products/search)
-	SearchProducts(ctx context.Context, request SearchProductsRequestObject) (SearchProductsResponseObject, error)
+// (DELETE /products/{productId}/comments/{commentId})
+func (_ Unimplemented) DeleteProductComment(w http.ResponseWriter, r *http.Request, productId int64, commentId int64, params DeleteProductCommentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// (DELETE /products/{id})
-	DeleteProductByID(ctx context.Context, request DeleteProductByIDRequestObject) (DeleteProductByIDResponseObject, error)
+// (PUT /products/{productId}/comments/{commentId})
+func (_ Unimplemented) UpdateProductComment(w http.ResponseWriter, r *http.Request, productId int64, commentId int64, params UpdateProductCommentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// (GET /products/{id})
-	GetProductByID(ctx context.Context, request GetProductByIDRequestObject) (GetProductByIDResponseObject, error)
+// (GET /products:export)
+func (_ Unimplemented) ExportProducts(w http.ResponseWriter, r *http.Request, params ExportProductsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// (PUT /products/{id})
-	UpdateProduct(ctx context.Context, request UpdateProductRequestObject) (UpdateProductResponseObject, error)
+// (POST /products:import)
+func (_ Unimplemented) ImportProducts(w http.ResponseWriter, r *http.Request, params ImportProductsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /reservations)
+func (_ Unimplemented) CreateReservation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /reservations/{id})
+func (_ Unimplemented) GetReservation(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /reservations/{id}/commit)
+func (_ Unimplemented) CommitReservation(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /reservations/{id}/release)
+func (_ Unimplemented) ReleaseReservation(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users)
+func (_ Unimplemented) ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users)
+func (_ Unimplemented) CreateUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /users/{id})
+func (_ Unimplemented) DeleteUser(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/{id})
+func (_ Unimplemented) GetUserByID(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /users/{id})
+func (_ Unimplemented) UpdateUser(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListCategories operation middleware
+func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCategories(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCategory operation middleware
+func (siw *ServerInterfaceWrapper) CreateCategory(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCategory(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCategory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteCategoryParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCategory(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCategory operation middleware
+func (siw *ServerInterfaceWrapper) GetCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCategory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCategory operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateCategoryParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCategory(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProduct operation middleware
+func (siw *ServerInterfaceWrapper) CreateProduct(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProduct(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProductBySlug operation middleware
+func (siw *ServerInterfaceWrapper) GetProductBySlug(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductBySlugParams
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept-Language", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept-Language", Err: err})
+			return
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductBySlug(w, r, slug, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchProducts operation middleware
+func (siw *ServerInterfaceWrapper) SearchProducts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchProductsParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tagMatch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagMatch", r.URL.Query(), &params.TagMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagMatch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minPrice", r.URL.Query(), &params.MinPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPrice", r.URL.Query(), &params.MaxPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeTotal" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeTotal", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeTotal", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeUnpublished" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeUnpublished", r.URL.Query(), &params.IncludeUnpublished)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeUnpublished", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept-Language", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept-Language", Err: err})
+			return
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchProducts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SuggestProducts operation middleware
+func (siw *ServerInterfaceWrapper) SuggestProducts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SuggestProductsParams
+
+	// ------------- Required query parameter "prefix" -------------
+
+	if paramValue := r.URL.Query().Get("prefix"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "prefix"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "prefix", r.URL.Query(), &params.Prefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prefix", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SuggestProducts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTrashedProducts operation middleware
+func (siw *ServerInterfaceWrapper) ListTrashedProducts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTrashedProductsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTrashedProducts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProductByID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProductByIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProductByID(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProductByID operation middleware
+func (siw *ServerInterfaceWrapper) GetProductByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductByIDParams
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept-Language", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept-Language", Err: err})
+			return
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductByID(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchProduct operation middleware
+func (siw *ServerInterfaceWrapper) PatchProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchProductParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchProduct(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProduct operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateProductParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProduct(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ArchiveProduct operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ArchiveProductParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ArchiveProduct(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProductImages operation middleware
+func (siw *ServerInterfaceWrapper) ListProductImages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProductImages(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadProductImage operation middleware
+func (siw *ServerInterfaceWrapper) UploadProductImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadProductImage(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProductImage operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", chi.URLParam(r, "imageId"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProductImage(w, r, id, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProductImage operation middleware
+func (siw *ServerInterfaceWrapper) GetProductImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", chi.URLParam(r, "imageId"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductImage(w, r, id, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProductImage operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProductImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", chi.URLParam(r, "imageId"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProductImage(w, r, id, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProductImageContent operation middleware
+func (siw *ServerInterfaceWrapper) GetProductImageContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", chi.URLParam(r, "imageId"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductImageContentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Range", valueList[0], &Range, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
+	// ------------- Optional header parameter "If-Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Range")]; found {
+		var IfRange string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Range", valueList[0], &IfRange, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Range", Err: err})
+			return
+		}
+
+		params.IfRange = &IfRange
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductImageContent(w, r, id, imageId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProductPriceHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListProductPriceHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProductPriceHistory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ScheduleProductPrice operation middleware
+func (siw *ServerInterfaceWrapper) ScheduleProductPrice(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ScheduleProductPrice(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PublishProduct operation middleware
+func (siw *ServerInterfaceWrapper) PublishProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PublishProductParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishProduct(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRelatedProducts operation middleware
+func (siw *ServerInterfaceWrapper) ListRelatedProducts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRelatedProductsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeOrders" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeOrders", r.URL.Query(), &params.IncludeOrders)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeOrders", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRelatedProducts(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreProduct operation middleware
+func (siw *ServerInterfaceWrapper) RestoreProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreProduct(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProductStock operation middleware
+func (siw *ServerInterfaceWrapper) GetProductStock(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductStock(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdjustProductStock operation middleware
+func (siw *ServerInterfaceWrapper) AdjustProductStock(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdjustProductStock(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddProductTag operation middleware
+func (siw *ServerInterfaceWrapper) AddProductTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddProductTagParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddProductTag(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveProductTag operation middleware
+func (siw *ServerInterfaceWrapper) RemoveProductTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tag" -------------
+	var tag string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag", chi.URLParam(r, "tag"), &tag, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveProductTagParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveProductTag(w, r, id, tag, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProductTranslations operation middleware
+func (siw *ServerInterfaceWrapper) ListProductTranslations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProductTranslations(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProductTranslation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductTranslation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "locale" -------------
+	var locale string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "locale", chi.URLParam(r, "locale"), &locale, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProductTranslation(w, r, id, locale)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutProductTranslation operation middleware
+func (siw *ServerInterfaceWrapper) PutProductTranslation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "locale" -------------
+	var locale string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "locale", chi.URLParam(r, "locale"), &locale, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutProductTranslation(w, r, id, locale)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnarchiveProduct operation middleware
+func (siw *ServerInterfaceWrapper) UnarchiveProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnarchiveProductParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnarchiveProduct(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProductVariants operation middleware
+func (siw *ServerInterfaceWrapper) ListProductVariants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProductVariants(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProductVariant operation middleware
+func (siw *ServerInterfaceWrapper) CreateProductVariant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProductVariant(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProductVariant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductVariant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "variantId" -------------
+	var variantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "variantId", chi.URLParam(r, "variantId"), &variantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variantId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProductVariantParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProductVariant(w, r, id, variantId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProductVariant operation middleware
+func (siw *ServerInterfaceWrapper) GetProductVariant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "variantId" -------------
+	var variantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "variantId", chi.URLParam(r, "variantId"), &variantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variantId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductVariant(w, r, id, variantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProductVariant operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProductVariant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "variantId" -------------
+	var variantId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "variantId", chi.URLParam(r, "variantId"), &variantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variantId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateProductVariantParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProductVariant(w, r, id, variantId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProductComments operation middleware
+func (siw *ServerInterfaceWrapper) ListProductComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProductCommentsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProductComments(w, r, productId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProductComment operation middleware
+func (siw *ServerInterfaceWrapper) CreateProductComment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProductComment(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProductComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductComment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProductCommentParams
+
+	// ------------- Required query parameter "userId" -------------
+
+	if paramValue := r.URL.Query().Get("userId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "userId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "userId", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProductComment(w, r, productId, commentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProductComment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProductComment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateProductCommentParams
+
+	// ------------- Required query parameter "userId" -------------
+
+	if paramValue := r.URL.Query().Get("userId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "userId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "userId", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProductComment(w, r, productId, commentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportProducts operation middleware
+func (siw *ServerInterfaceWrapper) ExportProducts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportProductsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tagMatch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagMatch", r.URL.Query(), &params.TagMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagMatch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minPrice", r.URL.Query(), &params.MinPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPrice", r.URL.Query(), &params.MaxPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeUnpublished" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeUnpublished", r.URL.Query(), &params.IncludeUnpublished)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeUnpublished", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportProducts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportProducts operation middleware
+func (siw *ServerInterfaceWrapper) ImportProducts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportProductsParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportProducts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateReservation operation middleware
+func (siw *ServerInterfaceWrapper) CreateReservation(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateReservation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReservation operation middleware
+func (siw *ServerInterfaceWrapper) GetReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReservation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CommitReservation operation middleware
+func (siw *ServerInterfaceWrapper) CommitReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CommitReservation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReleaseReservation operation middleware
+func (siw *ServerInterfaceWrapper) ReleaseReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReleaseReservation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdFrom", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdFrom", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdTo", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdTo", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeTotal" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeTotal", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeTotal", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserByID operation middleware
+func (siw *ServerInterfaceWrapper) GetUserByID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserByID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/categories", wrapper.ListCategories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/categories", wrapper.CreateCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/categories/{id}", wrapper.DeleteCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/categories/{id}", wrapper.GetCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/categories/{id}", wrapper.UpdateCategory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products", wrapper.CreateProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/by-slug/{slug}", wrapper.GetProductBySlug)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/search", wrapper.SearchProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/suggest", wrapper.SuggestProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/trash", wrapper.ListTrashedProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/products/{id}", wrapper.DeleteProductByID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}", wrapper.GetProductByID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/products/{id}", wrapper.PatchProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/products/{id}", wrapper.UpdateProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{id}/archive", wrapper.ArchiveProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}/images", wrapper.ListProductImages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{id}/images", wrapper.UploadProductImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/products/{id}/images/{imageId}", wrapper.DeleteProductImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}/images/{imageId}", wrapper.GetProductImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/products/{id}/images/{imageId}", wrapper.UpdateProductImage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}/images/{imageId}/content", wrapper.GetProductImageContent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}/price-history", wrapper.ListProductPriceHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{id}/price-history", wrapper.ScheduleProductPrice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{id}/publish", wrapper.PublishProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}/related", wrapper.ListRelatedProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{id}/restore", wrapper.RestoreProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}/stock", wrapper.GetProductStock)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{id}/stock/adjustments", wrapper.AdjustProductStock)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{id}/tags", wrapper.AddProductTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/products/{id}/tags/{tag}", wrapper.RemoveProductTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}/translations", wrapper.ListProductTranslations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/products/{id}/translations/{locale}", wrapper.DeleteProductTranslation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/products/{id}/translations/{locale}", wrapper.PutProductTranslation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{id}/unarchive", wrapper.UnarchiveProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}/variants", wrapper.ListProductVariants)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{id}/variants", wrapper.CreateProductVariant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/products/{id}/variants/{variantId}", wrapper.DeleteProductVariant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{id}/variants/{variantId}", wrapper.GetProductVariant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/products/{id}/variants/{variantId}", wrapper.UpdateProductVariant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{productId}/comments", wrapper.ListProductComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{productId}/comments", wrapper.CreateProductComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/products/{productId}/comments/{commentId}", wrapper.DeleteProductComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/products/{productId}/comments/{commentId}", wrapper.UpdateProductComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products:export", wrapper.ExportProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products:import", wrapper.ImportProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reservations", wrapper.CreateReservation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reservations/{id}", wrapper.GetReservation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reservations/{id}/commit", wrapper.CommitReservation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reservations/{id}/release", wrapper.ReleaseReservation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users", wrapper.ListUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users", wrapper.CreateUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{id}", wrapper.DeleteUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}", wrapper.GetUserByID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{id}", wrapper.UpdateUser)
+	})
+
+	return r
+}
+
+type ListCategoriesRequestObject struct {
+}
+
+type ListCategoriesResponseObject interface {
+	VisitListCategoriesResponse(w http.ResponseWriter) error
+}
+
+type ListCategories200JSONResponse CategoryList
+
+func (response ListCategories200JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategoryRequestObject struct {
+	Body *CreateCategoryJSONRequestBody
+}
+
+type CreateCategoryResponseObject interface {
+	VisitCreateCategoryResponse(w http.ResponseWriter) error
+}
+
+type CreateCategory201ResponseHeaders struct {
+	ETag string
+}
+
+type CreateCategory201JSONResponse struct {
+	Body    Category
+	Headers CreateCategory201ResponseHeaders
+}
+
+func (response CreateCategory201JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateCategory400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateCategory400JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategory409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateCategory409JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoryRequestObject struct {
+	Id     int64 `json:"id"`
+	Params DeleteCategoryParams
+}
+
+type DeleteCategoryResponseObject interface {
+	VisitDeleteCategoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteCategory204Response struct {
+}
+
+func (response DeleteCategory204Response) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCategory400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteCategory400JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategory404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteCategory404JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategory409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteCategory409JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategory412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteCategory412JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoryRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetCategoryResponseObject interface {
+	VisitGetCategoryResponse(w http.ResponseWriter) error
+}
+
+type GetCategory200ResponseHeaders struct {
+	ETag string
+}
+
+type GetCategory200JSONResponse struct {
+	Body    Category
+	Headers GetCategory200ResponseHeaders
+}
+
+func (response GetCategory200JSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCategory400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetCategory400JSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategory404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetCategory404JSONResponse) VisitGetCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategoryRequestObject struct {
+	Id     int64 `json:"id"`
+	Params UpdateCategoryParams
+	Body   *UpdateCategoryJSONRequestBody
+}
+
+type UpdateCategoryResponseObject interface {
+	VisitUpdateCategoryResponse(w http.ResponseWriter) error
+}
+
+type UpdateCategory200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateCategory200JSONResponse struct {
+	Body    Category
+	Headers UpdateCategory200ResponseHeaders
+}
+
+func (response UpdateCategory200JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCategory400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateCategory400JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateCategory404JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateCategory409JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateCategory412JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProductRequestObject struct {
+	Body *CreateProductJSONRequestBody
+}
+
+type CreateProductResponseObject interface {
+	VisitCreateProductResponse(w http.ResponseWriter) error
+}
+
+type CreateProduct201ResponseHeaders struct {
+	ETag string
+}
+
+type CreateProduct201JSONResponse struct {
+	Body    Product
+	Headers CreateProduct201ResponseHeaders
+}
+
+func (response CreateProduct201JSONResponse) VisitCreateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateProduct400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateProduct400JSONResponse) VisitCreateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductBySlugRequestObject struct {
+	Slug   string `json:"slug"`
+	Params GetProductBySlugParams
+}
+
+type GetProductBySlugResponseObject interface {
+	VisitGetProductBySlugResponse(w http.ResponseWriter) error
+}
+
+type GetProductBySlug200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+	Vary         string
+}
+
+type GetProductBySlug200JSONResponse struct {
+	Body    Product
+	Headers GetProductBySlug200ResponseHeaders
+}
+
+func (response GetProductBySlug200JSONResponse) VisitGetProductBySlugResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetProductBySlug301ResponseHeaders struct {
+	Location string
+}
+
+type GetProductBySlug301JSONResponse struct {
+	Body    SlugRedirect
+	Headers GetProductBySlug301ResponseHeaders
+}
+
+func (response GetProductBySlug301JSONResponse) VisitGetProductBySlugResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(301)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetProductBySlug304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+	Vary         string
+}
+
+type GetProductBySlug304Response struct {
+	Headers GetProductBySlug304ResponseHeaders
+}
+
+func (response GetProductBySlug304Response) VisitGetProductBySlugResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetProductBySlug400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductBySlug400JSONResponse) VisitGetProductBySlugResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductBySlug404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductBySlug404JSONResponse) VisitGetProductBySlugResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchProductsRequestObject struct {
+	Params SearchProductsParams
+}
+
+type SearchProductsResponseObject interface {
+	VisitSearchProductsResponse(w http.ResponseWriter) error
+}
+
+type SearchProducts200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	Vary         string
+}
+
+type SearchProducts200JSONResponse struct {
+	Body    ProductList
+	Headers SearchProducts200ResponseHeaders
+}
+
+func (response SearchProducts200JSONResponse) VisitSearchProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SearchProducts304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	Vary         string
+}
+
+type SearchProducts304Response struct {
+	Headers SearchProducts304ResponseHeaders
+}
+
+func (response SearchProducts304Response) VisitSearchProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.WriteHeader(304)
+	return nil
+}
+
+type SearchProducts400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response SearchProducts400JSONResponse) VisitSearchProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestProductsRequestObject struct {
+	Params SuggestProductsParams
+}
+
+type SuggestProductsResponseObject interface {
+	VisitSuggestProductsResponse(w http.ResponseWriter) error
+}
+
+type SuggestProducts200JSONResponse SuggestionList
+
+func (response SuggestProducts200JSONResponse) VisitSuggestProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestProducts400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response SuggestProducts400JSONResponse) VisitSuggestProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrashedProductsRequestObject struct {
+	Params ListTrashedProductsParams
+}
+
+type ListTrashedProductsResponseObject interface {
+	VisitListTrashedProductsResponse(w http.ResponseWriter) error
+}
+
+type ListTrashedProducts200JSONResponse ProductList
+
+func (response ListTrashedProducts200JSONResponse) VisitListTrashedProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrashedProducts400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListTrashedProducts400JSONResponse) VisitListTrashedProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductByIDRequestObject struct {
+	Id     int64 `json:"id"`
+	Params DeleteProductByIDParams
+}
+
+type DeleteProductByIDResponseObject interface {
+	VisitDeleteProductByIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteProductByID204Response struct {
+}
+
+func (response DeleteProductByID204Response) VisitDeleteProductByIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProductByID400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductByID400JSONResponse) VisitDeleteProductByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductByID404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductByID404JSONResponse) VisitDeleteProductByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductByID412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductByID412JSONResponse) VisitDeleteProductByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductByIDRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetProductByIDParams
+}
+
+type GetProductByIDResponseObject interface {
+	VisitGetProductByIDResponse(w http.ResponseWriter) error
+}
+
+type GetProductByID200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+	Vary         string
+}
+
+type GetProductByID200JSONResponse struct {
+	Body    Product
+	Headers GetProductByID200ResponseHeaders
+}
+
+func (response GetProductByID200JSONResponse) VisitGetProductByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetProductByID304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+	Vary         string
+}
+
+type GetProductByID304Response struct {
+	Headers GetProductByID304ResponseHeaders
+}
+
+func (response GetProductByID304Response) VisitGetProductByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("Vary", fmt.Sprint(response.Headers.Vary))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetProductByID400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductByID400JSONResponse) VisitGetProductByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductByID404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductByID404JSONResponse) VisitGetProductByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductRequestObject struct {
+	Id     int64 `json:"id"`
+	Params PatchProductParams
+	Body   *PatchProductApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchProductResponseObject interface {
+	VisitPatchProductResponse(w http.ResponseWriter) error
+}
+
+type PatchProduct200ResponseHeaders struct {
+	ETag string
+}
+
+type PatchProduct200JSONResponse struct {
+	Body    Product
+	Headers PatchProduct200ResponseHeaders
+}
+
+func (response PatchProduct200JSONResponse) VisitPatchProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchProduct400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PatchProduct400JSONResponse) VisitPatchProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProduct404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PatchProduct404JSONResponse) VisitPatchProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProduct409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PatchProduct409JSONResponse) VisitPatchProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProduct412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PatchProduct412JSONResponse) VisitPatchProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductRequestObject struct {
+	Id     int64 `json:"id"`
+	Params UpdateProductParams
+	Body   *UpdateProductJSONRequestBody
+}
+
+type UpdateProductResponseObject interface {
+	VisitUpdateProductResponse(w http.ResponseWriter) error
+}
+
+type UpdateProduct200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateProduct200JSONResponse struct {
+	Body    Product
+	Headers UpdateProduct200ResponseHeaders
+}
+
+func (response UpdateProduct200JSONResponse) VisitUpdateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateProduct400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProduct400JSONResponse) VisitUpdateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProduct404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProduct404JSONResponse) VisitUpdateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProduct409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProduct409JSONResponse) VisitUpdateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProduct412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProduct412JSONResponse) VisitUpdateProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveProductRequestObject struct {
+	Id     int64 `json:"id"`
+	Params ArchiveProductParams
+}
+
+type ArchiveProductResponseObject interface {
+	VisitArchiveProductResponse(w http.ResponseWriter) error
+}
+
+type ArchiveProduct200JSONResponse Product
+
+func (response ArchiveProduct200JSONResponse) VisitArchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveProduct400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ArchiveProduct400JSONResponse) VisitArchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveProduct404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ArchiveProduct404JSONResponse) VisitArchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveProduct409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ArchiveProduct409JSONResponse) VisitArchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveProduct412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ArchiveProduct412JSONResponse) VisitArchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductImagesRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type ListProductImagesResponseObject interface {
+	VisitListProductImagesResponse(w http.ResponseWriter) error
+}
+
+type ListProductImages200JSONResponse ProductImageList
+
+func (response ListProductImages200JSONResponse) VisitListProductImagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductImages400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductImages400JSONResponse) VisitListProductImagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductImages404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductImages404JSONResponse) VisitListProductImagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadProductImageRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *multipart.Reader
+}
+
+type UploadProductImageResponseObject interface {
+	VisitUploadProductImageResponse(w http.ResponseWriter) error
+}
+
+type UploadProductImage201JSONResponse ProductImage
+
+func (response UploadProductImage201JSONResponse) VisitUploadProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadProductImage400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UploadProductImage400JSONResponse) VisitUploadProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadProductImage404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UploadProductImage404JSONResponse) VisitUploadProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadProductImage409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UploadProductImage409JSONResponse) VisitUploadProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadProductImage413JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UploadProductImage413JSONResponse) VisitUploadProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductImageRequestObject struct {
+	Id      int64 `json:"id"`
+	ImageId int64 `json:"imageId"`
+}
+
+type DeleteProductImageResponseObject interface {
+	VisitDeleteProductImageResponse(w http.ResponseWriter) error
+}
+
+type DeleteProductImage204Response struct {
+}
+
+func (response DeleteProductImage204Response) VisitDeleteProductImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProductImage400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductImage400JSONResponse) VisitDeleteProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductImage404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductImage404JSONResponse) VisitDeleteProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductImageRequestObject struct {
+	Id      int64 `json:"id"`
+	ImageId int64 `json:"imageId"`
+}
+
+type GetProductImageResponseObject interface {
+	VisitGetProductImageResponse(w http.ResponseWriter) error
+}
+
+type GetProductImage200JSONResponse ProductImage
+
+func (response GetProductImage200JSONResponse) VisitGetProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductImage400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductImage400JSONResponse) VisitGetProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductImage404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductImage404JSONResponse) VisitGetProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductImageRequestObject struct {
+	Id      int64 `json:"id"`
+	ImageId int64 `json:"imageId"`
+	Body    *UpdateProductImageJSONRequestBody
+}
+
+type UpdateProductImageResponseObject interface {
+	VisitUpdateProductImageResponse(w http.ResponseWriter) error
+}
+
+type UpdateProductImage200JSONResponse ProductImage
+
+func (response UpdateProductImage200JSONResponse) VisitUpdateProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductImage400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductImage400JSONResponse) VisitUpdateProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductImage404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductImage404JSONResponse) VisitUpdateProductImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductImageContentRequestObject struct {
+	Id      int64 `json:"id"`
+	ImageId int64 `json:"imageId"`
+	Params  GetProductImageContentParams
+}
+
+type GetProductImageContentResponseObject interface {
+	VisitGetProductImageContentResponse(w http.ResponseWriter) error
+}
+
+type GetProductImageContent200ResponseHeaders struct {
+	ETag string
+}
+
+type GetProductImageContent200ImageResponse struct {
+	Body          io.Reader
+	Headers       GetProductImageContent200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetProductImageContent200ImageResponse) VisitGetProductImageContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetProductImageContent206ResponseHeaders struct {
+	ETag string
+}
+
+type GetProductImageContent206ImageResponse struct {
+	Body          io.Reader
+	Headers       GetProductImageContent206ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetProductImageContent206ImageResponse) VisitGetProductImageContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(206)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetProductImageContent304ResponseHeaders struct {
+	ETag string
+}
+
+type GetProductImageContent304Response struct {
+	Headers GetProductImageContent304ResponseHeaders
+}
+
+func (response GetProductImageContent304Response) VisitGetProductImageContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetProductImageContent400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductImageContent400JSONResponse) VisitGetProductImageContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductImageContent404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductImageContent404JSONResponse) VisitGetProductImageContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductImageContent416Response struct {
+}
+
+func (response GetProductImageContent416Response) VisitGetProductImageContentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(416)
+	return nil
+}
+
+type ListProductPriceHistoryRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type ListProductPriceHistoryResponseObject interface {
+	VisitListProductPriceHistoryResponse(w http.ResponseWriter) error
+}
+
+type ListProductPriceHistory200JSONResponse PriceHistory
+
+func (response ListProductPriceHistory200JSONResponse) VisitListProductPriceHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductPriceHistory400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductPriceHistory400JSONResponse) VisitListProductPriceHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductPriceHistory404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductPriceHistory404JSONResponse) VisitListProductPriceHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleProductPriceRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *ScheduleProductPriceJSONRequestBody
+}
+
+type ScheduleProductPriceResponseObject interface {
+	VisitScheduleProductPriceResponse(w http.ResponseWriter) error
+}
+
+type ScheduleProductPrice201JSONResponse PriceChange
+
+func (response ScheduleProductPrice201JSONResponse) VisitScheduleProductPriceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleProductPrice400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ScheduleProductPrice400JSONResponse) VisitScheduleProductPriceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScheduleProductPrice404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ScheduleProductPrice404JSONResponse) VisitScheduleProductPriceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishProductRequestObject struct {
+	Id     int64 `json:"id"`
+	Params PublishProductParams
+}
+
+type PublishProductResponseObject interface {
+	VisitPublishProductResponse(w http.ResponseWriter) error
+}
+
+type PublishProduct200JSONResponse Product
+
+func (response PublishProduct200JSONResponse) VisitPublishProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishProduct400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PublishProduct400JSONResponse) VisitPublishProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishProduct404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PublishProduct404JSONResponse) VisitPublishProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishProduct409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PublishProduct409JSONResponse) VisitPublishProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishProduct412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PublishProduct412JSONResponse) VisitPublishProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRelatedProductsRequestObject struct {
+	Id     int64 `json:"id"`
+	Params ListRelatedProductsParams
+}
+
+type ListRelatedProductsResponseObject interface {
+	VisitListRelatedProductsResponse(w http.ResponseWriter) error
+}
+
+type ListRelatedProducts200JSONResponse RelatedProductList
+
+func (response ListRelatedProducts200JSONResponse) VisitListRelatedProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRelatedProducts400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListRelatedProducts400JSONResponse) VisitListRelatedProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRelatedProducts404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListRelatedProducts404JSONResponse) VisitListRelatedProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreProductRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type RestoreProductResponseObject interface {
+	VisitRestoreProductResponse(w http.ResponseWriter) error
+}
+
+type RestoreProduct200JSONResponse Product
+
+func (response RestoreProduct200JSONResponse) VisitRestoreProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreProduct400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response RestoreProduct400JSONResponse) VisitRestoreProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreProduct404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response RestoreProduct404JSONResponse) VisitRestoreProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreProduct409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response RestoreProduct409JSONResponse) VisitRestoreProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductStockRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetProductStockResponseObject interface {
+	VisitGetProductStockResponse(w http.ResponseWriter) error
+}
+
+type GetProductStock200JSONResponse StockList
+
+func (response GetProductStock200JSONResponse) VisitGetProductStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductStock400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductStock400JSONResponse) VisitGetProductStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductStock404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductStock404JSONResponse) VisitGetProductStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustProductStockRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *AdjustProductStockJSONRequestBody
+}
+
+type AdjustProductStockResponseObject interface {
+	VisitAdjustProductStockResponse(w http.ResponseWriter) error
+}
+
+type AdjustProductStock200JSONResponse StockLevel
+
+func (response AdjustProductStock200JSONResponse) VisitAdjustProductStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustProductStock400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response AdjustProductStock400JSONResponse) VisitAdjustProductStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustProductStock404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response AdjustProductStock404JSONResponse) VisitAdjustProductStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustProductStock409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response AdjustProductStock409JSONResponse) VisitAdjustProductStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProductTagRequestObject struct {
+	Id     int64 `json:"id"`
+	Params AddProductTagParams
+	Body   *AddProductTagJSONRequestBody
+}
+
+type AddProductTagResponseObject interface {
+	VisitAddProductTagResponse(w http.ResponseWriter) error
+}
+
+type AddProductTag200JSONResponse Product
+
+func (response AddProductTag200JSONResponse) VisitAddProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProductTag400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response AddProductTag400JSONResponse) VisitAddProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProductTag404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response AddProductTag404JSONResponse) VisitAddProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProductTag409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response AddProductTag409JSONResponse) VisitAddProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProductTag412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response AddProductTag412JSONResponse) VisitAddProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProductTagRequestObject struct {
+	Id     int64  `json:"id"`
+	Tag    string `json:"tag"`
+	Params RemoveProductTagParams
+}
+
+type RemoveProductTagResponseObject interface {
+	VisitRemoveProductTagResponse(w http.ResponseWriter) error
+}
+
+type RemoveProductTag200JSONResponse Product
+
+func (response RemoveProductTag200JSONResponse) VisitRemoveProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProductTag400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response RemoveProductTag400JSONResponse) VisitRemoveProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProductTag404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response RemoveProductTag404JSONResponse) VisitRemoveProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProductTag409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response RemoveProductTag409JSONResponse) VisitRemoveProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProductTag412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response RemoveProductTag412JSONResponse) VisitRemoveProductTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductTranslationsRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type ListProductTranslationsResponseObject interface {
+	VisitListProductTranslationsResponse(w http.ResponseWriter) error
+}
+
+type ListProductTranslations200JSONResponse ProductTranslationList
+
+func (response ListProductTranslations200JSONResponse) VisitListProductTranslationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductTranslations400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductTranslations400JSONResponse) VisitListProductTranslationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductTranslations404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductTranslations404JSONResponse) VisitListProductTranslationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductTranslationRequestObject struct {
+	Id     int64  `json:"id"`
+	Locale string `json:"locale"`
+}
+
+type DeleteProductTranslationResponseObject interface {
+	VisitDeleteProductTranslationResponse(w http.ResponseWriter) error
+}
+
+type DeleteProductTranslation204Response struct {
+}
+
+func (response DeleteProductTranslation204Response) VisitDeleteProductTranslationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProductTranslation400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductTranslation400JSONResponse) VisitDeleteProductTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductTranslation404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductTranslation404JSONResponse) VisitDeleteProductTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTranslationRequestObject struct {
+	Id     int64  `json:"id"`
+	Locale string `json:"locale"`
+	Body   *PutProductTranslationJSONRequestBody
+}
+
+type PutProductTranslationResponseObject interface {
+	VisitPutProductTranslationResponse(w http.ResponseWriter) error
+}
+
+type PutProductTranslation200JSONResponse ProductTranslation
+
+func (response PutProductTranslation200JSONResponse) VisitPutProductTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTranslation201JSONResponse ProductTranslation
+
+func (response PutProductTranslation201JSONResponse) VisitPutProductTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTranslation400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PutProductTranslation400JSONResponse) VisitPutProductTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTranslation404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response PutProductTranslation404JSONResponse) VisitPutProductTranslationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveProductRequestObject struct {
+	Id     int64 `json:"id"`
+	Params UnarchiveProductParams
+}
+
+type UnarchiveProductResponseObject interface {
+	VisitUnarchiveProductResponse(w http.ResponseWriter) error
+}
+
+type UnarchiveProduct200JSONResponse Product
+
+func (response UnarchiveProduct200JSONResponse) VisitUnarchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveProduct400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UnarchiveProduct400JSONResponse) VisitUnarchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveProduct404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UnarchiveProduct404JSONResponse) VisitUnarchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveProduct409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UnarchiveProduct409JSONResponse) VisitUnarchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnarchiveProduct412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UnarchiveProduct412JSONResponse) VisitUnarchiveProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductVariantsRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type ListProductVariantsResponseObject interface {
+	VisitListProductVariantsResponse(w http.ResponseWriter) error
+}
+
+type ListProductVariants200JSONResponse VariantList
+
+func (response ListProductVariants200JSONResponse) VisitListProductVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductVariants400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductVariants400JSONResponse) VisitListProductVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductVariants404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductVariants404JSONResponse) VisitListProductVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProductVariantRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *CreateProductVariantJSONRequestBody
+}
+
+type CreateProductVariantResponseObject interface {
+	VisitCreateProductVariantResponse(w http.ResponseWriter) error
+}
+
+type CreateProductVariant201ResponseHeaders struct {
+	ETag string
+}
+
+type CreateProductVariant201JSONResponse struct {
+	Body    Variant
+	Headers CreateProductVariant201ResponseHeaders
+}
+
+func (response CreateProductVariant201JSONResponse) VisitCreateProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateProductVariant400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateProductVariant400JSONResponse) VisitCreateProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProductVariant404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateProductVariant404JSONResponse) VisitCreateProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProductVariant409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateProductVariant409JSONResponse) VisitCreateProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductVariantRequestObject struct {
+	Id        int64 `json:"id"`
+	VariantId int64 `json:"variantId"`
+	Params    DeleteProductVariantParams
+}
+
+type DeleteProductVariantResponseObject interface {
+	VisitDeleteProductVariantResponse(w http.ResponseWriter) error
+}
+
+type DeleteProductVariant204Response struct {
+}
+
+func (response DeleteProductVariant204Response) VisitDeleteProductVariantResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProductVariant400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductVariant400JSONResponse) VisitDeleteProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductVariant404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductVariant404JSONResponse) VisitDeleteProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductVariant412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductVariant412JSONResponse) VisitDeleteProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductVariantRequestObject struct {
+	Id        int64 `json:"id"`
+	VariantId int64 `json:"variantId"`
+}
+
+type GetProductVariantResponseObject interface {
+	VisitGetProductVariantResponse(w http.ResponseWriter) error
+}
+
+type GetProductVariant200ResponseHeaders struct {
+	ETag string
+}
+
+type GetProductVariant200JSONResponse struct {
+	Body    Variant
+	Headers GetProductVariant200ResponseHeaders
+}
+
+func (response GetProductVariant200JSONResponse) VisitGetProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetProductVariant400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductVariant400JSONResponse) VisitGetProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductVariant404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetProductVariant404JSONResponse) VisitGetProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductVariantRequestObject struct {
+	Id        int64 `json:"id"`
+	VariantId int64 `json:"variantId"`
+	Params    UpdateProductVariantParams
+	Body      *UpdateProductVariantJSONRequestBody
+}
+
+type UpdateProductVariantResponseObject interface {
+	VisitUpdateProductVariantResponse(w http.ResponseWriter) error
+}
+
+type UpdateProductVariant200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateProductVariant200JSONResponse struct {
+	Body    Variant
+	Headers UpdateProductVariant200ResponseHeaders
+}
+
+func (response UpdateProductVariant200JSONResponse) VisitUpdateProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateProductVariant400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductVariant400JSONResponse) VisitUpdateProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductVariant404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductVariant404JSONResponse) VisitUpdateProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductVariant409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductVariant409JSONResponse) VisitUpdateProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductVariant412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductVariant412JSONResponse) VisitUpdateProductVariantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductCommentsRequestObject struct {
+	ProductId int64 `json:"productId"`
+	Params    ListProductCommentsParams
+}
+
+type ListProductCommentsResponseObject interface {
+	VisitListProductCommentsResponse(w http.ResponseWriter) error
+}
+
+type ListProductComments200JSONResponse CommentList
+
+func (response ListProductComments200JSONResponse) VisitListProductCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductComments400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductComments400JSONResponse) VisitListProductCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProductComments404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListProductComments404JSONResponse) VisitListProductCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProductCommentRequestObject struct {
+	ProductId int64 `json:"productId"`
+	Body      *CreateProductCommentJSONRequestBody
+}
+
+type CreateProductCommentResponseObject interface {
+	VisitCreateProductCommentResponse(w http.ResponseWriter) error
+}
+
+type CreateProductComment201ResponseHeaders struct {
+	ETag string
+}
+
+type CreateProductComment201JSONResponse struct {
+	Body    Comment
+	Headers CreateProductComment201ResponseHeaders
+}
+
+func (response CreateProductComment201JSONResponse) VisitCreateProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateProductComment400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateProductComment400JSONResponse) VisitCreateProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProductComment404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateProductComment404JSONResponse) VisitCreateProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductCommentRequestObject struct {
+	ProductId int64 `json:"productId"`
+	CommentId int64 `json:"commentId"`
+	Params    DeleteProductCommentParams
+}
+
+type DeleteProductCommentResponseObject interface {
+	VisitDeleteProductCommentResponse(w http.ResponseWriter) error
+}
+
+type DeleteProductComment204Response struct {
+}
+
+func (response DeleteProductComment204Response) VisitDeleteProductCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProductComment400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductComment400JSONResponse) VisitDeleteProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductComment403JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductComment403JSONResponse) VisitDeleteProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductComment404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductComment404JSONResponse) VisitDeleteProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductComment412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteProductComment412JSONResponse) VisitDeleteProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductCommentRequestObject struct {
+	ProductId int64 `json:"productId"`
+	CommentId int64 `json:"commentId"`
+	Params    UpdateProductCommentParams
+	Body      *UpdateProductCommentJSONRequestBody
+}
+
+type UpdateProductCommentResponseObject interface {
+	VisitUpdateProductCommentResponse(w http.ResponseWriter) error
+}
+
+type UpdateProductComment200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateProductComment200JSONResponse struct {
+	Body    Comment
+	Headers UpdateProductComment200ResponseHeaders
+}
+
+func (response UpdateProductComment200JSONResponse) VisitUpdateProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateProductComment400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductComment400JSONResponse) VisitUpdateProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductComment403JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductComment403JSONResponse) VisitUpdateProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductComment404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductComment404JSONResponse) VisitUpdateProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProductComment412JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateProductComment412JSONResponse) VisitUpdateProductCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportProductsRequestObject struct {
+	Params ExportProductsParams
+}
+
+type ExportProductsResponseObject interface {
+	VisitExportProductsResponse(w http.ResponseWriter) error
+}
+
+type ExportProducts200JSONResponse []Product
+
+func (response ExportProducts200JSONResponse) VisitExportProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportProducts200ApplicationxNdjsonResponse struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+func (response ExportProducts200ApplicationxNdjsonResponse) VisitExportProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportProducts200TextcsvResponse struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+func (response ExportProducts200TextcsvResponse) VisitExportProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportProducts400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ExportProducts400JSONResponse) VisitExportProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportProductsRequestObject struct {
+	Params      ImportProductsParams
+	ContentType string
+	Body        io.Reader
+}
+
+type ImportProductsResponseObject interface {
+	VisitImportProductsResponse(w http.ResponseWriter) error
+}
+
+type ImportProducts200JSONResponse ImportSummary
+
+func (response ImportProducts200JSONResponse) VisitImportProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportProducts400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ImportProducts400JSONResponse) VisitImportProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportProducts413JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ImportProducts413JSONResponse) VisitImportProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReservationRequestObject struct {
+	Body *CreateReservationJSONRequestBody
+}
+
+type CreateReservationResponseObject interface {
+	VisitCreateReservationResponse(w http.ResponseWriter) error
+}
+
+type CreateReservation201JSONResponse Reservation
+
+func (response CreateReservation201JSONResponse) VisitCreateReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReservation400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateReservation400JSONResponse) VisitCreateReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReservation404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateReservation404JSONResponse) VisitCreateReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateReservation409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateReservation409JSONResponse) VisitCreateReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReservationRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetReservationResponseObject interface {
+	VisitGetReservationResponse(w http.ResponseWriter) error
+}
+
+type GetReservation200JSONResponse Reservation
+
+func (response GetReservation200JSONResponse) VisitGetReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReservation400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetReservation400JSONResponse) VisitGetReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReservation404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetReservation404JSONResponse) VisitGetReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitReservationRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type CommitReservationResponseObject interface {
+	VisitCommitReservationResponse(w http.ResponseWriter) error
+}
+
+type CommitReservation200JSONResponse Reservation
+
+func (response CommitReservation200JSONResponse) VisitCommitReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitReservation400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CommitReservation400JSONResponse) VisitCommitReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitReservation404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CommitReservation404JSONResponse) VisitCommitReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitReservation409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CommitReservation409JSONResponse) VisitCommitReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReleaseReservationRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type ReleaseReservationResponseObject interface {
+	VisitReleaseReservationResponse(w http.ResponseWriter) error
+}
+
+type ReleaseReservation200JSONResponse Reservation
+
+func (response ReleaseReservation200JSONResponse) VisitReleaseReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReleaseReservation400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ReleaseReservation400JSONResponse) VisitReleaseReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReleaseReservation404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ReleaseReservation404JSONResponse) VisitReleaseReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReleaseReservation409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ReleaseReservation409JSONResponse) VisitReleaseReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsersRequestObject struct {
+	Params ListUsersParams
+}
+
+type ListUsersResponseObject interface {
+	VisitListUsersResponse(w http.ResponseWriter) error
+}
+
+type ListUsers200JSONResponse UserList
+
+func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsers400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response ListUsers400JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUserRequestObject struct {
+	Body *CreateUserJSONRequestBody
+}
+
+type CreateUserResponseObject interface {
+	VisitCreateUserResponse(w http.ResponseWriter) error
+}
+
+type CreateUser201JSONResponse User
+
+func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateUser400JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response CreateUser409JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteUserResponseObject interface {
+	VisitDeleteUserResponse(w http.ResponseWriter) error
+}
+
+type DeleteUser204Response struct {
+}
+
+func (response DeleteUser204Response) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUser400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteUser400JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteUser404JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response DeleteUser409JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserByIDRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetUserByIDResponseObject interface {
+	VisitGetUserByIDResponse(w http.ResponseWriter) error
+}
+
+type GetUserByID200JSONResponse User
+
+func (response GetUserByID200JSONResponse) VisitGetUserByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserByID400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetUserByID400JSONResponse) VisitGetUserByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserByID404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response GetUserByID404JSONResponse) VisitGetUserByIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *UpdateUserJSONRequestBody
+}
+
+type UpdateUserResponseObject interface {
+	VisitUpdateUserResponse(w http.ResponseWriter) error
+}
+
+type UpdateUser200JSONResponse User
+
+func (response UpdateUser200JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser400JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateUser400JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser404JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateUser404JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUser409JSONResponse struct {
+	Code    string `json:"code"`
+	Details *[]struct {
+		Field  *string `json:"field,omitempty"`
+		Reason *string `json:"reason,omitempty"`
+	} `json:"details,omitempty"`
+	Message string `json:"message"`
+}
+
+func (response UpdateUser409JSONResponse) VisitUpdateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /categories)
+	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
+
+	// (POST /categories)
+	CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error)
+
+	// (DELETE /categories/{id})
+	DeleteCategory(ctx context.Context, request DeleteCategoryRequestObject) (DeleteCategoryResponseObject, error)
+
+	// (GET /categories/{id})
+	GetCategory(ctx context.Context, request GetCategoryRequestObject) (GetCategoryResponseObject, error)
+
+	// (PUT /categories/{id})
+	UpdateCategory(ctx context.Context, request UpdateCategoryRequestObject) (UpdateCategoryResponseObject, error)
+
+	// (POST /products)
+	CreateProduct(ctx context.Context, request CreateProductRequestObject) (CreateProductResponseObject, error)
+
+	// (GET /products/by-slug/{slug})
+	GetProductBySlug(ctx context.Context, request GetProductBySlugRequestObject) (GetProductBySlugResponseObject, error)
+
+	// (GET /products/search)
+	SearchProducts(ctx context.Context, request SearchProductsRequestObject) (SearchProductsResponseObject, error)
+
+	// (GET /products/suggest)
+	SuggestProducts(ctx context.Context, request SuggestProductsRequestObject) (SuggestProductsResponseObject, error)
+
+	// (GET /products/trash)
+	ListTrashedProducts(ctx context.Context, request ListTrashedProductsRequestObject) (ListTrashedProductsResponseObject, error)
+
+	// (DELETE /products/{id})
+	DeleteProductByID(ctx context.Context, request DeleteProductByIDRequestObject) (DeleteProductByIDResponseObject, error)
+
+	// (GET /products/{id})
+	GetProductByID(ctx context.Context, request GetProductByIDRequestObject) (GetProductByIDResponseObject, error)
+
+	// (PATCH /products/{id})
+	PatchProduct(ctx context.Context, request PatchProductRequestObject) (PatchProductResponseObject, error)
+
+	// (PUT /products/{id})
+	UpdateProduct(ctx context.Context, request UpdateProductRequestObject) (UpdateProductResponseObject, error)
+
+	// (POST /products/{id}/archive)
+	ArchiveProduct(ctx context.Context, request ArchiveProductRequestObject) (ArchiveProductResponseObject, error)
+
+	// (GET /products/{id}/images)
+	ListProductImages(ctx context.Context, request ListProductImagesRequestObject) (ListProductImagesResponseObject, error)
+
+	// (POST /products/{id}/images)
+	UploadProductImage(ctx context.Context, request UploadProductImageRequestObject) (UploadProductImageResponseObject, error)
+
+	// (DELETE /products/{id}/images/{imageId})
+	DeleteProductImage(ctx context.Context, request DeleteProductImageRequestObject) (DeleteProductImageResponseObject, error)
+
+	// (GET /products/{id}/images/{imageId})
+	GetProductImage(ctx context.Context, request GetProductImageRequestObject) (GetProductImageResponseObject, error)
+
+	// (PUT /products/{id}/images/{imageId})
+	UpdateProductImage(ctx context.Context, request UpdateProductImageRequestObject) (UpdateProductImageResponseObject, error)
+
+	// (GET /products/{id}/images/{imageId}/content)
+	GetProductImageContent(ctx context.Context, request GetProductImageContentRequestObject) (GetProductImageContentResponseObject, error)
+
+	// (GET /products/{id}/price-history)
+	ListProductPriceHistory(ctx context.Context, request ListProductPriceHistoryRequestObject) (ListProductPriceHistoryResponseObject, error)
+
+	// (POST /products/{id}/price-history)
+	ScheduleProductPrice(ctx context.Context, request ScheduleProductPriceRequestObject) (ScheduleProductPriceResponseObject, error)
+
+	// (POST /products/{id}/publish)
+	PublishProduct(ctx context.Context, request PublishProductRequestObject) (PublishProductResponseObject, error)
+
+	// (GET /products/{id}/related)
+	ListRelatedProducts(ctx context.Context, request ListRelatedProductsRequestObject) (ListRelatedProductsResponseObject, error)
+
+	// (POST /products/{id}/restore)
+	RestoreProduct(ctx context.Context, request RestoreProductRequestObject) (RestoreProductResponseObject, error)
+
+	// (GET /products/{id}/stock)
+	GetProductStock(ctx context.Context, request GetProductStockRequestObject) (GetProductStockResponseObject, error)
+
+	// (POST /products/{id}/stock/adjustments)
+	AdjustProductStock(ctx context.Context, request AdjustProductStockRequestObject) (AdjustProductStockResponseObject, error)
+
+	// (POST /products/{id}/tags)
+	AddProductTag(ctx context.Context, request AddProductTagRequestObject) (AddProductTagResponseObject, error)
+
+	// (DELETE /products/{id}/tags/{tag})
+	RemoveProductTag(ctx context.Context, request RemoveProductTagRequestObject) (RemoveProductTagResponseObject, error)
+
+	// (GET /products/{id}/translations)
+	ListProductTranslations(ctx context.Context, request ListProductTranslationsRequestObject) (ListProductTranslationsResponseObject, error)
+
+	// (DELETE /products/{id}/translations/{locale})
+	DeleteProductTranslation(ctx context.Context, request DeleteProductTranslationRequestObject) (DeleteProductTranslationResponseObject, error)
+
+	// (PUT /products/{id}/translations/{locale})
+	PutProductTranslation(ctx context.Context, request PutProductTranslationRequestObject) (PutProductTranslationResponseObject, error)
+
+	// (POST /products/{id}/unarchive)
+	UnarchiveProduct(ctx context.Context, request UnarchiveProductRequestObject) (UnarchiveProductResponseObject, error)
+
+	// (GET /products/{id}/variants)
+	ListProductVariants(ctx context.Context, request ListProductVariantsRequestObject) (ListProductVariantsResponseObject, error)
+
+	// (POST /products/{id}/variants)
+	CreateProductVariant(ctx context.Context, request CreateProductVariantRequestObject) (CreateProductVariantResponseObject, error)
+
+	// (DELETE /products/{id}/variants/{variantId})
+	DeleteProductVariant(ctx context.Context, request DeleteProductVariantRequestObject) (DeleteProductVariantResponseObject, error)
+
+	// (GET /products/{id}/variants/{variantId})
+	GetProductVariant(ctx context.Context, request GetProductVariantRequestObject) (GetProductVariantResponseObject, error)
+
+	// (PUT /products/{id}/variants/{variantId})
+	UpdateProductVariant(ctx context.Context, request UpdateProductVariantRequestObject) (UpdateProductVariantResponseObject, error)
+
+	// (GET /products/{productId}/comments)
+	ListProductComments(ctx context.Context, request ListProductCommentsRequestObject) (ListProductCommentsResponseObject, error)
+
+	// (POST /products/{productId}/comments)
+	CreateProductComment(ctx context.Context, request CreateProductCommentRequestObject) (CreateProductCommentResponseObject, error)
+
+	// (DELETE /products/{productId}/comments/{commentId})
+	DeleteProductComment(ctx context.Context, request DeleteProductCommentRequestObject) (DeleteProductCommentResponseObject, error)
+
+	// (PUT /products/{productId}/comments/{commentId})
+	UpdateProductComment(ctx context.Context, request UpdateProductCommentRequestObject) (UpdateProductCommentResponseObject, error)
+
+	// (GET /products:export)
+	ExportProducts(ctx context.Context, request ExportProductsRequestObject) (ExportProductsResponseObject, error)
+
+	// (POST /products:import)
+	ImportProducts(ctx context.Context, request ImportProductsRequestObject) (ImportProductsResponseObject, error)
+
+	// (POST /reservations)
+	CreateReservation(ctx context.Context, request CreateReservationRequestObject) (CreateReservationResponseObject, error)
+
+	// (GET /reservations/{id})
+	GetReservation(ctx context.Context, request GetReservationRequestObject) (GetReservationResponseObject, error)
+
+	// (POST /reservations/{id}/commit)
+	CommitReservation(ctx context.Context, request CommitReservationRequestObject) (CommitReservationResponseObject, error)
+
+	// (POST /reservations/{id}/release)
+	ReleaseReservation(ctx context.Context, request ReleaseReservationRequestObject) (ReleaseReservationResponseObject, error)
+
+	// (GET /users)
+	ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error)
+
+	// (POST /users)
+	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
+
+	// (DELETE /users/{id})
+	DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error)
+
+	// (GET /users/{id})
+	GetUserByID(ctx context.Context, request GetUserByIDRequestObject) (GetUserByIDResponseObject, error)
+
+	// (PUT /users/{id})
+	UpdateUser(ctx context.Context, request UpdateUserRequestObject) (UpdateUserResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListCategories operation middleware
+func (sh *strictHandler) ListCategories(w http.ResponseWriter, r *http.Request) {
+	var request ListCategoriesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCategories(ctx, request.(ListCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCategories")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCategoriesResponseObject); ok {
+		if err := validResponse.VisitListCategoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCategory operation middleware
+func (sh *strictHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var request CreateCategoryRequestObject
+
+	var body CreateCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCategory(ctx, request.(CreateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCategoryResponseObject); ok {
+		if err := validResponse.VisitCreateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCategory operation middleware
+func (sh *strictHandler) DeleteCategory(w http.ResponseWriter, r *http.Request, id int64, params DeleteCategoryParams) {
+	var request DeleteCategoryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategory(ctx, request.(DeleteCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCategoryResponseObject); ok {
+		if err := validResponse.VisitDeleteCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCategory operation middleware
+func (sh *strictHandler) GetCategory(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetCategoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategory(ctx, request.(GetCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCategoryResponseObject); ok {
+		if err := validResponse.VisitGetCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCategory operation middleware
+func (sh *strictHandler) UpdateCategory(w http.ResponseWriter, r *http.Request, id int64, params UpdateCategoryParams) {
+	var request UpdateCategoryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCategory(ctx, request.(UpdateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCategoryResponseObject); ok {
+		if err := validResponse.VisitUpdateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateProduct operation middleware
+func (sh *strictHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
+	var request CreateProductRequestObject
+
+	var body CreateProductJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProduct(ctx, request.(CreateProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProductResponseObject); ok {
+		if err := validResponse.VisitCreateProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductBySlug operation middleware
+func (sh *strictHandler) GetProductBySlug(w http.ResponseWriter, r *http.Request, slug string, params GetProductBySlugParams) {
+	var request GetProductBySlugRequestObject
+
+	request.Slug = slug
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductBySlug(ctx, request.(GetProductBySlugRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductBySlug")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProductBySlugResponseObject); ok {
+		if err := validResponse.VisitGetProductBySlugResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchProducts operation middleware
+func (sh *strictHandler) SearchProducts(w http.ResponseWriter, r *http.Request, params SearchProductsParams) {
+	var request SearchProductsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchProducts(ctx, request.(SearchProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchProductsResponseObject); ok {
+		if err := validResponse.VisitSearchProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SuggestProducts operation middleware
+func (sh *strictHandler) SuggestProducts(w http.ResponseWriter, r *http.Request, params SuggestProductsParams) {
+	var request SuggestProductsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SuggestProducts(ctx, request.(SuggestProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SuggestProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SuggestProductsResponseObject); ok {
+		if err := validResponse.VisitSuggestProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListTrashedProducts operation middleware
+func (sh *strictHandler) ListTrashedProducts(w http.ResponseWriter, r *http.Request, params ListTrashedProductsParams) {
+	var request ListTrashedProductsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTrashedProducts(ctx, request.(ListTrashedProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTrashedProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTrashedProductsResponseObject); ok {
+		if err := validResponse.VisitListTrashedProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProductByID operation middleware
+func (sh *strictHandler) DeleteProductByID(w http.ResponseWriter, r *http.Request, id int64, params DeleteProductByIDParams) {
+	var request DeleteProductByIDRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProductByID(ctx, request.(DeleteProductByIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProductByID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProductByIDResponseObject); ok {
+		if err := validResponse.VisitDeleteProductByIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductByID operation middleware
+func (sh *strictHandler) GetProductByID(w http.ResponseWriter, r *http.Request, id int64, params GetProductByIDParams) {
+	var request GetProductByIDRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductByID(ctx, request.(GetProductByIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductByID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProductByIDResponseObject); ok {
+		if err := validResponse.VisitGetProductByIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchProduct operation middleware
+func (sh *strictHandler) PatchProduct(w http.ResponseWriter, r *http.Request, id int64, params PatchProductParams) {
+	var request PatchProductRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PatchProductApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProduct(ctx, request.(PatchProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchProductResponseObject); ok {
+		if err := validResponse.VisitPatchProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateProduct operation middleware
+func (sh *strictHandler) UpdateProduct(w http.ResponseWriter, r *http.Request, id int64, params UpdateProductParams) {
+	var request UpdateProductRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateProductJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateProduct(ctx, request.(UpdateProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateProductResponseObject); ok {
+		if err := validResponse.VisitUpdateProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchiveProduct operation middleware
+func (sh *strictHandler) ArchiveProduct(w http.ResponseWriter, r *http.Request, id int64, params ArchiveProductParams) {
+	var request ArchiveProductRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveProduct(ctx, request.(ArchiveProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchiveProductResponseObject); ok {
+		if err := validResponse.VisitArchiveProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProductImages operation middleware
+func (sh *strictHandler) ListProductImages(w http.ResponseWriter, r *http.Request, id int64) {
+	var request ListProductImagesRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProductImages(ctx, request.(ListProductImagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProductImages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProductImagesResponseObject); ok {
+		if err := validResponse.VisitListProductImagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UploadProductImage operation middleware
+func (sh *strictHandler) UploadProductImage(w http.ResponseWriter, r *http.Request, id int64) {
+	var request UploadProductImageRequestObject
+
+	request.Id = id
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadProductImage(ctx, request.(UploadProductImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadProductImage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadProductImageResponseObject); ok {
+		if err := validResponse.VisitUploadProductImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProductImage operation middleware
+func (sh *strictHandler) DeleteProductImage(w http.ResponseWriter, r *http.Request, id int64, imageId int64) {
+	var request DeleteProductImageRequestObject
+
+	request.Id = id
+	request.ImageId = imageId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProductImage(ctx, request.(DeleteProductImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProductImage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProductImageResponseObject); ok {
+		if err := validResponse.VisitDeleteProductImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductImage operation middleware
+func (sh *strictHandler) GetProductImage(w http.ResponseWriter, r *http.Request, id int64, imageId int64) {
+	var request GetProductImageRequestObject
+
+	request.Id = id
+	request.ImageId = imageId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductImage(ctx, request.(GetProductImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductImage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProductImageResponseObject); ok {
+		if err := validResponse.VisitGetProductImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateProductImage operation middleware
+func (sh *strictHandler) UpdateProductImage(w http.ResponseWriter, r *http.Request, id int64, imageId int64) {
+	var request UpdateProductImageRequestObject
+
+	request.Id = id
+	request.ImageId = imageId
+
+	var body UpdateProductImageJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateProductImage(ctx, request.(UpdateProductImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateProductImage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateProductImageResponseObject); ok {
+		if err := validResponse.VisitUpdateProductImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductImageContent operation middleware
+func (sh *strictHandler) GetProductImageContent(w http.ResponseWriter, r *http.Request, id int64, imageId int64, params GetProductImageContentParams) {
+	var request GetProductImageContentRequestObject
+
+	request.Id = id
+	request.ImageId = imageId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductImageContent(ctx, request.(GetProductImageContentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductImageContent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProductImageContentResponseObject); ok {
+		if err := validResponse.VisitGetProductImageContentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProductPriceHistory operation middleware
+func (sh *strictHandler) ListProductPriceHistory(w http.ResponseWriter, r *http.Request, id int64) {
+	var request ListProductPriceHistoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProductPriceHistory(ctx, request.(ListProductPriceHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProductPriceHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProductPriceHistoryResponseObject); ok {
+		if err := validResponse.VisitListProductPriceHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScheduleProductPrice operation middleware
+func (sh *strictHandler) ScheduleProductPrice(w http.ResponseWriter, r *http.Request, id int64) {
+	var request ScheduleProductPriceRequestObject
+
+	request.Id = id
+
+	var body ScheduleProductPriceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (GET /products/{productId}/comments)
-	ListProductComments(ctx context.Context, request ListProductCommentsRequestObject) (ListProductCommentsResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ScheduleProductPrice(ctx, request.(ScheduleProductPriceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScheduleProductPrice")
+	}
 
-	// (POST /products/{productId}/comments)
-	CreateProductComment(ctx context.Context, request CreateProductCommentRequestObject) (CreateProductCommentResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (DELETE /products/{productId}/comments/{commentId})
-	DeleteProductComment(ctx context.Context, request DeleteProductCommentRequestObject) (DeleteProductCommentResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ScheduleProductPriceResponseObject); ok {
+		if err := validResponse.VisitScheduleProductPriceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (PUT /products/{productId}/comments/{commentId})
-	UpdateProductComment(ctx context.Context, request UpdateProductCommentRequestObject) (UpdateProductCommentResponseObject, error)
+// PublishProduct operation middleware
+func (sh *strictHandler) PublishProduct(w http.ResponseWriter, r *http.Request, id int64, params PublishProductParams) {
+	var request PublishProductRequestObject
 
-	// (GET /users/{id})
-	GetUserByID(ctx context.Context, request GetUserByIDRequestObject) (GetUserByIDResponseObject, error)
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PublishProduct(ctx, request.(PublishProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PublishProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PublishProductResponseObject); ok {
+		if err := validResponse.VisitPublishProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// ListRelatedProducts operation middleware
+func (sh *strictHandler) ListRelatedProducts(w http.ResponseWriter, r *http.Request, id int64, params ListRelatedProductsParams) {
+	var request ListRelatedProductsRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRelatedProducts(ctx, request.(ListRelatedProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRelatedProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRelatedProductsResponseObject); ok {
+		if err := validResponse.VisitListRelatedProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// RestoreProduct operation middleware
+func (sh *strictHandler) RestoreProduct(w http.ResponseWriter, r *http.Request, id int64) {
+	var request RestoreProductRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreProduct(ctx, request.(RestoreProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreProductResponseObject); ok {
+		if err := validResponse.VisitRestoreProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// GetProductStock operation middleware
+func (sh *strictHandler) GetProductStock(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetProductStockRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductStock(ctx, request.(GetProductStockRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductStock")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProductStockResponseObject); ok {
+		if err := validResponse.VisitGetProductStockResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// AdjustProductStock operation middleware
+func (sh *strictHandler) AdjustProductStock(w http.ResponseWriter, r *http.Request, id int64) {
+	var request AdjustProductStockRequestObject
+
+	request.Id = id
+
+	var body AdjustProductStockJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AdjustProductStock(ctx, request.(AdjustProductStockRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdjustProductStock")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AdjustProductStockResponseObject); ok {
+		if err := validResponse.VisitAdjustProductStockResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// CreateProduct operation middleware
-func (sh *strictHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
-	var request CreateProductRequestObject
+// AddProductTag operation middleware
+func (sh *strictHandler) AddProductTag(w http.ResponseWriter, r *http.Request, id int64, params AddProductTagParams) {
+	var request AddProductTagRequestObject
 
-	var body CreateProductJSONRequestBody
+	request.Id = id
+	request.Params = params
+
+	var body AddProductTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddProductTag(ctx, request.(AddProductTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddProductTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddProductTagResponseObject); ok {
+		if err := validResponse.VisitAddProductTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveProductTag operation middleware
+func (sh *strictHandler) RemoveProductTag(w http.ResponseWriter, r *http.Request, id int64, tag string, params RemoveProductTagParams) {
+	var request RemoveProductTagRequestObject
+
+	request.Id = id
+	request.Tag = tag
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveProductTag(ctx, request.(RemoveProductTagRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveProductTag")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveProductTagResponseObject); ok {
+		if err := validResponse.VisitRemoveProductTagResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProductTranslations operation middleware
+func (sh *strictHandler) ListProductTranslations(w http.ResponseWriter, r *http.Request, id int64) {
+	var request ListProductTranslationsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProductTranslations(ctx, request.(ListProductTranslationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProductTranslations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProductTranslationsResponseObject); ok {
+		if err := validResponse.VisitListProductTranslationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProductTranslation operation middleware
+func (sh *strictHandler) DeleteProductTranslation(w http.ResponseWriter, r *http.Request, id int64, locale string) {
+	var request DeleteProductTranslationRequestObject
+
+	request.Id = id
+	request.Locale = locale
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProductTranslation(ctx, request.(DeleteProductTranslationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProductTranslation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProductTranslationResponseObject); ok {
+		if err := validResponse.VisitDeleteProductTranslationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutProductTranslation operation middleware
+func (sh *strictHandler) PutProductTranslation(w http.ResponseWriter, r *http.Request, id int64, locale string) {
+	var request PutProductTranslationRequestObject
+
+	request.Id = id
+	request.Locale = locale
+
+	var body PutProductTranslationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
-	request.Body = &body
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProductTranslation(ctx, request.(PutProductTranslationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProductTranslation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutProductTranslationResponseObject); ok {
+		if err := validResponse.VisitPutProductTranslationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UnarchiveProduct operation middleware
+func (sh *strictHandler) UnarchiveProduct(w http.ResponseWriter, r *http.Request, id int64, params UnarchiveProductParams) {
+	var request UnarchiveProductRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnarchiveProduct(ctx, request.(UnarchiveProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnarchiveProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnarchiveProductResponseObject); ok {
+		if err := validResponse.VisitUnarchiveProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProductVariants operation middleware
+func (sh *strictHandler) ListProductVariants(w http.ResponseWriter, r *http.Request, id int64) {
+	var request ListProductVariantsRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateProduct(ctx, request.(CreateProductRequestObject))
+		return sh.ssi.ListProductVariants(ctx, request.(ListProductVariantsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateProduct")
+		handler = middleware(handler, "ListProductVariants")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateProductResponseObject); ok {
-		if err := validResponse.VisitCreateProductResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListProductVariantsResponseObject); ok {
+		if err := validResponse.VisitListProductVariantsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1258,25 +8702,32 @@ func (sh *strictHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// SearchProducts operation middleware
-func (sh *strictHandler) SearchProducts(w http.ResponseWriter, r *http.Request, params SearchProductsParams) {
-	var request SearchProductsRequestObject
+// CreateProductVariant operation middleware
+func (sh *strictHandler) CreateProductVariant(w http.ResponseWriter, r *http.Request, id int64) {
+	var request CreateProductVariantRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body CreateProductVariantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SearchProducts(ctx, request.(SearchProductsRequestObject))
+		return sh.ssi.CreateProductVariant(ctx, request.(CreateProductVariantRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SearchProducts")
+		handler = middleware(handler, "CreateProductVariant")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SearchProductsResponseObject); ok {
-		if err := validResponse.VisitSearchProductsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateProductVariantResponseObject); ok {
+		if err := validResponse.VisitCreateProductVariantResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1284,25 +8735,27 @@ func (sh *strictHandler) SearchProducts(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteProductByID operation middleware
-func (sh *strictHandler) DeleteProductByID(w http.ResponseWriter, r *http.Request, id int64) {
-	var request DeleteProductByIDRequestObject
+// DeleteProductVariant operation middleware
+func (sh *strictHandler) DeleteProductVariant(w http.ResponseWriter, r *http.Request, id int64, variantId int64, params DeleteProductVariantParams) {
+	var request DeleteProductVariantRequestObject
 
 	request.Id = id
+	request.VariantId = variantId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProductByID(ctx, request.(DeleteProductByIDRequestObject))
+		return sh.ssi.DeleteProductVariant(ctx, request.(DeleteProductVariantRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProductByID")
+		handler = middleware(handler, "DeleteProductVariant")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteProductByIDResponseObject); ok {
-		if err := validResponse.VisitDeleteProductByIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteProductVariantResponseObject); ok {
+		if err := validResponse.VisitDeleteProductVariantResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1310,25 +8763,26 @@ func (sh *strictHandler) DeleteProductByID(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetProductByID operation middleware
-func (sh *strictHandler) GetProductByID(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetProductByIDRequestObject
+// GetProductVariant operation middleware
+func (sh *strictHandler) GetProductVariant(w http.ResponseWriter, r *http.Request, id int64, variantId int64) {
+	var request GetProductVariantRequestObject
 
 	request.Id = id
+	request.VariantId = variantId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProductByID(ctx, request.(GetProductByIDRequestObject))
+		return sh.ssi.GetProductVariant(ctx, request.(GetProductVariantRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProductByID")
+		handler = middleware(handler, "GetProductVariant")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProductByIDResponseObject); ok {
-		if err := validResponse.VisitGetProductByIDResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProductVariantResponseObject); ok {
+		if err := validResponse.VisitGetProductVariantResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1336,13 +8790,15 @@ func (sh *strictHandler) GetProductByID(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateProduct operation middleware
-func (sh *strictHandler) UpdateProduct(w http.ResponseWriter, r *http.Request, id int64) {
-	var request UpdateProductRequestObject
+// UpdateProductVariant operation middleware
+func (sh *strictHandler) UpdateProductVariant(w http.ResponseWriter, r *http.Request, id int64, variantId int64, params UpdateProductVariantParams) {
+	var request UpdateProductVariantRequestObject
 
 	request.Id = id
+	request.VariantId = variantId
+	request.Params = params
 
-	var body UpdateProductJSONRequestBody
+	var body UpdateProductVariantJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1350,18 +8806,18 @@ func (sh *strictHandler) UpdateProduct(w http.ResponseWriter, r *http.Request, i
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateProduct(ctx, request.(UpdateProductRequestObject))
+		return sh.ssi.UpdateProductVariant(ctx, request.(UpdateProductVariantRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateProduct")
+		handler = middleware(handler, "UpdateProductVariant")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateProductResponseObject); ok {
-		if err := validResponse.VisitUpdateProductResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateProductVariantResponseObject); ok {
+		if err := validResponse.VisitUpdateProductVariantResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1370,10 +8826,11 @@ func (sh *strictHandler) UpdateProduct(w http.ResponseWriter, r *http.Request, i
 }
 
 // ListProductComments operation middleware
-func (sh *strictHandler) ListProductComments(w http.ResponseWriter, r *http.Request, productId int64) {
+func (sh *strictHandler) ListProductComments(w http.ResponseWriter, r *http.Request, productId int64, params ListProductCommentsParams) {
 	var request ListProductCommentsRequestObject
 
 	request.ProductId = productId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.ListProductComments(ctx, request.(ListProductCommentsRequestObject))
@@ -1491,6 +8948,254 @@ func (sh *strictHandler) UpdateProductComment(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// ExportProducts operation middleware
+func (sh *strictHandler) ExportProducts(w http.ResponseWriter, r *http.Request, params ExportProductsParams) {
+	var request ExportProductsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportProducts(ctx, request.(ExportProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportProductsResponseObject); ok {
+		if err := validResponse.VisitExportProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportProducts operation middleware
+func (sh *strictHandler) ImportProducts(w http.ResponseWriter, r *http.Request, params ImportProductsParams) {
+	var request ImportProductsRequestObject
+
+	request.Params = params
+
+	request.ContentType = r.Header.Get("Content-Type")
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportProducts(ctx, request.(ImportProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportProductsResponseObject); ok {
+		if err := validResponse.VisitImportProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateReservation operation middleware
+func (sh *strictHandler) CreateReservation(w http.ResponseWriter, r *http.Request) {
+	var request CreateReservationRequestObject
+
+	var body CreateReservationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateReservation(ctx, request.(CreateReservationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateReservation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateReservationResponseObject); ok {
+		if err := validResponse.VisitCreateReservationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReservation operation middleware
+func (sh *strictHandler) GetReservation(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetReservationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReservation(ctx, request.(GetReservationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReservation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReservationResponseObject); ok {
+		if err := validResponse.VisitGetReservationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CommitReservation operation middleware
+func (sh *strictHandler) CommitReservation(w http.ResponseWriter, r *http.Request, id int64) {
+	var request CommitReservationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CommitReservation(ctx, request.(CommitReservationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CommitReservation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CommitReservationResponseObject); ok {
+		if err := validResponse.VisitCommitReservationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReleaseReservation operation middleware
+func (sh *strictHandler) ReleaseReservation(w http.ResponseWriter, r *http.Request, id int64) {
+	var request ReleaseReservationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReleaseReservation(ctx, request.(ReleaseReservationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReleaseReservation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReleaseReservationResponseObject); ok {
+		if err := validResponse.VisitReleaseReservationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUsers operation middleware
+func (sh *strictHandler) ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams) {
+	var request ListUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUsers(ctx, request.(ListUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUsers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUsersResponseObject); ok {
+		if err := validResponse.VisitListUsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateUser operation middleware
+func (sh *strictHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var request CreateUserRequestObject
+
+	var body CreateUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateUser(ctx, request.(CreateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateUserResponseObject); ok {
+		if err := validResponse.VisitCreateUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUser operation middleware
+func (sh *strictHandler) DeleteUser(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeleteUserRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUser(ctx, request.(DeleteUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUserResponseObject); ok {
+		if err := validResponse.VisitDeleteUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetUserByID operation middleware
 func (sh *strictHandler) GetUserByID(w http.ResponseWriter, r *http.Request, id int64) {
 	var request GetUserByIDRequestObject
@@ -1517,31 +9222,222 @@ func (sh *strictHandler) GetUserByID(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
+// UpdateUser operation middleware
+func (sh *strictHandler) UpdateUser(w http.ResponseWriter, r *http.Request, id int64) {
+	var request UpdateUserRequestObject
+
+	request.Id = id
+
+	var body UpdateUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateUser(ctx, request.(UpdateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateUserResponseObject); ok {
+		if err := validResponse.VisitUpdateUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaW2/bthf/KgL//0ctVhJvGPTW1sMQoMNSBH0qgoCRjh124iUUVdQz9N0HXnSzKNtx",
-	"Zc1Z+xSHl8Nzzu/ceMQNSjgVnAFTOYo3SGCJKSiQ5r9m7qGZeHjHKQWmbhZ6CWEoRgKrJxQihimgWO8y",
-	"8ykKkYTngkhIUaxkASHKkyegWG9cckmxQjEiTP0yRyGihBFaUBRfhkitBdgpWIFEZRkO8DLIBJni9Fu8",
-	"gvr85wLkumFA6Ln2kSkscZEpc8LRp92Rv3eeaOa9p15FIaL4qzs2io5lQvK0SIbBF25+CvV/GNLEc0cF",
-	"lLD3wFbqCcXXNfVcScJWO4h/zEHeLD4YwgPHFHrJmIKWlhTk6i1PCWz7YGeucsN3ErACu5IpYEr/xEJk",
-	"JMGKcDb7nHOmxxqm/i9hiWL0v1lDe2Zn679b1A1rKeSJJEITRTFyC4JEryCcBQKvM4776uhq2CvDR5Ge",
-	"UAZHfYcMhVlxlATOH06EQpe6RwK34AAUjG3lgrPcY1du/OE3KbkcXQpL1cO9mQiq440zuj2atEPHpCXJ",
-	"BUhVu0TNHMVfK9++iua/hm1nv+w5e4iMniB9ozrOqdH/SRGqQ2dvC0m9jrztvGEr9B223lrdi3hxEecQ",
-	"+mXbAj7ZfNgOznXwqrTZVk6bufuaNn/8DInSfDho3pPcAw9RQLs/XuCsmro7DkuJ131BDFEfU84XPAwd",
-	"ioiN6x2ruryK9hqVkCQBl2lscI/qVaygj0NwmOOq7TskGlPNlZJ6ag5txRJvfJbdqjz6s4ornPmmvMiF",
-	"VWXUKlcsBZ8GdBa2ZUw7bujRQEi+JBkEElQhGaTB4zpQTxC8ub25sLbeDhl7/V4CTv9k2brK4T2UgWKS",
-	"dbbbkbAThH6eHx5CBo78VoPcYWoVx406fErfdskfAfjsArC/TBsTqOMl7Ql2gADnlUn8BeQr1G5dz20z",
-	"nrZjecNWCgqTrKvy7tYlgSz17pWAXXXYj0c9BrdzD4U876afgWhmWG/W7xL+v1cP+K8FPfmmY/ulHH/H",
-	"lUzF9o+KZvKKRu8nbMkNskRleu4PLP8CJTKcQLAAyrWSUYi+gMwtIpcX0UWkOeYCGBYExejaDIWm42Uw",
-	"mLl8buMkt7atQTK3ZR3fkfXRylbb/Z31kKF3GgyzAzsP29f7q+jyVN0IbyfFqj8QjVPOo2hYQsfpbEcX",
-	"wuQNvMo14reVnu/1aK32WQ5YJk/6mBV4lH9npuvNYae9/cnPW7NkNtR1LMMjt5p+8TftNmGmvO+BHZ0K",
-	"bBOxPYDr8YAvg9oDJkJ8Q9LShs8MbO7rQr4w42732/XNYiTUbxY+tc/7kdwykI6iD01ifgqVhn5/+R3U",
-	"VJqLpoxOd4StMhg1OJ0QGlF4oLF3kCaPjIrMVClpUtCtxtLXgXo3xtVtgnLmPqbmgzlOB+JK79Xaccyj",
-	"+dQ3if+2OwHD32ryYMnlGWNaY2A8eX9JWDUrTgPZWI699TVwglqzbuIM15pJ0+c5XyPY69izTf1e4vDC",
-	"5mRWc2xt2rwJOZpE+4P7v1NqXZ9vINlbEnwfFjFyPKteBkyX3XYVKmPHs+szDolFrmGvLnNDlxFtAK/w",
-	"JmJafMPXkCIHeYZJS3Nt4GnGNgOvTcw7qACzNKCY4RWYRzTAUsGJLT/dA6nbpjngbXjiJOEFU4EEJQl8",
-	"wZmPiOWrT6F++uIe8exmpba/8r78JwAA//+6mIfu9SgAAA==",
+	"H4sIAAAAAAAC/+x9e3PcNpbvV0HxblXsWeplK5mNVVu3HHsy8Y7taCw5U3UnuVmIPN2NiA0wACi5x6vv",
+	"vnXwIEES7JdackvpfxKrSeJxcHBwnj98TjIxLQUHrlXy4nMyAZqDNP9sHvzqfv31Fc0m8EpwLUWBr+Sg",
+	"MslKzQRPXiT4lPExyZmETLMrUGQkJMkKho0QynOiJlRCTjJsR6UkE3zExhX+JDjREyAK5BXI/SRNVDaB",
+	"KcVe9KyE5EWitGR8nNzcpNGhCa6B67+c03F/ZGdaCj4mwDXTM6LpmEyomkBORlJMTb8SVCm4AnIh8tkJ",
+	"UcBzwjRhnLwZ7b0XHPbeUZ1NiBZEwhUtWE41rDHMZccnRm5YupIcchyfqGQGKWFakSuQigmOw/u9EhoU",
+	"eQL7433yc/L85+TpCZFAc4XUvQKpISfXTE/I/80qKYFnM5JRKWeEkszSzBCDMK400HyfnOHcad0HjuaC",
+	"ZpeOFjUZpvQSCCXXkmnAlnKGk6FFSoQklNtpzKcfoWak++QlKaXIq0x/1cyNFkqQqUAuup4Ax+FSDWMh",
+	"Z8grSIUL/DiT1fSCMEUkcDpFRpLmqzwlivEM6q9OqZ7geyWVuiFvKUEB1xTHvsZyvqVKvxM5GzHI+8v6",
+	"Dxy34y6zeuSaKlJQpQ3ZNPCUUNwY5Ifz81OyJku9FRm1HfbZSkgYScE1KXH2btaO1qTiOUhDScsZmqii",
+	"Gq8xgp+onPV7/wC/V6A0cW+1t1kOJfBcEcFPzAMNnzS5opKBstz6Msug1HtvKR9XdLyALjdpUlJJp6D7",
+	"sqt58Ktt0zfZH/GphBFIlEaFyGiB2+rD96/It0dHh0/3yXs6BSvFgq9wl02hkSMjJpUmWlKuCrMqZCQq",
+	"3E8FbnB8o2BKpwQobgY6JiNaFIy7LaaFWQ41EVKDROk5VeRJDnuvfkjxY05yeHpiCCQqTQSHcEG/UkRc",
+	"cwLcUpOZhq4NWzOcnl2IJE1woyQvkg6Jl1/3gKSv3O7qE/NHXsyc/PIDVGTECsgd4+kJU8GetkJDjCwF",
+	"qgv3hIGqJ/B7BXLWjN9/2xo40ozq5EXCuP7mOEmTKeNsWk2TF0epnxXjGsYg501LTKfA9ZvX2KTpGzdQ",
+	"0LV7nidpIuH3iknIkxdaVnAHY5FANeTfSzGdT+VK4TbL7OuEakPTkfakRgFPuR6kZtBNdBIonvY0m0KS",
+	"Ls8ettFzscrIL2AkJLQGfUKmldLkAkhB7YQoJ8GIF0zqXGxwSu4U7c/ozdmPe8fPjv5MMpED7mVzNpNS",
+	"sgxwJvvkowLV3bFXICXLwahKekI1qY9pc+wp0CkRegLyminwZ7rC5cWGvOSGT9mE8jEQSTWcEDFlGkk5",
+	"BcqVFTZtIeE7GSScn2VIt5JqDRLf/v//fLn3/+jev375/Pzm31YjnhIywgwl/b0ys1FIBnoJ3MpUDp+0",
+	"/ag5veCKiQoP8jHMGT72Ew5+Sj+9BT7Wk+TF10fPlh/yazn7UEUO15+8EgNXIGdEimtzNkgohdTkGlfy",
+	"WlRFTtzCeKGNJz8KfMpnGtXloSnktt9wCjmMaFXo5MWIFgrqKVwIUQDlc+YwKMfYfQiwNyOj+/VJiMqw",
+	"XWbaLKtXEfbJ+QSceilQVkxoWQJXhI3aOhWec5oVhd0SVHsNMtw2x0fPrI5o1ekT8t9/+m8Uj1w49cR2",
+	"pEjFA1W2mA2enV4TXuvQfDPyOuMZ41lEEanVwRPy/PCYvBea+C/CaVgJ0SLGhCrCBUpK4F7JtIrwPnkz",
+	"5kL6r9pqOdIQuJ47XTeAPTvm9eaNXc5hBmW5AagsGMiaFdQyZBAcHB9MsQNQ++ScXoJCzsogB56BEbak",
+	"N5d5s25otOaMP+DmH2B9I8CNdfyVtUsYLUgmSuR1dULMpzhRy/6Ci8ouHysAjdPWbENux3avJ6KA2sIL",
+	"qDVvtnawa010SscwLGbM03uRNTwrqhx+lN6L0Sb7SzQqVSYkNDrphajGE00uZtYBQad4DiktpiBV2mj2",
+	"Qlorhl4Ug8cOa/W+GdFtmzwXmkZcLmegUdEwjeI/1CUrSSYqjkfMCdH4lTmWRjQD1BqMXgXcKwgLJmJ7",
+	"jc7DLt+q0/jIy+qiYOh7iSxOPmWcjAo6RgeMmRj2gv934yG5pCNtJkRlNmFXkNfruGAqYc8bWZi3bMp0",
+	"fxLv6CfkWsKr6QVIa8/AVFnHB+7AE0KLwv2Iy9GRYvPXpTCddtQat0sOD9fcM2+NsdufynevTsnxn0nh",
+	"7EM0V1MiSn82WjvdfuBUnzEavE9ySEmp9777kJJ/TfZ+oFzvnf/j6YmTVOj9U7DHuAKuGLoJg2O2LTas",
+	"FT5XagRa3dE3Zv7+z2dpVF99lj6/efLPvV9/cb8c7n2LPx7fPP18mD5bSZl9Rz+dShY7vw23K3YFpCpL",
+	"kNYEQEHDc8I4ybCloSWe+laXE46HKyz0O8YXDrgQ16sOmPG7GrARpBGphxq28zKjA8ntYbPJ8E1jS0ko",
+	"4IriqW/EhcqaA3JoJkbEt6YBHIf8z4SqLEnNKJJflmeQU+dlivVVdj0utSA6WnMbY29n7F9zezTPo70+",
+	"O0w3IUvQhcY+xWID7T1PSvMiwYZzovAIk0PLYl9dVgx8fdgSA0crLJc9SQa1GHfS3Ise8/ehVfy9Lf2b",
+	"iT5ffqIDOul3Mw1E4rPguEqJiSxczDSo/zzcOzp89vyEUK6uQfrAwrPDb8ip015dGMYEAY6PvrGHGhcc",
+	"SEY5+m8U1Uyh7j2oh66vhH4A9A7lS5/M0r6/UIXon7rNZg23zdfr7pqzoopEhV45346wnmCQxj+PA6d+",
+	"yGscqtjGsnvpP3p7qX+k4gH670/2wr+e/mmVYxSleX/y3zMocnQM5siLRjJ7SxdF/QnRDKwCdSEFuosu",
+	"ZoTl+4HYdzNU5HcberxmxlCyFEMPDCrOVVHsGW+54WQ9KwXRogBJQ7uwww4KBxzlButP8YcGy/03aVK6",
+	"E7Ie3irnyFk1HoPSS/O1su8zwW/N0s/WZWkX54wwoKbju5fl53Q84Gf4xwT0BGSzh6xr2cVEuY1EFEUT",
+	"IjRRLGRDOh4kp/bdxdmC8lnAF/YvWhSr8AB6R5YMspipMBtuUkZtV+RJVz48xanAp7IQOfg1GJhZ25I1",
+	"lsuq62Q46o398qjRAKmUdIZPlZ6hBWIOz2SQBh8VyDev/24GOHA8VvjKfRzQOJa/L6HnqOrCEsEzFI7w",
+	"K0VwvMhqMKWsGGKr34c82M++Pl53Z+DANytyzVRQxJq5YEC0xJfmnEgbEKimryT1EZ6XepXN9BOVjM4J",
+	"712555tkpBvbFCj9ncgZdMPUrWd1WNXGz+yrRrHCf9KyLJiN+R/8pmzgvxnVv0kYJS+S/3PQNH5gn9b/",
+	"7zZvBtflY/sGKemsEDRPa76wBDfrbZTEsqBoY5EgGtsmWXsVOvO0cdS7mmar9dgs7Qt2TkxwP9t15vCx",
+	"zO9wDq71OXOozBtrzcCY72eoRlbF5mfQbj0yA/8sd26H9aZgDr87YqR265EpuBdux0je/kRn+R1xU6SL",
+	"yGzewzWhhbZpJLjRS6FMSMzYHpwYd/4tpmYo057atCo0K6nUByhV93Kq6W1nZ3qJzM48RtlF87zOjwhD",
+	"8vCJKROiFRzUetMs3QE7sHif9njeX8D+6YULcJCpq/nvDXGjCsS1kE5AmIyzV2c/WYOH+vAnhq+fZKKo",
+	"plwRlqdNEBWPxNTuzFc45fRn7pMDUqNbnpj/EgV4wJr8jRn5Ofmfn5On2CtV5P3r/zr78b3tUPCa0kRc",
+	"/AaZJiVIUjAO+z/zD+LapX8hl+VeppmorvsqJeOKytx24wZpvQxjdgUum8x4+nxCiQ0aqP2f+Tpreeqt",
+	"iIGlnIIcw16Jb/37rfak7Si2ms61UqftWaJg4iAxhH2HQyCnzv5YeYbOUrsLOYNNxwQ+4+Oi4QNMg7uF",
+	"vDxvku3uVmr2O4rJTq8MBz+3XDaE2Vj1QHBj3oQ/AKZHmwHc0UHX7yEyxeAllDBGAVxpHmdaZJcv898q",
+	"padu6BudRbf9GAviK4Q276wyfjSh7mgBgqYjo8anfY1cwpgpDbKvkztbs5QCEy9XmqMzke5omu3WIzN1",
+	"LyxvfjiTrT9HY3e5PJKezeV+//UvUgq58VnaViOzMw/q9Jakuw5+VP5kkFAnJX1PWQH5/Y30PMgXZ4pw",
+	"QTCRGaRNtoKwEsGnRO2TD8Dp1ChQJhcf/9XO3m/rW0Eev0n6v6impQrrHFIMEEnYw/fCb13UWUsXi8ZH",
+	"HK4JJtfsGwXKzQ/J8F3dS8yNBqSAKyispM4a41dP9o33VpQgtbPaWR61/rsWv/cmxBS7hkdDr0bjwbCq",
+	"EbYRJljfchhzHfs9h11Jpc1w7udpXCjg2iasinLPEq6drr3EqJC2kRhUwwt15k2vkxnJMYdVC5sjLLh1",
+	"1XvH5DIM3/ST3NSjq92RVsVCp9KS2cJp4lg1ltqq2ZQpzTKSiVp1blgbud1qszaR1CQiWjXWJIk5BT2j",
+	"Eonb5Chd0aKCpWg9yG+pd3v5wYczn8eMzalAc58seRowp8ulafOrZ8POkcYZZv7Sqa+PsHz3VacGIB1w",
+	"J26Kp0/Nk5rBbAa1YXEa4b998iNvjBNoDxVFHtiCj+jyLHA3h0u1UCq8ZUpHJIPfCZ1Tx/BXI4dlbmK4",
+	"1ou76gbyI+hvny67mVajk7Ceq/74Q79A4PM+PP6PhSvcuIOX3rlLy9Em/r/c+2tIERe+WK79jYkcyJm+",
+	"c4kTZk/UURq/0uHChYRrJjmHgRpptDE2WmEdOnPtTW3OwBfs3tX2o20ydpo1NRQxuacUuhJ85YUgI9CZ",
+	"V6U+aVNlcUKoPfKd6mYqGH35xQLlZtHub0z2h7J4ta3QHXAeU/bSJAdNWdFe0fanIwy8Rb+VQJ0+3ydz",
+	"b2DddZ+CUi4Dbf4imaE378cmbV2aH8T1wOxZ7Ez1dkPu/MbuxJTiekkdsWA8ojK8ZRzq8Ly4RtsD/1kZ",
+	"jy8WdbMCUqI0lbbQRpOj/WjzSxPIjGMZAp1V0ymNKetOvA0Sqa5+M5lTuqkeugD/BHcfJbmcEVnx+ITy",
+	"ulapm8acJoALp2JlujgFyJGUKlQKcNLmVJiZmC/Hs8KXlKysLnT4J8Kto9qyHTxJ51DPvRGhnnuykHqd",
+	"Ja/Lr/zKNaOox1oTNcYQJuz1ahJPtUODEzgarqFr8CvlImATpjSqmT3T01j6XqUYKDa3TdBMVyZLWwtx",
+	"SWA0gkzXctyWkJTAc9ZRUudqJ2soV1lQLdnJHRuoHUwTO1h2Bb7kdeN6nI9lxBgKicc4mTKOMRPOtMI1",
+	"CuslV8ptXl1tVJrqKjI0t1yk4poVpEUkUlJlyqSw/qMMC8NKH5g0jJjX82iKbO1m9ikWrpMk9ayWpIlr",
+	"NJ5gMVfPCyidhiWl7RWupxzP5uhsqR/s3lja6jmlyjqKPP1cPW4g6VrDWVm0hRt9fWOoF4NvT663Jzqp",
+	"O5WuJPhqaes6uZ4wp8g5iWAWVC2/3eftEwwRlwN7pe3d8xXKa++gDglbPNUmS5yuZiCRE9kZsDFvQJ39",
+	"otvuzwC+oJalIzN11xpTnXTiJfZ7CFBya4dYM6sTAtNSzxaMb4NOs2zJwngxCqLJJ50ky3bxu+AWMsWE",
+	"dveTdOkjJIcC9MJz0q7rNbUum9w6FIFoSdXkxFZdOnwYr2OaRwbCY6WDszWC3t6VADYHOfjdr16TP9+i",
+	"Q8skOjy8zWnIuImFDSfKhrRqo3S4mIsyVcfKRtSuKCuwRLJPPwVYs+ezCQMVLFBSi/mlaAEyUl2VxmMB",
+	"V2qEYUpKll3iwk4kFnl2QWVOCHCHu9AFUcH12I8tZcSZfvRsCc9jU3dVSsio9vGpDnMkryFjUyzanIrK",
+	"0m1Kf4tpIim5hNKKH2QP5yc5wUBZsL8MZepN5TGeWowrqosC4jLYppSvpTM9MTVjT2+tO7EMfnQQGWrY",
+	"4fx51YY7W5B9grwB6mhN5BJmVktoSTFnGF0zbovKPf7GnqTao3QohynVO5PqStSl5ZP1uPjPWmdPnfZt",
+	"zR6oTbX69eXllKmMjpmIvqbCvLBoaxMlpMsDqssd4izXYzMVLYT5+OHtnqIjICwHrtmIgSRj4GDTjepj",
+	"EbfmicEUw3857LCQkloQagKE2I0NIU+AiCI3O0iCLSlU0X0/pJW/ZSPIZlkBxL7hieOJ36wPLUugMiAX",
+	"DiAo1UB6ucTnUCM32UtJwDRJmvji54hKntqM/VAfnpOL//WC2NeyrOncAvY0R1C0GmJh0JscwBF9iQib",
+	"of7FzDGL8rBxqhMBFrwFIHdfcTlXpBXOM23KoYfMqo60bKqOXBFHOJPQ6lo2/NdLsl1HoxZGi+4D0eHG",
+	"qXMUTRk8GmtMkwJodyO39NmVg2xrq6phnfHHs9f7SbzEfFAnnaMEvm7+QgkBPI0oJUWttwQAc7YiY0o5",
+	"RfveZ2EWs+XUxK1XZwwSZ6DPfFkV5sRgU/UxZUzbj0m/cQlXjtqGBBZ5rPagvPKYAAawoBnqs8NYaKJ7",
+	"Jq1bPPb1AgfLYMTcya7vDQ5KJKdlPJYwdhvKLKlBc6yKwmk1FoiNC23P9xB7zUfC2tKwN+V5FvY5HZuR",
+	"LXQgmVbnzM8kt/dFMy30OXyKHOnnJsW/0CA5NfVq3hwyOf4LrNC+t3cC2aWqIg6qH+DTHnDkrJyc/fBy",
+	"79nX33hLzsXaTsI/vlL2kB0+Xft92y/Pze8xf7sY2Ukd/FbCOHX/Lnn9zzEboRZg/7iGizIlOWjIWjqm",
+	"62U/ue/cA1eBERFdJgGSdZPqxrQoQM4cgNHhSbOoGBA7RG8W/jKlzNVz7G8k50E5AIpujZKhGinMHsfB",
+	"GjyBJR1k6+RRyCKqvkoIV9HCnkkDFmywAHADOCfQy9M3RAqhlwhx97zefq3Set+12dORKdgvdsRDWRCL",
+	"9vsaWUCWEzqAwIFL3M9hDW94M65bucNjtVArZJsNSrwPNmXYsr+vcnL5XkJafYNyJ/jskhvHamGMN91X",
+	"q2Lq0eBufVcbpXYFNPJkStSEjbQr2XbVKyf1GigM7+gm88J+abLMmPb8CjzfX6AV3Cyksy/MGiTlwpmb",
+	"PPPerP/r9C9/Tcnp+7+m5K9vvkcy/wMuTuttiCaXJlOhNPmavGPfWWGVQ1YYtHiD2I092Ur9KfUJKoEw",
+	"rsXCBePUFKHO37RmnHMYL76lRrX2sMJWcCrHTbpmZo9r5otn9qQWsygaoC8D7KH+Ux0Hr/vRgdQa7SJE",
+	"nPtPs6OXCNJbOqYeTKkexZyFreu55sqSDvt2qqwsLPifn3/7zdMOGovH3TUZPahbQ4l0ZRLd48jLRn+x",
+	"nh9jfVtt0voOVe2ndr6eA+fnOai4+5fb4uGIj9INm+HcKr3WG8JcMNGgxLuPFxrc2ALGADoogX4V0+TT",
+	"3ljsuV/fu5ffuIbqZ3sYd97zeHN7pcDvZVO5MmjDY2xyYcjJrYe3S5U1PytegFIte9MioxItxCbtfRxj",
+	"K9zTPXLMeaOiB86m7fgNhXizGXliy2kxRFT/ZnnbK6hGiTfVkk+/nLm8gD+7a5eYrY/GsBYh73jobuO5",
+	"bTwE1O4gu9P9Pmohewew36sa0AO6DALW2Frctv5iTopGfdETaOc3bNIMH5K4rr60Zx2vgfXTsYTnyfmg",
+	"WLPfe2dr9iY6FIZ8j1xVsH9BTvoRycAnNzdy2Huwsn3TzU70fmHnPG57jZcyIwJqrWFMBDOfY1LYYa5r",
+	"UITreWuzIloyvIJxMVe0/zjPkhhZ0PHgizuU78v6xhxu3nCqjDitZDahKjL75JXHSkbI6Wa9ha6vHFAn",
+	"5NCfrC2EZHu46rmehzW0ZDxjhu8l8WkNrREaH3r7chIPBR0oPf18hYGA6TMDauAuuzKwuc9rx1qrVaMJ",
+	"Hpm3Pea0IaPHpG5TccLGOHKG+SoSPHxhnHy28zhqGP7amb/BDEst+mtGFeRWRxXSdTAcTJy7E/0iekq1",
+	"xtVZqbTFZ4v5dCVB9WMjh8xIUktMpe2NPe52HXR81EnrK0uqzjZaX0oFBfaDmeRbUeH0e0XNlWUbzWx1",
+	"kGOeAsjumZg6w+qJTTNSE5OSahBGJBRAVfPMA2o/jee11m05NEb8NBpHX6cItMYNi6hq1tPok6aCXGzg",
+	"kVifnYvB7bcfbqTwql6waM7tImUhij+xwrE5n73mh2xDVutVkNqQ7EQU+YkVjeZ+IJTpdT4amVmT/Pjw",
+	"2zUCxnMW1iMVGDQCs1TOmdj8MLS8ty0Pja5sbOEQX/aDS2+JsaZHoGd6Qp4fHvkL94LM10IITKSrSpSR",
+	"1CbQMB3gAVTK+vPXrFEvBi+xM/f2aeFzRH1I0qWauM8WZ2P0E3qiqUbn0dxlfy/eEoEAl7lRzye6HH38",
+	"k5WUz0LToW1wYdeJcfLEuo+vwAhJUWnyhJsI5xVeIafppQFoqHQtYJx1v2CfrLs3LNRKszXs33e1MyyN",
+	"Bmn/FpOnI45uLywGhYyx4il3V69cQE28lAj+A6pMU8arFYV2mthvh3p1rpNrKmEiKgVps2SCQ61TL9uZ",
+	"/3iouwkUNgTkTuXgLL7DiN0yXGRdL9kluQCUOij0W6mQqzDTAgZyKxJQKw3YY9FBaZksqp8u5LH6BUIz",
+	"KZTqAZ44cOIwAXvJZRnQjc8HlI9QMw57+8pAr9k/CMtPbL7pnvkIZU99tYldmpX16GCLLqdDh0sTXY46",
+	"ybK/HpfMbjyvKDofStu7FDpMAxWmfxePMe/x3AnzOtHFv+QKaRdn6/V7DWw8iZzd72vQ7yAPOOwe0+dy",
+	"3ClLhFO0DVUbktRdzifoJkvYm1ZvYTvVmTQRL0bF9UCAio6DB3McjqlrJdYzgnNFNrWB7LIAXM1dP+6y",
+	"qZenb/o602IDTwLNEfm7479uuMWCI4ef21/SHob0srbiQJfzUYZW9lAtC/DcJvpatkhNojgcjRW95l7S",
+	"IeiZ1Wm7MSeep84QRTa5K7G9Xez5drFnp8FEtAGtJbuo9Pxg1vzQYvIP1EoVONHvD2ZaUqnd7S2YbmQr",
+	"gUQhMMRrr7KW7rqnPePxi5aq3K23afU67Hjgse9M8VRQUBT1ZbmdMvtlgcpWTYG7rKJXsWeXJh/AOrqY",
+	"Tu3dYJb2J6RqCR7U8cIyzY04px4WQlno3ECSpuFmWQ8vqIdpuUo+2VI7NZDs3xwvjKf2kWPtpuyeN5gd",
+	"YJLCarbuRNlq9END92aoHWO+E2g+igWaN7kpva2Pm3CVPTg/CSC6v171SGYvkT7720eV2hOGqbp81dCy",
+	"BpQ2RApotPQKdtgWBzaH7dYIrA4s9+2Q29xwbqFjR+rBHxKkZg/CbgetuYPW/NLQmgNXs+wgNr8UxGZ3",
+	"QR401GYXJXAHubmD3FxLhY5frfSA0Bs7E/hDQ3DG75h6gIv5h4TkHIBW3EFzzifUDqJzB9HZYYwdVOcO",
+	"qnMH1blRqM7W1nqkkJ3xCy530J2bgu7spvbvIDx3EJ47CM8dhOcOwnMH4bmD8NxBeO4gPHcQnjsIz9Ug",
+	"POM32u+gPHdQnjsozx2U58OA8ozjaz0+SM8opN4O2nMH7bmD9nzU0J6xff8IIT5jze2gPu8O6jNO7x3k",
+	"Z5cwO+jPx1Z+1SHnDgJ0BwG6gwDdQYDuIEDvHAK0C+J4v1CgcyAkd5Cgy5offxRo0OFGdxChaTKAcbiD",
+	"Ct1BhW4lVGicXx8vZGjT4A46dAcdun3QoRH+3EGIPiQI0Rqkawcluk1Qoi3MvB2k6BeBFI3gFu6gRXfQ",
+	"oncDLdpmth3E6JZAjEYwLHdQo5uAGu0TdusgR1tIiTsA0PsFAA2JvwMCHaLMDhB0OyPSO2DQHTDoDhj0",
+	"iwODdrbjDiB0BxB6nwChHfZ7LEChN6Y0eSSwP8002qPJWJSSZhrZ5DVMBSrAwd58kRztH+4fYo+iBE5L",
+	"lrxInpufLKCiGftBAwSIf44t2D/SyngSUB4nSMNXzWvG2C4Fd2HJZ4eHHWArg7Bg/WwHvzl8J0uHVVH6",
+	"zOqZufdN4OuJKIKInpYAqBVRMiqoNjXphrg20+OfSTCBX2yqaWSmVlgFoTHn3fxO5LOhsTevMFDBg19b",
+	"D7pYlDc9Kh7dGRVjFHzlQJayZq72hDGj+YtLLon14l4LZ+p++tV8Zjo7Pjwc+r6edptW7sdfa8Ck48Nv",
+	"b9fE0OLfpCHXH3xm+Y3z24KGWAka/q6a1IIAdJOD9TVaP6Mp+29Bawb1yKkFS+g6A9POqdHmR9t3wI8l",
+	"lXQK2izTP+PEaV4JqdP8+uub18lNuu63o3cm4fPmlx7/Hg+RLk82xRDHX5yn0uT46NndsGUaF75/BX0H",
+	"6//LPQrxqPhxz6wyZSIjDZjKFoqi47ta87KKRv6c7dhOWPEpxKmlWuBY7mAP21wajx7cFys2CWtrxcq9",
+	"Hbn3y/MfHTLfNh+5j1rC4sHvj2PsYZ4OeFpnUG2OH9s4AfehAfpZzFEAm1SxrWLGYA1P/ZK1V/DgYraH",
+	"Uf2Dz/jfm8B66SCSCHGpgqwJmythpCamD5CX5v/tyg0FuUcK9PgpBmpTcGORW5O2AK2NR0ZBSihX1yCV",
+	"TdPwDgKfp+CzDvqS+K+g3fS+m53ZFIVNyGLT1NrS+JUvBbmFPH8vODiZfotDwYGmnDGewfoNWZgrj3J1",
+	"P8rPnJ13xvi4gPjGe0WzCexhHbAUxRo70HzvP79Jb7eR06QFXbNGO/h9/bm9vWW2RjPmM0PJ53cgJFtZ",
+	"WAPOBptYpQzAQRzXUXBoL+XbIHVqVbL5T92cj4dBpyvuIU4VbhLnli9YTrWQttZqx2GrcNiWmQtDh5/F",
+	"zBp02Z2Zx/XHmzlV/r6+CDaJ57f5+pZnSXDtx5otvMMyUXabY+gd/XTLFs6E1Ot/bXLw1//81CIU3OJr",
+	"E8G9jVaixC3G/yaIQ39R3ciO4yNvEOy+rJ71BdWjIf8+/h4mR23lEebQWvxJtgHd5jhar4DoSiUdQ/Ss",
+	"x96395TfNInuw6x0OXjDR6t9vuGz9VTCiH26xdFgR/WWTZm+ny3cSSKM7OIPlF82HoYGmRiLeoNEy+Se",
+	"FtbEX+YGOc9thGbTS7uhg3ML5LIjUCOX72np+jG6WJys9qS8eb0LlX05L6xHhpGQCW4zd763F8gMLPVw",
+	"5Gu7VnTnF9v5xbbWL7bzEe18RL/Y+4qzSV+YGqCtJqj1OAO+LUyymy8rzcwgtje+9vCDvWuoGS7ZIpYM",
+	"8QfZGveYCjFnb/hMiN3e2Ja90bO2PFbicJ7ES/vCtm6cLyj5ffGvAe+rocuYr4zc8ecm+NOg+c7PWQ/B",
+	"edUDS57s4VVHGM1OrFOnkGyffuoWYDjt3iInh1Pe9Gpt+BgN8Z7vMY/LkiZ6oLo7oZl941EJmeebZ8Qh",
+	"cXLw2fz/zfyKgA8w7cCRo1fbQPqGdzTYJt0xwLRFHq9Kk0QW4sXPcWVuei+sfbQaqrx+mL7MuCRa4HJ8",
+	"AJQ/vHcxYx6QKWiaU023/KRZaOtt5xLfzUmV37PVN+eksqbfVh1U6x0RBwHhopnHZyDbxwTTCopRU/Pi",
+	"GjCXM5wQVZWlkFoRZR3hpuSjKjQrCzAXwRBpYO3nZRGb4buo+zax9roNfHDXiq9tEt66gSBgs1gEm2U+",
+	"+FN72yy+W2NA0Gb1Ot7ePdLKxMAOnx1+c0eDP2/dYB0w7t1MJBr6cG+tkjKzqfFsS/j2mxi+Oq8vMWpW",
+	"yC4OKRgoI5ocjp3njCWlowEY2JswpR388yKz3ORC/uDef2jGeTD0qO9fWew3j81niKOSbQ8dRY3zMwxj",
+	"VAWEy7a95jnLwA/4nixzRPCY2FMmEtN2Y3G3TLrbO5KHk2du97bNVB32BZ/aF3a+4J0v+Ev4gh0i/6Ai",
+	"ftq/fdx9Yq+BY8o4ZRCcvr47wCDUNcXXKfE3JxWzlGRir/SQ+X11HA+5Nhi92oIt4UbkMkRvmb1uL5K4",
+	"n+0VuXYglmzqFnSjqYn3KGMlKO0utYjL2A/2hc3L2C8sIt288m0LYGwWgWbu2hso3kGdubHuDb7dA1v5",
+	"Bls5ph7hQ1IgdnA3jlX71D2M8TY6i/gVcGMEDK7qAa1x5FuYA206fGdq1GMQb77qvIMgbarQDWx8iv9R",
+	"zN7Kh3hrAQ54/2iyqPZ3yU6bUuW7IPw398arFsm6z6x2MOhKaLj2cQqr+Xxt3xpMC8nz4IK6R55O5RJU",
+	"t8HCqEEnsLqG5vkOdmtz9gW+dPBZ0/HcIhQbFt4q5rf8+Uhs6BaH24tAdzy+OR5v7mhcKqvqPHz/QRoj",
+	"3cs44/Vu9SS3P8+qtSSL1vjgs70odPm6uqD5LRBtb83oH3xGSmfNBgABbdq2stfsuTuK2zA0dXFt8KEx",
+	"Ejj4G2FJ/+IYvDkEYW0YJ8BTogQB7qPU5s47BB9G4PSCXYKxLmoQbMk0WNcZIpd3h+NAiPsGyGmlt5+d",
+	"Nq0l9q6/vfkiUi7ufTHslIeXCyc36R3FSpYZkQem6wzoocnaii/MoP/oX9nFTXZxky+h89WutSX0vZ/8",
+	"uw9L1wuvAYjwmZ/V9it3Nf0Xode312tb3Xvt20HuI1LvCTLnyLmqabarR1uSF4eFysHn+q7I5W2MzXPt",
+	"uiehG8kOAWTz0mtBlGsreeAez6o559TjhsZvH3FxYPzA+D3728c0vH6Jcp9lhZGw5oJ3MWVa11l45rYl",
+	"MqWXBkp/0W1Jc8oHHrGouq9D/l63kK882B3yX0Lqt/WE+to5LKKY1sHxRTbIK//upjD37ChefzlQ0nvE",
+	"63PEG7KE3GNl7kLeXjOoZoElzSD3/uY5ZrM3ldhR3ufdYI4u864Gq0n3mK/jafhpoYg6+Oz+tYpFc2cM",
+	"uLbIcnO4RRN4a/Gb13+vQM4eqnX0/JEZWG25uLD2dceV93sTlZ3dPUZ/5sj3+h6q7ZXvj3h3hqfMC/hU",
+	"CjmngFhLoFPlboR2X5EpMijas+YOYnPRAxmxQptb9RknLLd31aZkJMXURIAzwRVTpiJScVqqiaivt82o",
+	"poUY75NTOsZG0YpWQhqDORfmwhPkxlnfGv6LGfxwhUPnbl2eCVML5/rNq2l5QniO7G06zdSVud16Zkbs",
+	"J1uCJAXjxhhn2M7vZn/7a/99fWoabI8cRrQqdPIisa0naQK8muI61D9k6ipJE/PHL5GbhncXZDzMCzLu",
+	"5naG21pnK10UfdqYXr2LosNuPu3xvN9V5855DsS1R+xF0q391GP7BPNBDnBvzGn0B3sRvRTXKW5klDcp",
+	"sTekNQ62tL6YPDXFVOSJAqSyrQgnPyf/83PyNCVKU12plNTEfmlu7HcXzRux4DJJzMCdUNhfpgr9lZVr",
+	"Rs7cE0z5Czb14jye5v+TBd0FL9GluCZ6IkU1nrRy/mVVgDqxGL34kruoXkzRk4ngGZxc4HYHc9u6i43T",
+	"DHshQLNJaosneKcBCTg8873SQHMUxZjHo4Hvk+9ZAYqIK5Dk+TPyjn3nPrEZQDb58vjo+QlRZcE0Kagc",
+	"gyR2xpEauDfT+YfDWpvztZx9qPhdAaWU9nadu9fNbFdn1XRK4/Xj9gWi/Bub0ao2CKTV2QBBycmcIpcf",
+	"BN5zbYsnKq5Z4XAI6m8x5axhcpPrVgBVmH+WCe4vWgs7Ixw3kuFadOT32dD6Mj40n2z0Qs+g3Xt03YSz",
+	"iaXOOMwBGb72R6hSCRmjvjFiKNbYZomHlNGyYPk/bN+yr7JmB1YADEuRcxO5ayQH5L5urjI2jZEv++RH",
+	"Xsxq+I2WyMgoJxdAFGhdoGgxl7kqew1//Bb+V2ZIj5ZjziwldgLj5sAdOMPc926I++gVZQW9QNiuMWV8",
+	"swz4wQ5rx4GPjQMr5dxu8ZurmdKKmHdMVM4BxBEtRMH4OHVeH2tSmQIAIQlMKSuM/n8xI5kEq1lpNoU4",
+	"UMVHM4TNsBO2dQsfiAs8fS/F9NaNnIvbOZJ3V21u7KrN+xAwuGRDkW30gpj7E8XIbqZNuwPsFgoD0l2V",
+	"bMwUEoZQM4ATQrnfp4UEms+IppfAccdSbi+Xx/eMNxeLblo3zZtzQg1oKob5cTwbtXOwwXs0cMz45wSm",
+	"K/N8Q+fDtxtng1qwR66tiwU1rT6BXxAtxmCW39e6MunDNGqfvLQvXU8EUZoVBZlQZV39yt9NbnvKyZPj",
+	"w2+fpg700L1CJZBLKDWhiowYpzxjtCASMiFzNQRE7Xjp7pSNB5EJewdcMpwGi29s+Ma7+xLBc6512+im",
+	"Pb4L2b0w4RSnEJZaGhG+rDCfK7dtSPYu9tq9HAH3x0w+dr1N3HRHp0jz29C9fiYUajhxSjkdwxS4JsDz",
+	"UjCbJOlipKcNJFq3KeyN0CwTlfGsop5i2dJlU4sRKyDW5kenSA2NzZ1aC0ZWx8XnNORB+LQEWNScfZfB",
+	"vAZd/i95cva3j08XNPhTgz31eRi6ytAq9GNHWmosv+GRWZDwytzl4Wq5C2YCRbEGpwaKN9IayD1b8d2/",
+	"eTl4M9Zmq6j15peb/x0A+yC6/NFwAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,6 +1,7 @@
 package httpadapter
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
@@ -11,13 +12,53 @@ func presentProduct(p *domain.Product) Product {
 	if p == nil {
 		return Product{}
 	}
+	currency := p.BaseCurrency()
 	return Product{
-		Id:    p.ID,
-		Name:  p.Name,
-		Price: centsToAmount(p.Price),
+		Id:             p.ID,
+		Name:           p.Name,
+		Slug:           p.Slug,
+		Description:    p.Description,
+		Locale:         p.ContentLocale(),
+		PriceCents:     p.Price,
+		Currency:       currency,
+		PriceOverrides: presentPriceOverrides(p.PriceOverrides),
+		Price:          minorUnitsToAmount(p.Price, currency),
+		Tags:           presentTags(p.Tags),
+		CategoryId:     p.CategoryID,
+		CategoryPath:   presentBreadcrumbs(p.Category),
+		Status:         ProductStatus(p.LifecycleStatus()),
+		PublishedAt:    p.PublishedAt,
+		DeletedAt:      p.DeletedAt,
+		Version:        p.Version,
+		UpdatedAt:      p.UpdatedAt,
 	}
 }
 
+func presentPriceOverrides(overrides map[string]int64) map[string]int64 {
+	out := make(map[string]int64, len(overrides))
+	for code, price := range overrides {
+		out[code] = price
+	}
+	return out
+}
+
+func presentTags(tags []string) []string {
+	if len(tags) == 0 {
+		return []string{}
+	}
+	out := make([]string, len(tags))
+	copy(out, tags)
+	return out
+}
+
+func presentBreadcrumbs(path domain.CategoryPath) []Breadcrumb {
+	out := make([]Breadcrumb, 0, len(path))
+	for _, ref := range path {
+		out = append(out, Breadcrumb{Id: ref.ID, Name: ref.Name})
+	}
+	return out
+}
+
 func presentProducts(items []domain.Product) []Product {
 	if len(items) == 0 {
 		return []Product{}
@@ -29,12 +70,136 @@ func presentProducts(items []domain.Product) []Product {
 	return out
 }
 
-func centsToAmount(cents int64) float32 {
-	return float32(cents) / 100.0
+func presentProductList(criteria domain.ProductSearch, result *domain.ProductSearchResult) ProductList {
+	list := ProductList{
+		Items:    []Product{},
+		Page:     criteria.Page,
+		PageSize: criteria.PageSize,
+	}
+	if result == nil {
+		return list
+	}
+	list.Items = presentProducts(result.Items)
+	for i := range result.Items {
+		inStock := result.Items[i].InStock
+		list.Items[i].InStock = &inStock
+	}
+	for i := range result.Scores {
+		if i < len(list.Items) {
+			score := result.Scores[i]
+			list.Items[i].Score = &score
+		}
+	}
+	list.NextCursor = encodeProductCursor(result.Next)
+	if criteria.SkipTotal {
+		return list
+	}
+	total := result.Total
+	list.Total = &total
+	list.Facets = &ProductFacets{Tags: []TagFacet{}}
+	for _, f := range result.Facets {
+		list.Facets.Tags = append(list.Facets.Tags, TagFacet{Tag: f.Tag, Count: f.Count})
+	}
+	return list
+}
+
+func presentTrashList(page domain.TrashPage, list *domain.TrashList) ProductList {
+	out := ProductList{
+		Items:    []Product{},
+		Page:     page.Page,
+		PageSize: page.PageSize,
+	}
+	if list == nil {
+		return out
+	}
+	out.Items = presentProducts(list.Items)
+	total := list.Total
+	out.Total = &total
+	return out
 }
 
-func amountToCents(amount float32) int64 {
-	return int64(math.Round(float64(amount) * 100.0))
+func presentPriceChange(c *domain.PriceChange) PriceChange {
+	if c == nil {
+		return PriceChange{}
+	}
+	out := PriceChange{
+		Id:            c.ID,
+		ProductId:     c.ProductID,
+		PriceCents:    c.Price,
+		Currency:      c.Currency,
+		EffectiveFrom: c.EffectiveFrom.UTC(),
+		Status:        PriceChangeStatus(c.Status),
+		CreatedAt:     c.CreatedAt.UTC(),
+	}
+	if c.AppliedAt != nil {
+		at := c.AppliedAt.UTC()
+		out.AppliedAt = &at
+	}
+	return out
+}
+
+func presentPriceHistory(items []domain.PriceChange) PriceHistory {
+	out := PriceHistory{Items: make([]PriceChange, 0, len(items))}
+	for i := range items {
+		out.Items = append(out.Items, presentPriceChange(&items[i]))
+	}
+	return out
+}
+
+func presentSuggestionList(items []domain.Suggestion) SuggestionList {
+	out := SuggestionList{Items: make([]Suggestion, 0, len(items))}
+	for _, s := range items {
+		item := Suggestion{Text: s.Text, Kind: SuggestionKind(s.Kind), Weight: s.Weight}
+		if s.Kind == domain.SuggestionName {
+			id := s.ProductID
+			item.ProductId = &id
+		}
+		out.Items = append(out.Items, item)
+	}
+	return out
+}
+
+func presentRelatedProductList(items []domain.RelatedProduct) RelatedProductList {
+	out := RelatedProductList{Items: make([]RelatedProduct, 0, len(items))}
+	for i := range items {
+		item := &items[i]
+		out.Items = append(out.Items, RelatedProduct{
+			Product:      presentProduct(&item.Product),
+			Score:        item.Score,
+			SharedTags:   presentTags(item.SharedTags),
+			SameCategory: item.SameCategory,
+			CoPurchases:  item.CoPurchases,
+		})
+	}
+	return out
+}
+
+// minorUnitsToAmount feeds the deprecated decimal price field; float64 keeps every minor unit exact up to 2^53.
+func minorUnitsToAmount(amount int64, currency string) float64 {
+	return float64(amount) / math.Pow10(domain.CurrencyExponent(currency))
+}
+
+func amountToMinorUnits(amount float64, currency string) int64 {
+	return int64(math.Round(amount * math.Pow10(domain.CurrencyExponent(currency))))
+}
+
+func presentImportSummary(r *domain.ImportResult) ImportSummary {
+	out := ImportSummary{
+		DryRun:  r.DryRun,
+		Created: r.Created,
+		Updated: r.Updated,
+		Failed:  r.Failed,
+		Errors:  make([]ImportRowError, 0, len(r.Errors)),
+	}
+	for _, e := range r.Errors {
+		rowErr := ImportRowError{Line: e.Line, Message: e.Message}
+		if e.ID != 0 {
+			id := e.ID
+			rowErr.Id = &id
+		}
+		out.Errors = append(out.Errors, rowErr)
+	}
+	return out
 }
 
 func presentUser(u *domain.User) User {
@@ -51,6 +216,26 @@ func presentUser(u *domain.User) User {
 	}
 }
 
+func presentUserList(criteria domain.UserSearch, result *domain.UserSearchResult) UserList {
+	list := UserList{
+		Items:    []User{},
+		Page:     criteria.Page,
+		PageSize: criteria.PageSize,
+	}
+	if result == nil {
+		return list
+	}
+	for i := range result.Items {
+		list.Items = append(list.Items, presentUser(&result.Items[i]))
+	}
+	list.NextCursor = encodeUserCursor(result.Next)
+	if !criteria.SkipTotal {
+		total := result.Total
+		list.Total = &total
+	}
+	return list
+}
+
 func presentComment(c *domain.Comment) Comment {
 	if c == nil {
 		return Comment{}
@@ -62,6 +247,17 @@ func presentComment(c *domain.Comment) Comment {
 		Content:   c.Content,
 		CreatedAt: c.CreatedAt.UTC(),
 		UpdatedAt: c.UpdatedAt.UTC(),
+		Version:   c.Version,
+	}
+}
+
+func presentCommentList(list *domain.CommentList) CommentList {
+	if list == nil {
+		return CommentList{Items: []Comment{}}
+	}
+	return CommentList{
+		Items:      presentComments(list.Items),
+		NextCursor: encodeCommentCursor(list.Next),
 	}
 }
 
@@ -75,3 +271,136 @@ func presentComments(items []domain.Comment) []Comment {
 	}
 	return out
 }
+
+func presentCategory(c *domain.Category) Category {
+	if c == nil {
+		return Category{}
+	}
+	return Category{
+		Id:        c.ID,
+		Name:      c.Name,
+		ParentId:  c.ParentID,
+		Path:      presentBreadcrumbs(c.Path),
+		Version:   c.Version,
+		UpdatedAt: c.UpdatedAt.UTC(),
+	}
+}
+
+func presentCategoryList(items []domain.Category) CategoryList {
+	out := CategoryList{Items: make([]Category, 0, len(items))}
+	for i := range items {
+		out.Items = append(out.Items, presentCategory(&items[i]))
+	}
+	return out
+}
+
+func presentVariant(v *domain.Variant) Variant {
+	if v == nil {
+		return Variant{}
+	}
+	attributes := make(map[string]string, len(v.Attributes))
+	for name, value := range v.Attributes {
+		attributes[name] = value
+	}
+	return Variant{
+		Id:         v.ID,
+		ProductId:  v.ProductID,
+		Sku:        v.SKU,
+		Attributes: attributes,
+		PriceCents: v.PriceCents,
+		CreatedAt:  v.CreatedAt.UTC(),
+		UpdatedAt:  v.UpdatedAt.UTC(),
+		Version:    v.Version,
+	}
+}
+
+func presentVariantList(items []domain.Variant) VariantList {
+	out := VariantList{Items: make([]Variant, 0, len(items))}
+	for i := range items {
+		out.Items = append(out.Items, presentVariant(&items[i]))
+	}
+	return out
+}
+
+func presentStockLevel(s *domain.StockLevel) StockLevel {
+	if s == nil {
+		return StockLevel{}
+	}
+	return StockLevel{
+		VariantId: s.VariantID,
+		OnHand:    s.OnHand,
+		Reserved:  s.Reserved,
+		Available: s.Available(),
+		UpdatedAt: s.UpdatedAt.UTC(),
+	}
+}
+
+func presentStockList(levels []domain.StockLevel) StockList {
+	out := StockList{Items: make([]StockLevel, 0, len(levels))}
+	for i := range levels {
+		out.Items = append(out.Items, presentStockLevel(&levels[i]))
+		out.Available += levels[i].Available()
+	}
+	return out
+}
+
+func presentReservation(r *domain.Reservation) Reservation {
+	if r == nil {
+		return Reservation{}
+	}
+	return Reservation{
+		Id:        r.ID,
+		ProductId: r.ProductID,
+		VariantId: r.VariantID,
+		Quantity:  r.Quantity,
+		Status:    ReservationStatus(r.Status),
+		CreatedAt: r.CreatedAt.UTC(),
+		UpdatedAt: r.UpdatedAt.UTC(),
+	}
+}
+
+func presentImage(img *domain.ProductImage) ProductImage {
+	if img == nil {
+		return ProductImage{}
+	}
+	return ProductImage{
+		Id:          img.ID,
+		ProductId:   img.ProductID,
+		Position:    img.Position,
+		AltText:     img.AltText,
+		ContentType: img.ContentType,
+		Size:        img.Size,
+		Checksum:    img.Checksum,
+		Url:         fmt.Sprintf("/products/%d/images/%d/content", img.ProductID, img.ID),
+		CreatedAt:   img.CreatedAt.UTC(),
+		UpdatedAt:   img.UpdatedAt.UTC(),
+	}
+}
+
+func presentImageList(items []domain.ProductImage) ProductImageList {
+	out := ProductImageList{Items: make([]ProductImage, 0, len(items))}
+	for i := range items {
+		out.Items = append(out.Items, presentImage(&items[i]))
+	}
+	return out
+}
+
+func presentTranslation(t *domain.ProductTranslation) ProductTranslation {
+	if t == nil {
+		return ProductTranslation{}
+	}
+	return ProductTranslation{
+		Locale:      t.Locale,
+		Name:        t.Name,
+		Description: t.Description,
+		UpdatedAt:   t.UpdatedAt.UTC(),
+	}
+}
+
+func presentTranslationList(items []domain.ProductTranslation) ProductTranslationList {
+	out := ProductTranslationList{Items: make([]ProductTranslation, 0, len(items))}
+	for i := range items {
+		out.Items = append(out.Items, presentTranslation(&items[i]))
+	}
+	return out
+}
@@ -1,49 +1,220 @@
 package httpadapter
 
-import "github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
 
 const (
 	defaultPage     = 1
 	defaultPageSize = 20
 )
 
-type searchFilters struct {
-	query    string
-	page     int
-	pageSize int
+// importOptionsInput picks the import format from the request's media type.
+func importOptionsInput(contentType string, params ImportProductsParams) (domain.ImportOptions, error) {
+	var opts domain.ImportOptions
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/csv":
+		opts.Format = domain.ImportCSV
+	case "application/x-ndjson":
+		opts.Format = domain.ImportNDJSON
+	default:
+		return opts, domain.ValidationError("Content-Type must be text/csv or application/x-ndjson")
+	}
+	opts.DryRun = params.DryRun != nil && *params.DryRun
+	return opts, nil
 }
 
-func newSearchFilters(params SearchProductsParams) searchFilters {
-	filters := searchFilters{
-		page:     defaultPage,
-		pageSize: defaultPageSize,
+func newSearchCriteria(params SearchProductsParams) (domain.ProductSearch, error) {
+	criteria := domain.ProductSearch{
+		TagMatch: domain.TagMatchAny,
+		SortBy:   domain.SortByID,
+		Page:     defaultPage,
+		PageSize: defaultPageSize,
+	}
+
+	if params.Q != nil {
+		criteria.Query = *params.Q
+	}
+	if params.Tags != nil {
+		criteria.Tags = *params.Tags
+	}
+	if params.TagMatch != nil {
+		criteria.TagMatch = domain.TagMatch(*params.TagMatch)
+	}
+	criteria.CategoryID = params.Category
+	criteria.MinPrice = params.MinPrice
+	criteria.MaxPrice = params.MaxPrice
+	if params.Sort != nil {
+		criteria.SortBy = domain.ProductSortField(*params.Sort)
+	}
+	if params.Order != nil {
+		criteria.Order = domain.SortOrder(*params.Order)
 	}
+	if params.Page != nil {
+		criteria.Page = *params.Page
+	}
+	if params.PageSize != nil {
+		criteria.PageSize = *params.PageSize
+	}
+	if params.Cursor != nil {
+		after, err := decodeProductCursor(*params.Cursor)
+		if err != nil {
+			return criteria, err
+		}
+		criteria.After = after
+	}
+	if params.IncludeTotal != nil {
+		criteria.SkipTotal = !*params.IncludeTotal
+	}
+	if params.IncludeUnpublished != nil {
+		criteria.IncludeUnpublished = *params.IncludeUnpublished
+	}
+	if params.Currency != nil {
+		criteria.Currency = *params.Currency
+	}
+	criteria.Locales = localesInput(params.AcceptLanguage)
+
+	return criteria, nil
+}
 
+// newExportCriteria maps the export filters; ndjson is the default format.
+func newExportCriteria(params ExportProductsParams) (domain.ProductSearch, ExportProductsParamsFormat) {
+	criteria := domain.ProductSearch{TagMatch: domain.TagMatchAny, SortBy: domain.SortByID}
 	if params.Q != nil {
-		filters.query = *params.Q
+		criteria.Query = *params.Q
 	}
+	if params.Tags != nil {
+		criteria.Tags = *params.Tags
+	}
+	if params.TagMatch != nil {
+		criteria.TagMatch = domain.TagMatch(*params.TagMatch)
+	}
+	criteria.CategoryID = params.Category
+	criteria.MinPrice = params.MinPrice
+	criteria.MaxPrice = params.MaxPrice
+	if params.Currency != nil {
+		criteria.Currency = *params.Currency
+	}
+	if params.IncludeUnpublished != nil {
+		criteria.IncludeUnpublished = *params.IncludeUnpublished
+	}
+	format := ExportProductsParamsFormatNdjson
+	if params.Format != nil {
+		format = *params.Format
+	}
+	return criteria, format
+}
+
+func productCurrencyInput(params GetProductByIDParams) string {
+	if params.Currency == nil {
+		return ""
+	}
+	return *params.Currency
+}
+
+// localesInput turns Accept-Language into the translation lookup chain; unusable tags are skipped
+// rather than rejected, as content negotiation only expresses preferences.
+func localesInput(acceptLanguage *string) []string {
+	if acceptLanguage == nil {
+		return nil
+	}
+	return domain.ParseAcceptLanguage(*acceptLanguage)
+}
+
+func commentPageInput(params ListProductCommentsParams) (domain.CommentPage, error) {
+	var page domain.CommentPage
+	if params.Limit != nil {
+		page.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		after, err := decodeCommentCursor(*params.Cursor)
+		if err != nil {
+			return page, err
+		}
+		page.After = after
+	}
+	return page, nil
+}
+
+func trashPageInput(params ListTrashedProductsParams) domain.TrashPage {
+	var page domain.TrashPage
 	if params.Page != nil {
-		filters.page = *params.Page
+		page.Page = *params.Page
 	}
 	if params.PageSize != nil {
-		filters.pageSize = *params.PageSize
+		page.PageSize = *params.PageSize
 	}
+	return page.Normalize()
+}
 
-	return filters
+func suggestInput(params SuggestProductsParams) (string, int) {
+	limit := domain.DefaultSuggestLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	return params.Prefix, limit
+}
+
+func relatedQueryInput(id int64, params ListRelatedProductsParams) domain.RelatedQuery {
+	query := domain.RelatedQuery{ProductID: id}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	if params.IncludeOrders != nil {
+		query.IncludeOrders = *params.IncludeOrders
+	}
+	return query
 }
 
 func newProductFromCreateBody(body *CreateProductJSONRequestBody) (*domain.Product, error) {
 	if body == nil {
 		return nil, domain.ValidationError("invalid request body")
 	}
-	return domain.NewProduct(body.Name, amountToCents(body.Price), nil)
+	currency := domain.DefaultCurrency
+	if body.Currency != nil {
+		var err error
+		if currency, err = domain.NormalizeCurrency(*body.Currency); err != nil {
+			return nil, err
+		}
+	}
+	cents, err := priceCentsFromBody(body.PriceCents, body.Price, currency)
+	if err != nil {
+		return nil, err
+	}
+	product, err := domain.NewProduct(body.Name, cents, tagsFromBody(body.Tags))
+	if err != nil {
+		return nil, err
+	}
+	if body.Description != nil {
+		if err := product.Describe(*body.Description); err != nil {
+			return nil, err
+		}
+	}
+	if err := product.SetPricing(currency, overridesFromBody(body.PriceOverrides)); err != nil {
+		return nil, err
+	}
+	if err := product.SetCategory(body.CategoryId); err != nil {
+		return nil, err
+	}
+	return product, nil
 }
 
 func newProductFromUpdateBody(id int64, body *UpdateProductJSONRequestBody) (*domain.Product, error) {
 	if body == nil {
 		return nil, domain.ValidationError("invalid request body")
 	}
-	product, err := domain.NewProduct(body.Name, amountToCents(body.Price), nil)
+	// create and update share the same payload schema
+	create := CreateProductJSONRequestBody(*body)
+	product, err := newProductFromCreateBody(&create)
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +222,261 @@ func newProductFromUpdateBody(id int64, body *UpdateProductJSONRequestBody) (*do
 	return product, nil
 }
 
+// priceCentsFromBody prefers priceCents and falls back to the deprecated decimal price.
+func priceCentsFromBody(cents *int64, amount *float64, currency string) (int64, error) {
+	switch {
+	case cents != nil:
+		return *cents, nil
+	case amount != nil:
+		return amountToMinorUnits(*amount, currency), nil
+	default:
+		return 0, domain.ValidationError("priceCents required")
+	}
+}
+
+func overridesFromBody(overrides *map[string]int64) map[string]int64 {
+	if overrides == nil {
+		return nil
+	}
+	return *overrides
+}
+
+func priceScheduleInput(body *ScheduleProductPriceJSONRequestBody) (int64, time.Time, error) {
+	if body == nil {
+		return 0, time.Time{}, domain.ValidationError("invalid request body")
+	}
+	return body.PriceCents, body.EffectiveFrom, nil
+}
+
+func tagsFromBody(tags *[]string) []string {
+	if tags == nil {
+		return nil
+	}
+	return *tags
+}
+
+func productTagInput(body *AddProductTagJSONRequestBody) (string, error) {
+	if body == nil {
+		return "", domain.ValidationError("invalid request body")
+	}
+	return body.Tag, nil
+}
+
+// productPatchInput maps a merge-patch body onto a domain.ProductPatch; absent fields stay nil.
+func productPatchInput(body *PatchProductApplicationMergePatchPlusJSONRequestBody) (domain.ProductPatch, error) {
+	if body == nil {
+		return domain.ProductPatch{}, domain.ValidationError("invalid request body")
+	}
+	patch := domain.ProductPatch{
+		Name:        body.Name,
+		Description: body.Description,
+		Price:       body.PriceCents,
+		Currency:    body.Currency,
+		Tags:        body.Tags,
+	}
+	if body.PriceOverrides != nil {
+		patch.PriceOverrides = *body.PriceOverrides
+	}
+	if body.CategoryId.Set {
+		categoryID := body.CategoryId.Ptr()
+		patch.CategoryID = &categoryID
+	}
+	return patch, nil
+}
+
+// NullableInt64 tells an absent merge-patch member (Set false) apart from an explicit null
+// (Set true, Null true), which a plain *int64 cannot.
+type NullableInt64 struct {
+	Set   bool
+	Null  bool
+	Value int64
+}
+
+func (n *NullableInt64) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		n.Null = true
+		return nil
+	}
+	return json.Unmarshal(data, &n.Value)
+}
+
+func (n NullableInt64) MarshalJSON() ([]byte, error) {
+	if !n.Set || n.Null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+// Ptr returns the value, or nil for null and absent members.
+func (n NullableInt64) Ptr() *int64 {
+	if !n.Set || n.Null {
+		return nil
+	}
+	v := n.Value
+	return &v
+}
+
+func categoryInput(body *CreateCategoryJSONRequestBody) (string, *int64, error) {
+	if body == nil {
+		return "", nil, domain.ValidationError("invalid request body")
+	}
+	return body.Name, body.ParentId, nil
+}
+
+func categoryUpdateInput(body *UpdateCategoryJSONRequestBody) (string, *int64, error) {
+	if body == nil {
+		return "", nil, domain.ValidationError("invalid request body")
+	}
+	return body.Name, body.ParentId, nil
+}
+
+func newUserSearchCriteria(params ListUsersParams) (domain.UserSearch, error) {
+	criteria := domain.UserSearch{
+		SortBy:      domain.UserSortByID,
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		Page:        defaultPage,
+		PageSize:    defaultPageSize,
+	}
+	if params.Q != nil {
+		criteria.Query = *params.Q
+	}
+	if params.Sort != nil {
+		criteria.SortBy = domain.UserSortField(*params.Sort)
+	}
+	if params.Order != nil {
+		criteria.Order = domain.SortOrder(*params.Order)
+	}
+	if params.Page != nil {
+		criteria.Page = *params.Page
+	}
+	if params.PageSize != nil {
+		criteria.PageSize = *params.PageSize
+	}
+	if params.Cursor != nil {
+		after, err := decodeUserCursor(*params.Cursor)
+		if err != nil {
+			return criteria, err
+		}
+		criteria.After = after
+	}
+	if params.IncludeTotal != nil {
+		criteria.SkipTotal = !*params.IncludeTotal
+	}
+	return criteria.Normalize(), nil
+}
+
+func userInput(body *CreateUserJSONRequestBody) (string, string, error) {
+	if body == nil {
+		return "", "", domain.ValidationError("invalid request body")
+	}
+	return body.Name, string(body.Email), nil
+}
+
+func userUpdateInput(body *UpdateUserJSONRequestBody) (string, string, error) {
+	if body == nil {
+		return "", "", domain.ValidationError("invalid request body")
+	}
+	return body.Name, string(body.Email), nil
+}
+
+// variantInput carries the create and replace payloads of a variant.
+type variantInput struct {
+	sku        string
+	attributes map[string]string
+	priceCents *int64
+}
+
+func variantCreateInput(body *CreateProductVariantJSONRequestBody) (variantInput, error) {
+	if body == nil {
+		return variantInput{}, domain.ValidationError("invalid request body")
+	}
+	in := variantInput{sku: body.Sku, priceCents: body.PriceCents}
+	if body.Attributes != nil {
+		in.attributes = *body.Attributes
+	}
+	return in, nil
+}
+
+func variantUpdateInput(body *UpdateProductVariantJSONRequestBody) (variantInput, error) {
+	if body == nil {
+		return variantInput{}, domain.ValidationError("invalid request body")
+	}
+	// create and replace share the same payload schema
+	create := CreateProductVariantJSONRequestBody(*body)
+	return variantCreateInput(&create)
+}
+
+func stockAdjustmentInput(body *AdjustProductStockJSONRequestBody) (*int64, int64, error) {
+	if body == nil {
+		return nil, 0, domain.ValidationError("invalid request body")
+	}
+	return body.VariantId, body.Delta, nil
+}
+
+func reservationCreateInput(body *CreateReservationJSONRequestBody) (int64, *int64, int64, error) {
+	if body == nil {
+		return 0, nil, 0, domain.ValidationError("invalid request body")
+	}
+	return body.ProductId, body.VariantId, body.Quantity, nil
+}
+
+// imageUpload carries the parts of an image upload.
+type imageUpload struct {
+	contentType string
+	altText     string
+	data        []byte
+}
+
+// imageUploadInput reads the file and altText parts of an upload. The file is read up to one
+// byte past domain.MaxImageBytes so the domain can still reject oversized content.
+func imageUploadInput(body *multipart.Reader) (imageUpload, error) {
+	var in imageUpload
+	if body == nil {
+		return in, domain.ValidationError("invalid request body")
+	}
+	found := false
+	for {
+		part, err := body.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return in, domain.ValidationError("invalid multipart body")
+		}
+		switch part.FormName() {
+		case "file":
+			in.contentType = part.Header.Get("Content-Type")
+			in.data, err = io.ReadAll(io.LimitReader(part, domain.MaxImageBytes+1))
+			found = true
+		case "altText":
+			var raw []byte
+			raw, err = io.ReadAll(io.LimitReader(part, 4*domain.MaxImageAltTextLength+1))
+			in.altText = string(raw)
+		}
+		_ = part.Close()
+		if err != nil {
+			return in, domain.ValidationError("invalid multipart body")
+		}
+	}
+	if !found {
+		return in, domain.ValidationError("file required")
+	}
+	return in, nil
+}
+
+func imageUpdateInput(body *UpdateProductImageJSONRequestBody) (string, *int, error) {
+	if body == nil {
+		return "", nil, domain.ValidationError("invalid request body")
+	}
+	var altText string
+	if body.AltText != nil {
+		altText = *body.AltText
+	}
+	return altText, body.Position, nil
+}
+
 func commentCreateInput(body *CreateProductCommentJSONRequestBody) (int64, string, error) {
 	if body == nil {
 		return 0, "", domain.ValidationError("invalid request body")
@@ -64,3 +490,14 @@ func commentUpdateInput(body *UpdateProductCommentJSONRequestBody) (int64, strin
 	}
 	return body.UserId, body.Content, nil
 }
+
+func translationInput(body *PutProductTranslationJSONRequestBody) (string, string, error) {
+	if body == nil {
+		return "", "", domain.ValidationError("invalid request body")
+	}
+	var description string
+	if body.Description != nil {
+		description = *body.Description
+	}
+	return body.Name, description, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
@@ -36,6 +37,10 @@ func classifyDomainError(err error) (int, string) {
 		return http.StatusNotFound, "NOT_FOUND"
 	case errors.Is(err, domain.ErrForbidden):
 		return http.StatusForbidden, "FORBIDDEN"
+	case errors.Is(err, domain.ErrConflict):
+		return http.StatusConflict, "CONFLICT"
+	case errors.Is(err, domain.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed, "PRECONDITION_FAILED"
 	default:
 		return http.StatusInternalServerError, "INTERNAL"
 	}
@@ -45,7 +50,7 @@ func domainErrorMessage(status int, err error) string {
 	if status == http.StatusInternalServerError {
 		return http.StatusText(status)
 	}
-	if errors.Is(err, domain.ErrValidation) {
+	if errors.Is(err, domain.ErrValidation) || errors.Is(err, domain.ErrConflict) || errors.Is(err, domain.ErrPreconditionFailed) {
 		if split := strings.SplitN(err.Error(), "\n", 2); len(split) == 2 {
 			return split[1]
 		}
@@ -72,6 +77,34 @@ func createProductError(err error) (CreateProductResponseObject, bool) {
 	}
 }
 
+func exportProductsError(err error) (ExportProductsResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return ExportProducts400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func importProductsError(err error) (ImportProductsResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return ImportProducts400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
 func updateProductError(err error) (UpdateProductResponseObject, bool) {
 	status, payload := errorPayloadFromDomain(err)
 	switch status {
@@ -87,6 +120,50 @@ func updateProductError(err error) (UpdateProductResponseObject, bool) {
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
+	case http.StatusConflict:
+		return UpdateProduct409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return UpdateProduct412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func patchProductError(err error) (PatchProductResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return PatchProduct400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return PatchProduct404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return PatchProduct409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return PatchProduct412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
 	default:
 		return nil, false
 	}
@@ -107,22 +184,40 @@ func deleteProductError(err error) (DeleteProductByIDResponseObject, bool) {
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
+	case http.StatusPreconditionFailed:
+		return DeleteProductByID412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
 	default:
 		return nil, false
 	}
 }
 
-func getProductError(err error) (GetProductByIDResponseObject, bool) {
+func addProductTagError(err error) (AddProductTagResponseObject, bool) {
 	status, payload := errorPayloadFromDomain(err)
 	switch status {
 	case http.StatusBadRequest:
-		return GetProductByID400JSONResponse{
+		return AddProductTag400JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
 	case http.StatusNotFound:
-		return GetProductByID404JSONResponse{
+		return AddProductTag404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return AddProductTag409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return AddProductTag412JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
@@ -132,29 +227,49 @@ func getProductError(err error) (GetProductByIDResponseObject, bool) {
 	}
 }
 
-func searchProductsError(err error) (SearchProductsResponseObject, bool) {
+func removeProductTagError(err error) (RemoveProductTagResponseObject, bool) {
 	status, payload := errorPayloadFromDomain(err)
-	if status == http.StatusBadRequest {
-		return SearchProducts400JSONResponse{
+	switch status {
+	case http.StatusBadRequest:
+		return RemoveProductTag400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return RemoveProductTag404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return RemoveProductTag409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return RemoveProductTag412JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
+	default:
+		return nil, false
 	}
-	return nil, false
 }
 
-func getUserError(err error) (GetUserByIDResponseObject, bool) {
+func getProductError(err error) (GetProductByIDResponseObject, bool) {
 	status, payload := errorPayloadFromDomain(err)
 	switch status {
 	case http.StatusBadRequest:
-		return GetUserByID400JSONResponse{
+		return GetProductByID400JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
 	case http.StatusNotFound:
-		return GetUserByID404JSONResponse{
+		return GetProductByID404JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
@@ -164,46 +279,93 @@ func getUserError(err error) (GetUserByIDResponseObject, bool) {
 	}
 }
 
-func okCreateProduct(product *domain.Product) CreateProductResponseObject {
-	return CreateProduct201JSONResponse(presentProduct(product))
-}
-
-func okUpdateProduct(product *domain.Product) UpdateProductResponseObject {
-	return UpdateProduct200JSONResponse(presentProduct(product))
+func getProductBySlugError(err error) (GetProductBySlugResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return GetProductBySlug400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return GetProductBySlug404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
 }
 
-func okDeleteProduct() DeleteProductByIDResponseObject {
-	return DeleteProductByID204Response{}
+func searchProductsError(err error) (SearchProductsResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	if status == http.StatusBadRequest {
+		return SearchProducts400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	}
+	return nil, false
 }
 
-func okGetProduct(product *domain.Product) GetProductByIDResponseObject {
-	return GetProductByID200JSONResponse(presentProduct(product))
+func listTrashedProductsError(err error) (ListTrashedProductsResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	if status == http.StatusBadRequest {
+		return ListTrashedProducts400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	}
+	return nil, false
 }
 
-func okSearchProducts(items []domain.Product, page, pageSize, total int) SearchProductsResponseObject {
-	return SearchProducts200JSONResponse(ProductList{
-		Items:    presentProducts(items),
-		Page:     page,
-		PageSize: pageSize,
-		Total:    total,
-	})
+func suggestProductsError(err error) (SuggestProductsResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	if status == http.StatusBadRequest {
+		return SuggestProducts400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	}
+	return nil, false
 }
 
-func okGetUser(user *domain.User) GetUserByIDResponseObject {
-	return GetUserByID200JSONResponse(presentUser(user))
+func listPriceHistoryError(err error) (ListProductPriceHistoryResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return ListProductPriceHistory400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return ListProductPriceHistory404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
 }
 
-func listCommentsError(err error) (ListProductCommentsResponseObject, bool) {
+func schedulePriceError(err error) (ScheduleProductPriceResponseObject, bool) {
 	status, payload := errorPayloadFromDomain(err)
 	switch status {
 	case http.StatusBadRequest:
-		return ListProductComments400JSONResponse{
+		return ScheduleProductPrice400JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
 	case http.StatusNotFound:
-		return ListProductComments404JSONResponse{
+		return ScheduleProductPrice404JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
@@ -213,17 +375,29 @@ func listCommentsError(err error) (ListProductCommentsResponseObject, bool) {
 	}
 }
 
-func createCommentError(err error) (CreateProductCommentResponseObject, bool) {
+func publishProductError(err error) (PublishProductResponseObject, bool) {
 	status, payload := errorPayloadFromDomain(err)
 	switch status {
 	case http.StatusBadRequest:
-		return CreateProductComment400JSONResponse{
+		return PublishProduct400JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
 	case http.StatusNotFound:
-		return CreateProductComment404JSONResponse{
+		return PublishProduct404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return PublishProduct409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return PublishProduct412JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
@@ -233,23 +407,29 @@ func createCommentError(err error) (CreateProductCommentResponseObject, bool) {
 	}
 }
 
-func updateCommentError(err error) (UpdateProductCommentResponseObject, bool) {
+func archiveProductError(err error) (ArchiveProductResponseObject, bool) {
 	status, payload := errorPayloadFromDomain(err)
 	switch status {
 	case http.StatusBadRequest:
-		return UpdateProductComment400JSONResponse{
+		return ArchiveProduct400JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
-	case http.StatusForbidden:
-		return UpdateProductComment403JSONResponse{
+	case http.StatusNotFound:
+		return ArchiveProduct404JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
-	case http.StatusNotFound:
-		return UpdateProductComment404JSONResponse{
+	case http.StatusConflict:
+		return ArchiveProduct409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return ArchiveProduct412JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
@@ -259,23 +439,23 @@ func updateCommentError(err error) (UpdateProductCommentResponseObject, bool) {
 	}
 }
 
-func deleteCommentError(err error) (DeleteProductCommentResponseObject, bool) {
+func restoreProductError(err error) (RestoreProductResponseObject, bool) {
 	status, payload := errorPayloadFromDomain(err)
 	switch status {
 	case http.StatusBadRequest:
-		return DeleteProductComment400JSONResponse{
+		return RestoreProduct400JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
-	case http.StatusForbidden:
-		return DeleteProductComment403JSONResponse{
+	case http.StatusNotFound:
+		return RestoreProduct404JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
 		}, true
-	case http.StatusNotFound:
-		return DeleteProductComment404JSONResponse{
+	case http.StatusConflict:
+		return RestoreProduct409JSONResponse{
 			Code:    payload.Code,
 			Message: payload.Message,
 			Details: payload.Details,
@@ -285,18 +465,1092 @@ func deleteCommentError(err error) (DeleteProductCommentResponseObject, bool) {
 	}
 }
 
-func okListComments(items []domain.Comment) ListProductCommentsResponseObject {
-	return ListProductComments200JSONResponse(CommentList{Items: presentComments(items)})
+func unarchiveProductError(err error) (UnarchiveProductResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return UnarchiveProduct400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return UnarchiveProduct404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return UnarchiveProduct409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return UnarchiveProduct412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
 }
 
-func okCreateComment(comment *domain.Comment) CreateProductCommentResponseObject {
-	return CreateProductComment201JSONResponse(presentComment(comment))
+func getUserError(err error) (GetUserByIDResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return GetUserByID400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return GetUserByID404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
 }
 
-func okUpdateComment(comment *domain.Comment) UpdateProductCommentResponseObject {
-	return UpdateProductComment200JSONResponse(presentComment(comment))
+func listUsersError(err error) (ListUsersResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	if status == http.StatusBadRequest {
+		return ListUsers400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	}
+	return nil, false
 }
 
-func okDeleteComment() DeleteProductCommentResponseObject {
-	return DeleteProductComment204Response{}
+func createUserError(err error) (CreateUserResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return CreateUser400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return CreateUser409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func updateUserError(err error) (UpdateUserResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return UpdateUser400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return UpdateUser404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return UpdateUser409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func deleteUserError(err error) (DeleteUserResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return DeleteUser400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return DeleteUser404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return DeleteUser409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func okCreateProduct(product *domain.Product) CreateProductResponseObject {
+	return CreateProduct201JSONResponse{
+		Body:    presentProduct(product),
+		Headers: CreateProduct201ResponseHeaders{ETag: formatETag(product.Version)},
+	}
+}
+
+func okImportProducts(result *domain.ImportResult) ImportProductsResponseObject {
+	return ImportProducts200JSONResponse(presentImportSummary(result))
+}
+
+func okUpdateProduct(product *domain.Product) UpdateProductResponseObject {
+	return UpdateProduct200JSONResponse{
+		Body:    presentProduct(product),
+		Headers: UpdateProduct200ResponseHeaders{ETag: formatETag(product.Version)},
+	}
+}
+
+func okPatchProduct(product *domain.Product) PatchProductResponseObject {
+	return PatchProduct200JSONResponse{
+		Body:    presentProduct(product),
+		Headers: PatchProduct200ResponseHeaders{ETag: formatETag(product.Version)},
+	}
+}
+
+func okDeleteProduct() DeleteProductByIDResponseObject {
+	return DeleteProductByID204Response{}
+}
+
+func okAddProductTag(product *domain.Product) AddProductTagResponseObject {
+	return AddProductTag200JSONResponse(presentProduct(product))
+}
+
+func okRemoveProductTag(product *domain.Product) RemoveProductTagResponseObject {
+	return RemoveProductTag200JSONResponse(presentProduct(product))
+}
+
+func okGetProduct(body Product, headers GetProductByID200ResponseHeaders) GetProductByIDResponseObject {
+	return GetProductByID200JSONResponse{Body: body, Headers: headers}
+}
+
+func notModifiedGetProduct(headers GetProductByID200ResponseHeaders) GetProductByIDResponseObject {
+	return GetProductByID304Response{Headers: GetProductByID304ResponseHeaders(headers)}
+}
+
+func okGetProductBySlug(body Product, headers GetProductByID200ResponseHeaders) GetProductBySlugResponseObject {
+	return GetProductBySlug200JSONResponse{Body: body, Headers: GetProductBySlug200ResponseHeaders(headers)}
+}
+
+func notModifiedGetProductBySlug(headers GetProductByID200ResponseHeaders) GetProductBySlugResponseObject {
+	return GetProductBySlug304Response{Headers: GetProductBySlug304ResponseHeaders(headers)}
+}
+
+func redirectProductSlug(product *domain.Product, location string) GetProductBySlugResponseObject {
+	return GetProductBySlug301JSONResponse{
+		Body:    SlugRedirect{Id: product.ID, Slug: product.Slug, Location: location},
+		Headers: GetProductBySlug301ResponseHeaders{Location: location},
+	}
+}
+
+// productSlugLocation is the canonical path of a product slug, keeping the requested currency.
+func productSlugLocation(slug, currency string) string {
+	location := "/products/by-slug/" + slug
+	if currency != "" {
+		location += "?" + url.Values{"currency": {currency}}.Encode()
+	}
+	return location
+}
+
+func okSearchProducts(body ProductList, headers SearchProducts200ResponseHeaders) SearchProductsResponseObject {
+	return SearchProducts200JSONResponse{Body: body, Headers: headers}
+}
+
+func notModifiedSearchProducts(headers SearchProducts200ResponseHeaders) SearchProductsResponseObject {
+	return SearchProducts304Response{Headers: SearchProducts304ResponseHeaders(headers)}
+}
+
+func okSuggestProducts(items []domain.Suggestion) SuggestProductsResponseObject {
+	return SuggestProducts200JSONResponse(presentSuggestionList(items))
+}
+
+func okListPriceHistory(items []domain.PriceChange) ListProductPriceHistoryResponseObject {
+	return ListProductPriceHistory200JSONResponse(presentPriceHistory(items))
+}
+
+func okSchedulePrice(change *domain.PriceChange) ScheduleProductPriceResponseObject {
+	return ScheduleProductPrice201JSONResponse(presentPriceChange(change))
+}
+
+func okPublishProduct(product *domain.Product) PublishProductResponseObject {
+	return PublishProduct200JSONResponse(presentProduct(product))
+}
+
+func okArchiveProduct(product *domain.Product) ArchiveProductResponseObject {
+	return ArchiveProduct200JSONResponse(presentProduct(product))
+}
+
+func okRestoreProduct(product *domain.Product) RestoreProductResponseObject {
+	return RestoreProduct200JSONResponse(presentProduct(product))
+}
+
+func okUnarchiveProduct(product *domain.Product) UnarchiveProductResponseObject {
+	return UnarchiveProduct200JSONResponse(presentProduct(product))
+}
+
+func okListTrashedProducts(page domain.TrashPage, list *domain.TrashList) ListTrashedProductsResponseObject {
+	return ListTrashedProducts200JSONResponse(presentTrashList(page, list))
+}
+
+func okGetUser(user *domain.User) GetUserByIDResponseObject {
+	return GetUserByID200JSONResponse(presentUser(user))
+}
+
+func okListUsers(criteria domain.UserSearch, result *domain.UserSearchResult) ListUsersResponseObject {
+	return ListUsers200JSONResponse(presentUserList(criteria, result))
+}
+
+func okCreateUser(user *domain.User) CreateUserResponseObject {
+	return CreateUser201JSONResponse(presentUser(user))
+}
+
+func okUpdateUser(user *domain.User) UpdateUserResponseObject {
+	return UpdateUser200JSONResponse(presentUser(user))
+}
+
+func okDeleteUser() DeleteUserResponseObject {
+	return DeleteUser204Response{}
+}
+
+func listCommentsError(err error) (ListProductCommentsResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return ListProductComments400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return ListProductComments404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func createCommentError(err error) (CreateProductCommentResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return CreateProductComment400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return CreateProductComment404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func updateCommentError(err error) (UpdateProductCommentResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return UpdateProductComment400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusForbidden:
+		return UpdateProductComment403JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return UpdateProductComment404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return UpdateProductComment412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func deleteCommentError(err error) (DeleteProductCommentResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return DeleteProductComment400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusForbidden:
+		return DeleteProductComment403JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return DeleteProductComment404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return DeleteProductComment412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func okListComments(list *domain.CommentList) ListProductCommentsResponseObject {
+	return ListProductComments200JSONResponse(presentCommentList(list))
+}
+
+func okCreateComment(comment *domain.Comment) CreateProductCommentResponseObject {
+	return CreateProductComment201JSONResponse{
+		Body:    presentComment(comment),
+		Headers: CreateProductComment201ResponseHeaders{ETag: formatETag(comment.Version)},
+	}
+}
+
+func okUpdateComment(comment *domain.Comment) UpdateProductCommentResponseObject {
+	return UpdateProductComment200JSONResponse{
+		Body:    presentComment(comment),
+		Headers: UpdateProductComment200ResponseHeaders{ETag: formatETag(comment.Version)},
+	}
+}
+
+func okDeleteComment() DeleteProductCommentResponseObject {
+	return DeleteProductComment204Response{}
+}
+
+func createCategoryError(err error) (CreateCategoryResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return CreateCategory400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return CreateCategory409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func getCategoryError(err error) (GetCategoryResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return GetCategory400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return GetCategory404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func updateCategoryError(err error) (UpdateCategoryResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return UpdateCategory400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return UpdateCategory404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return UpdateCategory409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return UpdateCategory412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func deleteCategoryError(err error) (DeleteCategoryResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return DeleteCategory400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return DeleteCategory404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return DeleteCategory409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return DeleteCategory412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func okListCategories(items []domain.Category) ListCategoriesResponseObject {
+	return ListCategories200JSONResponse(presentCategoryList(items))
+}
+
+func okCreateCategory(category *domain.Category) CreateCategoryResponseObject {
+	return CreateCategory201JSONResponse{
+		Body:    presentCategory(category),
+		Headers: CreateCategory201ResponseHeaders{ETag: formatETag(category.Version)},
+	}
+}
+
+func okGetCategory(category *domain.Category) GetCategoryResponseObject {
+	return GetCategory200JSONResponse{
+		Body:    presentCategory(category),
+		Headers: GetCategory200ResponseHeaders{ETag: formatETag(category.Version)},
+	}
+}
+
+func okUpdateCategory(category *domain.Category) UpdateCategoryResponseObject {
+	return UpdateCategory200JSONResponse{
+		Body:    presentCategory(category),
+		Headers: UpdateCategory200ResponseHeaders{ETag: formatETag(category.Version)},
+	}
+}
+
+func okDeleteCategory() DeleteCategoryResponseObject {
+	return DeleteCategory204Response{}
+}
+
+func listVariantsError(err error) (ListProductVariantsResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return ListProductVariants400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return ListProductVariants404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func createVariantError(err error) (CreateProductVariantResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return CreateProductVariant400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return CreateProductVariant404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return CreateProductVariant409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func getVariantError(err error) (GetProductVariantResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return GetProductVariant400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return GetProductVariant404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func updateVariantError(err error) (UpdateProductVariantResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return UpdateProductVariant400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return UpdateProductVariant404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return UpdateProductVariant409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return UpdateProductVariant412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func deleteVariantError(err error) (DeleteProductVariantResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return DeleteProductVariant400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return DeleteProductVariant404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusPreconditionFailed:
+		return DeleteProductVariant412JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func okListVariants(items []domain.Variant) ListProductVariantsResponseObject {
+	return ListProductVariants200JSONResponse(presentVariantList(items))
+}
+
+func okCreateVariant(variant *domain.Variant) CreateProductVariantResponseObject {
+	return CreateProductVariant201JSONResponse{
+		Body:    presentVariant(variant),
+		Headers: CreateProductVariant201ResponseHeaders{ETag: formatETag(variant.Version)},
+	}
+}
+
+func okGetVariant(variant *domain.Variant) GetProductVariantResponseObject {
+	return GetProductVariant200JSONResponse{
+		Body:    presentVariant(variant),
+		Headers: GetProductVariant200ResponseHeaders{ETag: formatETag(variant.Version)},
+	}
+}
+
+func okUpdateVariant(variant *domain.Variant) UpdateProductVariantResponseObject {
+	return UpdateProductVariant200JSONResponse{
+		Body:    presentVariant(variant),
+		Headers: UpdateProductVariant200ResponseHeaders{ETag: formatETag(variant.Version)},
+	}
+}
+
+func okDeleteVariant() DeleteProductVariantResponseObject {
+	return DeleteProductVariant204Response{}
+}
+
+func getStockError(err error) (GetProductStockResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return GetProductStock400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return GetProductStock404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func adjustStockError(err error) (AdjustProductStockResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return AdjustProductStock400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return AdjustProductStock404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return AdjustProductStock409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func createReservationError(err error) (CreateReservationResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return CreateReservation400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return CreateReservation404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return CreateReservation409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func getReservationError(err error) (GetReservationResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return GetReservation400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return GetReservation404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func commitReservationError(err error) (CommitReservationResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return CommitReservation400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return CommitReservation404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return CommitReservation409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func releaseReservationError(err error) (ReleaseReservationResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return ReleaseReservation400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return ReleaseReservation404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return ReleaseReservation409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func okGetStock(levels []domain.StockLevel) GetProductStockResponseObject {
+	return GetProductStock200JSONResponse(presentStockList(levels))
+}
+
+func okAdjustStock(level *domain.StockLevel) AdjustProductStockResponseObject {
+	return AdjustProductStock200JSONResponse(presentStockLevel(level))
+}
+
+func okCreateReservation(reservation *domain.Reservation) CreateReservationResponseObject {
+	return CreateReservation201JSONResponse(presentReservation(reservation))
+}
+
+func okGetReservation(reservation *domain.Reservation) GetReservationResponseObject {
+	return GetReservation200JSONResponse(presentReservation(reservation))
+}
+
+func okCommitReservation(reservation *domain.Reservation) CommitReservationResponseObject {
+	return CommitReservation200JSONResponse(presentReservation(reservation))
+}
+
+func okReleaseReservation(reservation *domain.Reservation) ReleaseReservationResponseObject {
+	return ReleaseReservation200JSONResponse(presentReservation(reservation))
+}
+
+func listImagesError(err error) (ListProductImagesResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return ListProductImages400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return ListProductImages404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func uploadImageError(err error) (UploadProductImageResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return UploadProductImage400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return UploadProductImage404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusConflict:
+		return UploadProductImage409JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func getImageError(err error) (GetProductImageResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return GetProductImage400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return GetProductImage404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func updateImageError(err error) (UpdateProductImageResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return UpdateProductImage400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return UpdateProductImage404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func deleteImageError(err error) (DeleteProductImageResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return DeleteProductImage400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return DeleteProductImage404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func getImageContentError(err error) (GetProductImageContentResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return GetProductImageContent400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return GetProductImageContent404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func okListImages(images []domain.ProductImage) ListProductImagesResponseObject {
+	return ListProductImages200JSONResponse(presentImageList(images))
+}
+
+func okUploadImage(image *domain.ProductImage) UploadProductImageResponseObject {
+	return UploadProductImage201JSONResponse(presentImage(image))
+}
+
+func okGetImage(image *domain.ProductImage) GetProductImageResponseObject {
+	return GetProductImage200JSONResponse(presentImage(image))
+}
+
+func okUpdateImage(image *domain.ProductImage) UpdateProductImageResponseObject {
+	return UpdateProductImage200JSONResponse(presentImage(image))
+}
+
+func okDeleteImage() DeleteProductImageResponseObject {
+	return DeleteProductImage204Response{}
+}
+
+func listRelatedError(err error) (ListRelatedProductsResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return ListRelatedProducts400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return ListRelatedProducts404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func okListRelated(items []domain.RelatedProduct) ListRelatedProductsResponseObject {
+	return ListRelatedProducts200JSONResponse(presentRelatedProductList(items))
+}
+
+func listTranslationsError(err error) (ListProductTranslationsResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return ListProductTranslations400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return ListProductTranslations404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func putTranslationError(err error) (PutProductTranslationResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return PutProductTranslation400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return PutProductTranslation404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func deleteTranslationError(err error) (DeleteProductTranslationResponseObject, bool) {
+	status, payload := errorPayloadFromDomain(err)
+	switch status {
+	case http.StatusBadRequest:
+		return DeleteProductTranslation400JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	case http.StatusNotFound:
+		return DeleteProductTranslation404JSONResponse{
+			Code:    payload.Code,
+			Message: payload.Message,
+			Details: payload.Details,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func okListTranslations(translations []domain.ProductTranslation) ListProductTranslationsResponseObject {
+	return ListProductTranslations200JSONResponse(presentTranslationList(translations))
+}
+
+func okPutTranslation(translation *domain.ProductTranslation, created bool) PutProductTranslationResponseObject {
+	if created {
+		return PutProductTranslation201JSONResponse(presentTranslation(translation))
+	}
+	return PutProductTranslation200JSONResponse(presentTranslation(translation))
+}
+
+func okDeleteTranslation() DeleteProductTranslationResponseObject {
+	return DeleteProductTranslation204Response{}
 }
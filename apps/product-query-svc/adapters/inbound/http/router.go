@@ -1,13 +1,26 @@
 package httpadapter
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/go-chi/chi/v5"
 	nethttpmiddleware "github.com/oapi-codegen/nethttp-middleware"
 )
 
+func init() {
+	// Product imports are checked row by row by the product service, which reports bad rows
+	// instead of rejecting the file, so the request validator only reads them as text.
+	openapi3filter.RegisterBodyDecoder("text/csv", openapi3filter.PlainBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("application/x-ndjson", openapi3filter.PlainBodyDecoder)
+}
+
 // NewAPIHandler returns a chi-backed handler wired with the strict server and
 // OpenAPI request validator.
 func NewAPIHandler(server *Server, strictMiddlewares []StrictMiddlewareFunc, middlewares ...func(http.Handler) http.Handler) (http.Handler, error) {
@@ -20,8 +33,58 @@ func NewAPIHandler(server *Server, strictMiddlewares []StrictMiddlewareFunc, mid
 	for _, mw := range middlewares {
 		r.Use(mw)
 	}
+	r.Use(limitRequestBodies)
 	r.Use(nethttpmiddleware.OapiRequestValidator(swagger))
 
 	strict := NewStrictHTTPHandler(server, strictMiddlewares)
 	return HandlerFromMux(strict, r), nil
 }
+
+const (
+	// maxRequestBytes bounds the body of any request without a larger limit of its own.
+	maxRequestBytes = 1 << 20
+	// multipartOverheadBytes leaves room for the boundaries, part headers and altText of an image upload.
+	multipartOverheadBytes = 64 << 10
+	// maxImportBytes bounds a product import file, which the request validator reads whole.
+	maxImportBytes = 32 << 20
+)
+
+// requestBodyLimit returns the largest body accepted for the request.
+func requestBodyLimit(r *http.Request) int64 {
+	if r.Method == http.MethodPost && r.URL.Path == "/products:import" {
+		return maxImportBytes
+	}
+	if r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/products/") && strings.HasSuffix(r.URL.Path, "/images") {
+		return domain.MaxImageBytes + multipartOverheadBytes
+	}
+	return maxRequestBytes
+}
+
+// limitRequestBodies caps request bodies before the request validator buffers them, answering
+// 413 instead of reading an oversized body. A body of unknown length is read here, within the
+// limit, so that an overflow is still told apart from a malformed request.
+func limitRequestBodies(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit := requestBodyLimit(r)
+		if r.ContentLength > limit {
+			writeError(w, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", fmt.Sprintf("request body exceeds %d bytes", limit))
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		if r.ContentLength < 0 {
+			data, err := io.ReadAll(r.Body)
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				writeError(w, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", fmt.Sprintf("request body exceeds %d bytes", limit))
+				return
+			}
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "INVALID_REQUEST", "unreadable request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(data))
+			r.ContentLength = int64(len(data))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
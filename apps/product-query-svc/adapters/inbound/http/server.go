@@ -6,15 +6,44 @@ import (
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
 )
 
-// Server wires product and user services to HTTP handlers generated from OpenAPI.
+// Server wires product, translation, variant, inventory, image, recommendation, category and user services to HTTP handlers generated from OpenAPI.
 type Server struct {
-	products inbound.ProductUseCases
-	users    inbound.UserQueries
-	comments inbound.CommentUseCases
+	products        inbound.ProductUseCases
+	users           inbound.UserUseCases
+	comments        inbound.CommentUseCases
+	categories      inbound.CategoryUseCases
+	variants        inbound.VariantUseCases
+	inventory       inbound.InventoryUseCases
+	images          inbound.ImageUseCases
+	recommendations inbound.RecommendationQueries
+	translations    inbound.TranslationUseCases
+	cache           CachePolicy
 }
 
-func NewServer(products inbound.ProductUseCases, users inbound.UserQueries, comments inbound.CommentUseCases) *Server {
-	return &Server{products: products, users: users, comments: comments}
+// CachePolicy holds the Cache-Control values sent with cacheable product reads and their 304s.
+type CachePolicy struct {
+	Product string // GET /products/{id}
+	Search  string // GET /products/search
+}
+
+// DefaultCachePolicy lets clients and CDNs store product reads but revalidate them with the
+// ETag before every reuse, so a write is never served stale.
+var DefaultCachePolicy = CachePolicy{Product: "no-cache", Search: "no-cache"}
+
+// ServerOption customises a Server built by NewServer.
+type ServerOption func(*Server)
+
+// WithCachePolicy replaces DefaultCachePolicy.
+func WithCachePolicy(policy CachePolicy) ServerOption {
+	return func(s *Server) { s.cache = policy }
+}
+
+func NewServer(products inbound.ProductUseCases, users inbound.UserUseCases, comments inbound.CommentUseCases, categories inbound.CategoryUseCases, variants inbound.VariantUseCases, inventory inbound.InventoryUseCases, images inbound.ImageUseCases, recommendations inbound.RecommendationQueries, translations inbound.TranslationUseCases, opts ...ServerOption) *Server {
+	s := &Server{products: products, users: users, comments: comments, categories: categories, variants: variants, inventory: inventory, images: images, recommendations: recommendations, translations: translations, cache: DefaultCachePolicy}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 var _ StrictServerInterface = (*Server)(nil)
@@ -0,0 +1,63 @@
+package inmem
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+)
+
+var _ outbound.BlobStore = (*BlobStore)(nil)
+
+// BlobStore 是 blob 存储的内存实现，内容随进程退出而丢失，用于本地开发/测试。
+type BlobStore struct {
+	mu    sync.RWMutex
+	blobs map[string][]byte
+}
+
+func NewBlobStore() *BlobStore {
+	return &BlobStore{blobs: make(map[string][]byte)}
+}
+
+func (s *BlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blobs[key] = data
+	return nil
+}
+
+// Open 返回内容的只读视图；内容写入后不会再被修改，无需拷贝。
+func (s *BlobStore) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.blobs[key]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return nopCloser{bytes.NewReader(data)}, nil
+}
+
+func (s *BlobStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.blobs, key)
+	return nil
+}
+
+// Len 返回当前保存的内容数量，供测试检查是否有遗留。
+func (s *BlobStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.blobs)
+}
+
+type nopCloser struct{ io.ReadSeeker }
+
+func (nopCloser) Close() error { return nil }
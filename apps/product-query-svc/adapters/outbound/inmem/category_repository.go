@@ -0,0 +1,184 @@
+package inmem
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+func (r *InMemRepo) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]domain.Category, 0, len(r.categories))
+	for _, c := range r.categories {
+		out = append(out, cloneCategory(c))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	domain.BuildCategoryPaths(out)
+	return out, nil
+}
+
+func (r *InMemRepo) GetCategory(ctx context.Context, id int64) (*domain.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.categories[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	cc := cloneCategory(c)
+	cc.Path = r.categoryPathLocked(id)
+	return &cc, nil
+}
+
+func (r *InMemRepo) CreateCategory(ctx context.Context, category *domain.Category) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkCategoryLocked(category); err != nil {
+		return 0, err
+	}
+	category.ID = r.nextCategory
+	category.Version = 1
+	category.UpdatedAt = time.Now().UTC()
+	r.categories[category.ID] = cloneCategory(*category)
+	r.nextCategory++
+	category.Path = r.categoryPathLocked(category.ID)
+	return category.ID, nil
+}
+
+func (r *InMemRepo) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old, ok := r.categories[category.ID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if err := domain.CheckVersion(category.Version, old.Version); err != nil {
+		return err
+	}
+	if err := r.checkCategoryLocked(category); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	category.Version = old.Version + 1
+	category.UpdatedAt = now
+	r.categories[category.ID] = cloneCategory(*category)
+	category.Path = r.categoryPathLocked(category.ID)
+	if old.Name != category.Name || !sameParent(old.ParentID, category.ParentID) {
+		r.touchCategoryProductsLocked(category.ID, now)
+	}
+	return nil
+}
+
+// touchCategoryProductsLocked 推进子树内所有商品的版本与修改时间：面包屑随分类改名或移动而变化，
+// 旧的 ETag 与 Last-Modified 不能再命中缓存。调用方需持有写锁。
+func (r *InMemRepo) touchCategoryProductsLocked(id int64, now time.Time) {
+	subtree := r.categorySubtreeLocked(&id)
+	for productID, p := range r.products {
+		if p.CategoryID != nil && subtree[*p.CategoryID] {
+			p.Version++
+			p.UpdatedAt = now
+			r.products[productID] = p
+		}
+	}
+}
+
+func (r *InMemRepo) DeleteCategory(ctx context.Context, id int64, version int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.categories[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if err := domain.CheckVersion(version, c.Version); err != nil {
+		return err
+	}
+	for _, child := range r.categories {
+		if child.ParentID != nil && *child.ParentID == id {
+			return domain.CategoryInUseError()
+		}
+	}
+	// 回收站中的商品恢复后仍指向该分类，同样阻止删除
+	for _, p := range r.products {
+		if p.CategoryID != nil && *p.CategoryID == id {
+			return domain.CategoryInUseError()
+		}
+	}
+	delete(r.categories, id)
+	return nil
+}
+
+// checkCategoryLocked 检查父分类存在、不会成环，且同一父分类下没有同名分类；调用方需持有写锁。
+func (r *InMemRepo) checkCategoryLocked(category *domain.Category) error {
+	if category.ParentID != nil {
+		if _, ok := r.categories[*category.ParentID]; !ok {
+			return domain.ValidationError(fmt.Sprintf("parent category %d not found", *category.ParentID))
+		}
+		if category.ID != 0 && r.categorySubtreeLocked(&category.ID)[*category.ParentID] {
+			return domain.CategoryCycleError()
+		}
+	}
+	for _, other := range r.categories {
+		if other.ID != category.ID && sameParent(other.ParentID, category.ParentID) && strings.EqualFold(other.Name, category.Name) {
+			return domain.DuplicateCategoryError()
+		}
+	}
+	return nil
+}
+
+// categoryPathLocked 从 id 沿父分类向上拼出面包屑；调用方需持有读锁。
+func (r *InMemRepo) categoryPathLocked(id int64) domain.CategoryPath {
+	var path domain.CategoryPath
+	for c, ok := r.categories[id]; ok; {
+		path = append(domain.CategoryPath{{ID: c.ID, Name: c.Name}}, path...)
+		if c.ParentID == nil {
+			break
+		}
+		c, ok = r.categories[*c.ParentID]
+	}
+	return path
+}
+
+// categorySubtreeLocked 返回 id 及其所有子孙分类的集合；id 为 nil 时返回 nil，表示不按分类过滤。
+// 调用方需持有读锁。
+func (r *InMemRepo) categorySubtreeLocked(id *int64) map[int64]bool {
+	if id == nil {
+		return nil
+	}
+	children := make(map[int64][]int64, len(r.categories))
+	for _, c := range r.categories {
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c.ID)
+		}
+	}
+	subtree := map[int64]bool{*id: true}
+	for queue := []int64{*id}; len(queue) > 0; queue = queue[1:] {
+		for _, child := range children[queue[0]] {
+			if !subtree[child] {
+				subtree[child] = true
+				queue = append(queue, child)
+			}
+		}
+	}
+	return subtree
+}
+
+func sameParent(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// cloneCategory 拷贝父分类指针，面包屑不随分类保存。
+func cloneCategory(c domain.Category) domain.Category {
+	if c.ParentID != nil {
+		id := *c.ParentID
+		c.ParentID = &id
+	}
+	c.Path = nil
+	return c
+}
@@ -0,0 +1,93 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+func (r *InMemRepo) ListImages(ctx context.Context, productID int64) ([]domain.ProductImage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.productImagesLocked(productID), nil
+}
+
+func (r *InMemRepo) GetImage(ctx context.Context, productID, imageID int64) (*domain.ProductImage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	img, ok := r.images[imageID]
+	if !ok || img.ProductID != productID {
+		return nil, domain.ErrNotFound
+	}
+	return &img, nil
+}
+
+func (r *InMemRepo) CreateImage(ctx context.Context, image *domain.ProductImage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	siblings := r.productImagesLocked(image.ProductID)
+	if len(siblings) >= domain.MaxProductImages {
+		return domain.TooManyImagesError()
+	}
+	now := time.Now().UTC()
+	image.ID = r.nextImage
+	image.Position = len(siblings)
+	image.CreatedAt = now
+	image.UpdatedAt = now
+	r.images[image.ID] = *image
+	r.nextImage++
+	return nil
+}
+
+func (r *InMemRepo) UpdateImage(ctx context.Context, image *domain.ProductImage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old, ok := r.images[image.ID]
+	if !ok || old.ProductID != image.ProductID {
+		return domain.ErrNotFound
+	}
+	reordered, err := domain.ReorderImages(r.productImagesLocked(image.ProductID), image.ID, image.Position)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for _, img := range reordered {
+		if img.ID == image.ID {
+			img.AltText = image.AltText
+			img.UpdatedAt = now
+			*image = img
+		}
+		r.images[img.ID] = img
+	}
+	return nil
+}
+
+func (r *InMemRepo) DeleteImage(ctx context.Context, productID, imageID int64) (*domain.ProductImage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	img, ok := r.images[imageID]
+	if !ok || img.ProductID != productID {
+		return nil, domain.ErrNotFound
+	}
+	delete(r.images, imageID)
+	// 后面的图片依次前移，保持序号连续
+	for i, rest := range r.productImagesLocked(productID) {
+		rest.Position = i
+		r.images[rest.ID] = rest
+	}
+	return &img, nil
+}
+
+// productImagesLocked 返回商品的图片并按序号排序；调用方需持有读锁。
+func (r *InMemRepo) productImagesLocked(productID int64) []domain.ProductImage {
+	out := []domain.ProductImage{}
+	for _, img := range r.images {
+		if img.ProductID == productID {
+			out = append(out, img)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Position < out[j].Position })
+	return out
+}
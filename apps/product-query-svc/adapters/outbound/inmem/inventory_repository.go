@@ -0,0 +1,162 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// stockKey 定位一个库存单位；variantID 为 0 表示商品本身，对应 Postgres 中 COALESCE(variant_id, 0) 的唯一索引。
+type stockKey struct {
+	productID int64
+	variantID int64
+}
+
+func newStockKey(productID int64, variantID *int64) stockKey {
+	key := stockKey{productID: productID}
+	if variantID != nil {
+		key.variantID = *variantID
+	}
+	return key
+}
+
+func (r *InMemRepo) ListStock(ctx context.Context, productID int64) ([]domain.StockLevel, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := []domain.StockLevel{}
+	for key, level := range r.stock {
+		if key.productID == productID {
+			out = append(out, cloneStockLevel(level))
+		}
+	}
+	// 商品本身（variantID 为 0）排在最前，其余按规格 ID 排序
+	sort.Slice(out, func(i, j int) bool {
+		return newStockKey(productID, out[i].VariantID).variantID < newStockKey(productID, out[j].VariantID).variantID
+	})
+	return out, nil
+}
+
+func (r *InMemRepo) AdjustStock(ctx context.Context, productID int64, variantID *int64, delta int64) (*domain.StockLevel, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := newStockKey(productID, variantID)
+	level, ok := r.stock[key]
+	if !ok {
+		level = domain.StockLevel{ProductID: productID, VariantID: variantID}
+	}
+	if err := level.Adjust(delta); err != nil {
+		return nil, err
+	}
+	level.UpdatedAt = time.Now().UTC()
+	r.stock[key] = cloneStockLevel(level)
+	out := cloneStockLevel(level)
+	return &out, nil
+}
+
+// Reserve 在写锁内检查并扣减可用库存，并发预留不会超卖。
+func (r *InMemRepo) Reserve(ctx context.Context, reservation *domain.Reservation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := newStockKey(reservation.ProductID, reservation.VariantID)
+	level := r.stock[key]
+	if err := level.Reserve(reservation.Quantity); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	level.UpdatedAt = now
+	r.stock[key] = level
+	reservation.ID = r.nextReservation
+	reservation.Status = domain.ReservationPending
+	reservation.CreatedAt = now
+	reservation.UpdatedAt = now
+	r.reservations[reservation.ID] = cloneReservation(*reservation)
+	r.nextReservation++
+	return nil
+}
+
+func (r *InMemRepo) GetReservation(ctx context.Context, id int64) (*domain.Reservation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	res, ok := r.reservations[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	out := cloneReservation(res)
+	return &out, nil
+}
+
+func (r *InMemRepo) CommitReservation(ctx context.Context, id int64) (*domain.Reservation, error) {
+	return r.settleReservation(id, (*domain.Reservation).Commit, (*domain.StockLevel).Commit)
+}
+
+func (r *InMemRepo) ReleaseReservation(ctx context.Context, id int64) (*domain.Reservation, error) {
+	return r.settleReservation(id, (*domain.Reservation).Release, (*domain.StockLevel).Release)
+}
+
+// settleReservation 在写锁内完成预留的状态迁移，并把预留数量提交出库或释放回库存。
+func (r *InMemRepo) settleReservation(id int64, transition func(*domain.Reservation) error, apply func(*domain.StockLevel, int64)) (*domain.Reservation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res, ok := r.reservations[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	if err := transition(&res); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	key := newStockKey(res.ProductID, res.VariantID)
+	level := r.stock[key]
+	apply(&level, res.Quantity)
+	level.UpdatedAt = now
+	r.stock[key] = level
+	res.UpdatedAt = now
+	r.reservations[id] = res
+	out := cloneReservation(res)
+	return &out, nil
+}
+
+// inStockLocked 报告商品本身或任一规格是否还有可用库存；调用方需持有读锁。
+func (r *InMemRepo) inStockLocked(productID int64) bool {
+	for key, level := range r.stock {
+		if key.productID == productID && level.Available() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteStockLocked 删除库存单位及其预留，对应 Postgres 的级联删除；variantID 为 nil 时删除商品的全部库存。
+func (r *InMemRepo) deleteStockLocked(productID int64, variantID *int64) {
+	matches := func(p int64, v *int64) bool {
+		return p == productID && (variantID == nil || newStockKey(p, v) == newStockKey(productID, variantID))
+	}
+	for key, level := range r.stock {
+		if matches(key.productID, level.VariantID) {
+			delete(r.stock, key)
+		}
+	}
+	for id, res := range r.reservations {
+		if matches(res.ProductID, res.VariantID) {
+			delete(r.reservations, id)
+		}
+	}
+}
+
+func cloneStockLevel(s domain.StockLevel) domain.StockLevel {
+	if s.VariantID != nil {
+		id := *s.VariantID
+		s.VariantID = &id
+	}
+	return s
+}
+
+func cloneReservation(res domain.Reservation) domain.Reservation {
+	if res.VariantID != nil {
+		id := *res.VariantID
+		res.VariantID = &id
+	}
+	return res
+}
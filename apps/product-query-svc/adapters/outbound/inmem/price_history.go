@@ -0,0 +1,91 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// appendPriceChange 分配 ID 并追加价格记录，调用方需持有写锁。
+func (r *InMemRepo) appendPriceChange(c domain.PriceChange) int64 {
+	c.ID = r.nextPriceChange
+	r.nextPriceChange++
+	r.priceChanges = append(r.priceChanges, c)
+	return c.ID
+}
+
+// deletePriceChanges 随商品清理其价格历史，对应 Postgres 的级联删除。
+func (r *InMemRepo) deletePriceChanges(productID int64) {
+	out := r.priceChanges[:0]
+	for _, c := range r.priceChanges {
+		if c.ProductID != productID {
+			out = append(out, c)
+		}
+	}
+	r.priceChanges = out
+}
+
+func (r *InMemRepo) SchedulePriceChange(ctx context.Context, change *domain.PriceChange) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.liveProduct(change.ProductID); !ok {
+		return 0, domain.ErrNotFound
+	}
+	change.ID = r.appendPriceChange(*change)
+	return change.ID, nil
+}
+
+func (r *InMemRepo) ListPriceHistory(ctx context.Context, productID int64) ([]domain.PriceChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, ok := r.liveProduct(productID); !ok {
+		return nil, domain.ErrNotFound
+	}
+	out := []domain.PriceChange{}
+	for _, c := range r.priceChanges {
+		if c.ProductID == productID {
+			out = append(out, c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return priceChangeLess(out[i], out[j]) })
+	return out, nil
+}
+
+func (r *InMemRepo) ApplyDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]domain.PriceChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var due []int
+	for i := range r.priceChanges {
+		// 回收站中商品的预约保持 pending，恢复后再应用
+		if _, live := r.liveProduct(r.priceChanges[i].ProductID); live && r.priceChanges[i].Due(now) {
+			due = append(due, i)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return priceChangeLess(r.priceChanges[due[i]], r.priceChanges[due[j]]) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+	out := make([]domain.PriceChange, 0, len(due))
+	for _, i := range due {
+		c := &r.priceChanges[i]
+		p := r.products[c.ProductID]
+		c.ApplyTo(&p, now)
+		if c.Status == domain.PriceChangeApplied {
+			p.Version++
+			p.UpdatedAt = now.UTC()
+		}
+		r.products[c.ProductID] = p
+		out = append(out, *c)
+	}
+	return out, nil
+}
+
+// priceChangeLess 按生效时间升序排列，同一时间按 ID 保证稳定。
+func priceChangeLess(a, b domain.PriceChange) bool {
+	if a.EffectiveFrom.Equal(b.EffectiveFrom) {
+		return a.ID < b.ID
+	}
+	return a.EffectiveFrom.Before(b.EffectiveFrom)
+}
@@ -0,0 +1,37 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// ExportProducts 在读锁内拷贝一份匹配商品的快照，释放锁后再逐个交给 fn，导出期间的写入不会影响结果。
+func (r *InMemRepo) ExportProducts(ctx context.Context, criteria domain.ProductSearch, fn func(*domain.Product) error) error {
+	q := strings.ToLower(strings.TrimSpace(criteria.Query))
+	r.mu.RLock()
+	inCategory := r.categorySubtreeLocked(criteria.CategoryID)
+	var snapshot []domain.Product
+	for _, p := range r.products {
+		if q != "" && !strings.Contains(strings.ToLower(p.Name), q) {
+			continue
+		}
+		if matchesFilters(criteria, inCategory, &p) {
+			snapshot = append(snapshot, r.readProductLocked(p))
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(snapshot, func(i, j int) bool { return snapshot[i].ID < snapshot[j].ID })
+	for i := range snapshot {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(&snapshot[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
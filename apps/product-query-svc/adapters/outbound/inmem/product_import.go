@@ -0,0 +1,33 @@
+package inmem
+
+import (
+	"context"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// ImportProducts 在同一把写锁内写入一批导入数据；先校验全部更新，任何一条失败都不会写入整批。
+func (r *InMemRepo) ImportProducts(ctx context.Context, creates, updates []*domain.Product) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range updates {
+		old, ok := r.liveProduct(p.ID)
+		if !ok {
+			return domain.ErrNotFound
+		}
+		if err := domain.CheckVersion(p.Version, old.Version); err != nil {
+			return err
+		}
+	}
+	now := time.Now().UTC()
+	for _, p := range creates {
+		r.createLocked(p, now)
+	}
+	for _, p := range updates {
+		if err := r.updateLocked(p, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -12,9 +12,15 @@ import (
 )
 
 var (
-	_ outbound.ProductRepository = (*InMemRepo)(nil)
-	_ outbound.UserRepository    = (*InMemRepo)(nil)
-	_ outbound.CommentRepository = (*InMemRepo)(nil)
+	_ outbound.ProductRepository     = (*InMemRepo)(nil)
+	_ outbound.UserRepository        = (*InMemRepo)(nil)
+	_ outbound.CommentRepository     = (*InMemRepo)(nil)
+	_ outbound.CategoryRepository    = (*InMemRepo)(nil)
+	_ outbound.VariantRepository     = (*InMemRepo)(nil)
+	_ outbound.InventoryRepository   = (*InMemRepo)(nil)
+	_ outbound.ImageRepository       = (*InMemRepo)(nil)
+	_ outbound.RecommendationSource  = (*InMemRepo)(nil)
+	_ outbound.TranslationRepository = (*InMemRepo)(nil)
 )
 
 // 简单的内存实现，用于本地开发/测试和示例 wiring
@@ -22,25 +28,76 @@ type InMemRepo struct {
 	mu          sync.RWMutex
 	products    map[int64]domain.Product
 	nextProduct int64
+	slugs       map[string]int64
 	users       map[int64]domain.User
+	nextUser    int64
 	comments    map[int64]domain.Comment
 	nextComment int64
+
+	categories   map[int64]domain.Category
+	nextCategory int64
+
+	variants    map[int64]domain.Variant
+	nextVariant int64
+
+	stock           map[stockKey]domain.StockLevel
+	reservations    map[int64]domain.Reservation
+	nextReservation int64
+
+	images    map[int64]domain.ProductImage
+	nextImage int64
+
+	translations map[translationKey]domain.ProductTranslation
+
+	orders []orderRecord
+
+	priceChanges    []domain.PriceChange
+	nextPriceChange int64
+
+	suggestMu sync.Mutex
+	suggest   *suggestIndex
 }
 
 func NewInMemRepo() *InMemRepo {
 	r := &InMemRepo{
 		products:    make(map[int64]domain.Product),
 		nextProduct: 1,
+		slugs:       make(map[string]int64),
 		users:       make(map[int64]domain.User),
+		nextUser:    1,
 		comments:    make(map[int64]domain.Comment),
 		nextComment: 1,
+
+		categories:   make(map[int64]domain.Category),
+		nextCategory: 1,
+
+		variants:    make(map[int64]domain.Variant),
+		nextVariant: 1,
+
+		stock:           make(map[stockKey]domain.StockLevel),
+		reservations:    make(map[int64]domain.Reservation),
+		nextReservation: 1,
+
+		images:    make(map[int64]domain.ProductImage),
+		nextImage: 1,
+
+		translations: make(map[translationKey]domain.ProductTranslation),
+
+		nextPriceChange: 1,
 	}
 	// seed demo data
-	r.products[1] = domain.Product{ID: 1, Name: "Blue Widget", Price: 1999}
-	r.products[2] = domain.Product{ID: 2, Name: "Red Gizmo", Price: 2999}
+	seededAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	r.products[1] = domain.Product{ID: 1, Name: "Blue Widget", Slug: "blue-widget", Price: 1999, Currency: "USD", Tags: []string{"gadget", "blue"}, Status: domain.ProductPublished, PublishedAt: &seededAt, Version: 1, UpdatedAt: seededAt}
+	r.products[2] = domain.Product{ID: 2, Name: "Red Gizmo", Slug: "red-gizmo", Price: 2999, Currency: "USD", PriceOverrides: map[string]int64{"EUR": 2499}, Tags: []string{"gadget", "red"}, Status: domain.ProductPublished, PublishedAt: &seededAt, Version: 1, UpdatedAt: seededAt}
 	r.nextProduct = 3
+	for _, id := range []int64{1, 2} {
+		p := r.products[id]
+		r.slugs[p.Slug] = id
+		r.appendPriceChange(domain.AppliedPriceChange(&p, seededAt))
+	}
 	r.users[1] = domain.User{ID: 1, Name: "Alice", Email: "alice@example.com", CreatedAt: time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)}
 	r.users[2] = domain.User{ID: 2, Name: "Bob", Email: "bob@example.com", CreatedAt: time.Date(2024, time.January, 11, 9, 30, 0, 0, time.UTC)}
+	r.nextUser = 3
 	return r
 }
 
@@ -49,6 +106,7 @@ func (r *InMemRepo) CreateComment(ctx context.Context, comment *domain.Comment)
 	defer r.mu.Unlock()
 	id := r.nextComment
 	comment.ID = id
+	comment.Version = 1
 	if comment.CreatedAt.IsZero() {
 		now := time.Now().UTC()
 		comment.CreatedAt = now
@@ -72,14 +130,18 @@ func (r *InMemRepo) GetCommentByID(ctx context.Context, id int64) (*domain.Comme
 	return &copy, nil
 }
 
-func (r *InMemRepo) ListCommentsByProduct(ctx context.Context, productID int64) ([]domain.Comment, error) {
+func (r *InMemRepo) ListCommentsByProduct(ctx context.Context, productID int64, page domain.CommentPage) (*domain.CommentList, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	var out []domain.Comment
 	for _, c := range r.comments {
-		if c.ProductID == productID {
-			out = append(out, c)
+		if c.ProductID != productID {
+			continue
 		}
+		if page.After != nil && !page.After.Precedes(c) {
+			continue
+		}
+		out = append(out, c)
 	}
 	sort.Slice(out, func(i, j int) bool {
 		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
@@ -87,25 +149,40 @@ func (r *InMemRepo) ListCommentsByProduct(ctx context.Context, productID int64)
 		}
 		return out[i].CreatedAt.After(out[j].CreatedAt)
 	})
-	return out, nil
+	list := &domain.CommentList{Items: out}
+	if page.Limit > 0 && len(out) > page.Limit {
+		list.Items = out[:page.Limit]
+		last := list.Items[page.Limit-1]
+		list.Next = &domain.CommentCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+	return list, nil
 }
 
 func (r *InMemRepo) UpdateComment(ctx context.Context, comment *domain.Comment) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	if _, ok := r.comments[comment.ID]; !ok {
+	old, ok := r.comments[comment.ID]
+	if !ok {
 		return domain.ErrNotFound
 	}
+	if err := domain.CheckVersion(comment.Version, old.Version); err != nil {
+		return err
+	}
+	comment.Version = old.Version + 1
 	r.comments[comment.ID] = *comment
 	return nil
 }
 
-func (r *InMemRepo) DeleteComment(ctx context.Context, id int64) error {
+func (r *InMemRepo) DeleteComment(ctx context.Context, id int64, version int64) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	if _, ok := r.comments[id]; !ok {
+	c, ok := r.comments[id]
+	if !ok {
 		return domain.ErrNotFound
 	}
+	if err := domain.CheckVersion(version, c.Version); err != nil {
+		return err
+	}
 	delete(r.comments, id)
 	return nil
 }
@@ -113,79 +190,227 @@ func (r *InMemRepo) DeleteComment(ctx context.Context, id int64) error {
 func (r *InMemRepo) GetByID(ctx context.Context, id int64) (*domain.Product, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	p, ok := r.products[id]
+	p, ok := r.liveProduct(id)
 	if !ok {
 		return nil, domain.ErrNotFound
 	}
 	// return copy
-	pp := p
+	pp := r.readProductLocked(p)
 	return &pp, nil
 }
 
-func (r *InMemRepo) Search(ctx context.Context, q string, page, pageSize int) ([]domain.Product, int, error) {
-	if page < 1 {
-		page = 1
-	}
-	start := (page - 1) * pageSize
-	q = strings.TrimSpace(strings.ToLower(q))
+func (r *InMemRepo) Search(ctx context.Context, criteria domain.ProductSearch) (*domain.ProductSearchResult, error) {
+	criteria = criteria.Normalize()
+	q := strings.ToLower(criteria.Query)
+	ranked := criteria.SortBy == domain.SortByRelevance
 
 	r.mu.RLock()
 	defer r.mu.RUnlock()
+	inCategory := r.categorySubtreeLocked(criteria.CategoryID)
 	var filtered []domain.Product
+	var keys []domain.ProductCursor
 	for _, p := range r.products {
-		if q == "" || strings.Contains(strings.ToLower(p.Name), q) {
-			filtered = append(filtered, p)
+		// 匹配、排序与游标都基于译文
+		r.localizeLocked(&p, criteria.Locales)
+		var score float64
+		if ranked {
+			// 相关度模式用分词打分代替子串匹配，0 分视为不匹配
+			if score = relevance(q, p.Name); score == 0 {
+				continue
+			}
+		} else if q != "" && !strings.Contains(strings.ToLower(p.Name), q) {
+			continue
+		}
+		if !matchesFilters(criteria, inCategory, &p) {
+			continue
+		}
+		filtered = append(filtered, r.readProductLocked(p))
+		keys = append(keys, criteria.SortKey(p, score))
+	}
+	sort.Sort(byKey{criteria: criteria, items: filtered, keys: keys})
+
+	result := &domain.ProductSearchResult{Items: []domain.Product{}}
+	if !criteria.SkipTotal {
+		result.Total = len(filtered)
+		result.Facets = tagFacets(filtered)
+	}
+	// keyset 分页跳过游标及之前的商品，否则按 offset 分页
+	start := criteria.Offset()
+	if criteria.After != nil {
+		start = sort.Search(len(keys), func(i int) bool { return criteria.IsAfterCursor(keys[i]) })
+	}
+	if start >= len(filtered) {
+		return result, nil
+	}
+	end := start + criteria.PageSize
+	if end > len(filtered) {
+		end = len(filtered)
+	} else if end < len(filtered) {
+		next := keys[end-1]
+		result.Next = &next
+	}
+	result.Items = filtered[start:end]
+	if ranked {
+		for _, k := range keys[start:end] {
+			result.Scores = append(result.Scores, k.Score)
 		}
 	}
-	total := len(filtered)
-	// simple pagination
-	if start >= total {
-		return []domain.Product{}, total, nil
+	return result, nil
+}
+
+// matchesFilters 判断商品是否满足可见性、分类、标签与价格过滤条件（关键词匹配由调用方处理）。
+// inCategory 为 categorySubtreeLocked 的结果，nil 表示不按分类过滤。
+func matchesFilters(criteria domain.ProductSearch, inCategory map[int64]bool, p *domain.Product) bool {
+	if inCategory != nil && (p.CategoryID == nil || !inCategory[*p.CategoryID]) {
+		return false
+	}
+	return !p.IsDeleted() && criteria.MatchesStatus(p) && criteria.MatchesTags(p.Tags) && criteria.MatchesPrice(p.Price)
+}
+
+// byKey 按排序键对商品排序，商品与排序键保持一一对应。
+type byKey struct {
+	criteria domain.ProductSearch
+	items    []domain.Product
+	keys     []domain.ProductCursor
+}
+
+func (b byKey) Len() int           { return len(b.items) }
+func (b byKey) Less(i, j int) bool { return b.criteria.Less(b.keys[i], b.keys[j]) }
+func (b byKey) Swap(i, j int) {
+	b.items[i], b.items[j] = b.items[j], b.items[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
+
+// tagFacets 统计结果集中每个标签（不区分大小写）出现的商品数，按数量倒序、标签名升序。
+func tagFacets(items []domain.Product) []domain.TagCount {
+	counts := make(map[string]*domain.TagCount)
+	for _, p := range items {
+		for _, tag := range p.Tags {
+			key := strings.ToLower(tag)
+			if c, ok := counts[key]; ok {
+				c.Count++
+				if tag < c.Tag {
+					c.Tag = tag
+				}
+				continue
+			}
+			counts[key] = &domain.TagCount{Tag: tag, Count: 1}
+		}
 	}
-	end := start + pageSize
-	if end > total {
-		end = total
+	out := make([]domain.TagCount, 0, len(counts))
+	for _, c := range counts {
+		out = append(out, *c)
 	}
-	return filtered[start:end], total, nil
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count == out[j].Count {
+			return out[i].Tag < out[j].Tag
+		}
+		return out[i].Count > out[j].Count
+	})
+	return out
 }
 
 func (r *InMemRepo) Create(ctx context.Context, p *domain.Product) (int64, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	r.createLocked(p, time.Now().UTC())
+	return p.ID, nil
+}
+
+// createLocked 分配 ID 与 slug 并保存商品；调用方需持有写锁。
+func (r *InMemRepo) createLocked(p *domain.Product, now time.Time) {
 	id := r.nextProduct
 	p.ID = id
-	r.products[id] = *p
+	p.Slug = r.claimSlugLocked(id, "", p.Name)
+	p.Version = 1
+	p.UpdatedAt = now
+	r.products[id] = cloneProduct(*p)
 	r.nextProduct = id + 1
-	return id, nil
+	r.appendPriceChange(domain.AppliedPriceChange(p, now))
+	r.suggest = nil
 }
 
-func (r *InMemRepo) Delete(ctx context.Context, id int64) error {
+func (r *InMemRepo) Delete(ctx context.Context, id int64, version int64) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	if _, ok := r.products[id]; !ok {
+	p, ok := r.liveProduct(id)
+	if !ok {
 		return domain.ErrNotFound
 	}
-	delete(r.products, id)
+	if err := domain.CheckVersion(version, p.Version); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	p.DeletedAt = &now
+	p.Version++
+	p.UpdatedAt = now
+	r.products[id] = p
+	r.suggest = nil
 	return nil
 }
 
 func (r *InMemRepo) Update(ctx context.Context, p *domain.Product) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	if _, ok := r.products[p.ID]; !ok {
+	return r.updateLocked(p, time.Now().UTC())
+}
+
+// updateLocked 校验版本后覆盖存储中的商品；调用方需持有写锁。
+func (r *InMemRepo) updateLocked(p *domain.Product, now time.Time) error {
+	old, ok := r.liveProduct(p.ID)
+	if !ok {
 		return domain.ErrNotFound
 	}
-	r.products[p.ID] = *p
+	if err := domain.CheckVersion(p.Version, old.Version); err != nil {
+		return err
+	}
+	p.Slug = r.claimSlugLocked(p.ID, old.Slug, p.Name)
+	p.Version = old.Version + 1
+	p.UpdatedAt = now
+	r.products[p.ID] = cloneProduct(*p)
+	if domain.PriceChanged(&old, p) {
+		r.appendPriceChange(domain.AppliedPriceChange(p, now))
+	}
+	r.suggest = nil
 	return nil
 }
 
-func (r *InMemRepo) FindByID(ctx context.Context, id int64) (*domain.User, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	u, ok := r.users[id]
-	if !ok {
-		return nil, domain.ErrNotFound
+// readProductLocked 拷贝商品并填充分类面包屑与库存标记；调用方需持有读锁。
+func (r *InMemRepo) readProductLocked(p domain.Product) domain.Product {
+	p = cloneProduct(p)
+	if p.CategoryID != nil {
+		p.Category = r.categoryPathLocked(*p.CategoryID)
+	}
+	p.InStock = r.inStockLocked(p.ID)
+	return p
+}
+
+// cloneProduct 拷贝标签切片、覆盖价与指针字段，避免调用方修改聚合时影响到存储中的数据。
+// 面包屑与库存标记不随商品保存，读取时由 readProductLocked 重新计算。
+func cloneProduct(p domain.Product) domain.Product {
+	if p.Tags != nil {
+		p.Tags = append([]string(nil), p.Tags...)
+	}
+	if p.PriceOverrides != nil {
+		overrides := make(map[string]int64, len(p.PriceOverrides))
+		for code, price := range p.PriceOverrides {
+			overrides[code] = price
+		}
+		p.PriceOverrides = overrides
+	}
+	if p.PublishedAt != nil {
+		at := *p.PublishedAt
+		p.PublishedAt = &at
+	}
+	if p.DeletedAt != nil {
+		at := *p.DeletedAt
+		p.DeletedAt = &at
+	}
+	if p.CategoryID != nil {
+		id := *p.CategoryID
+		p.CategoryID = &id
 	}
-	uu := u
-	return &uu, nil
+	p.Category = nil
+	p.InStock = false
+	return p
 }
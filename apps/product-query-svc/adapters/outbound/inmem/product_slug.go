@@ -0,0 +1,46 @@
+package inmem
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// GetBySlug 按当前或改名前的 slug 查找商品，回收站中的商品视为不存在。
+func (r *InMemRepo) GetBySlug(ctx context.Context, slug string) (*domain.Product, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.slugs[slug]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	p, ok := r.liveProduct(id)
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	pp := r.readProductLocked(p)
+	return &pp, nil
+}
+
+// claimSlugLocked 返回商品 id 应使用的 slug：current 仍与 name 相符时沿用，否则为 name 分配第一个
+// 未被其他商品占用过的候选。r.slugs 记录每个商品用过的全部 slug，旧 slug 因此一直指向原商品；调用方需持有写锁。
+func (r *InMemRepo) claimSlugLocked(id int64, current, name string) string {
+	if current != "" && domain.SlugFits(current, name) {
+		return current
+	}
+	slug := domain.FreeSlug(domain.Slugify(name), func(slug string) bool {
+		owner, ok := r.slugs[slug]
+		return ok && owner != id
+	})
+	r.slugs[slug] = id
+	return slug
+}
+
+// deleteSlugsLocked 释放商品用过的全部 slug，仅在彻底删除商品时调用；调用方需持有写锁。
+func (r *InMemRepo) deleteSlugsLocked(id int64) {
+	for slug, owner := range r.slugs {
+		if owner == id {
+			delete(r.slugs, slug)
+		}
+	}
+}
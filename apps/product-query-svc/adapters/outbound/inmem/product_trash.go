@@ -0,0 +1,111 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// liveProduct 返回不在回收站中的商品，调用方需持有读锁或写锁。
+func (r *InMemRepo) liveProduct(id int64) (domain.Product, bool) {
+	p, ok := r.products[id]
+	if !ok || p.IsDeleted() {
+		return domain.Product{}, false
+	}
+	return p, true
+}
+
+func (r *InMemRepo) Restore(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.products[id]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if !p.IsDeleted() {
+		return domain.ConflictError("product is not in the trash")
+	}
+	p.DeletedAt = nil
+	p.Version++
+	p.UpdatedAt = time.Now().UTC()
+	r.products[id] = p
+	r.suggest = nil
+	return nil
+}
+
+func (r *InMemRepo) ListDeleted(ctx context.Context, page domain.TrashPage) (*domain.TrashList, error) {
+	page = page.Normalize()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var trashed []domain.Product
+	for _, p := range r.products {
+		if p.IsDeleted() {
+			trashed = append(trashed, r.readProductLocked(p))
+		}
+	}
+	sort.Slice(trashed, func(i, j int) bool {
+		if trashed[i].DeletedAt.Equal(*trashed[j].DeletedAt) {
+			return trashed[i].ID > trashed[j].ID
+		}
+		return trashed[i].DeletedAt.After(*trashed[j].DeletedAt)
+	})
+	list := &domain.TrashList{Items: []domain.Product{}, Total: len(trashed)}
+	if start := page.Offset(); start < len(trashed) {
+		end := start + page.PageSize
+		if end > len(trashed) {
+			end = len(trashed)
+		}
+		list.Items = trashed[start:end]
+	}
+	return list, nil
+}
+
+// PurgeDeleted 彻底删除回收站中早于 cutoff 的商品，连同评论、价格历史、规格、库存、图片元数据与用过的 slug，对应 Postgres 的级联删除；
+// 返回被删图片的 blob key，内容由调用方删除。
+func (r *InMemRepo) PurgeDeleted(ctx context.Context, cutoff time.Time, limit int) (int, []string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var expired []domain.Product
+	for _, p := range r.products {
+		if p.IsDeleted() && p.DeletedAt.Before(cutoff) {
+			expired = append(expired, p)
+		}
+	}
+	// 最早删除的先清理，保证分批调用时顺序稳定
+	sort.Slice(expired, func(i, j int) bool {
+		if expired[i].DeletedAt.Equal(*expired[j].DeletedAt) {
+			return expired[i].ID < expired[j].ID
+		}
+		return expired[i].DeletedAt.Before(*expired[j].DeletedAt)
+	})
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+	var blobKeys []string
+	for _, p := range expired {
+		delete(r.products, p.ID)
+		r.deleteSlugsLocked(p.ID)
+		r.deleteTranslationsLocked(p.ID)
+		r.deletePriceChanges(p.ID)
+		for id, c := range r.comments {
+			if c.ProductID == p.ID {
+				delete(r.comments, id)
+			}
+		}
+		for id, v := range r.variants {
+			if v.ProductID == p.ID {
+				delete(r.variants, id)
+			}
+		}
+		r.deleteStockLocked(p.ID, nil)
+		for id, img := range r.images {
+			if img.ProductID == p.ID {
+				blobKeys = append(blobKeys, img.BlobKey)
+				delete(r.images, id)
+			}
+		}
+	}
+	return len(expired), blobKeys, nil
+}
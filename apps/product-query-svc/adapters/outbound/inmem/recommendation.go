@@ -0,0 +1,92 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// orderRecord 对应 Postgres 的 orders 表：订单只记录用户与商品名。
+type orderRecord struct {
+	userID      int64
+	productName string
+}
+
+// RecordOrder 记录一笔订单；内存实现没有下单流程，用于演示与测试“买了又买”的推荐。
+func (r *InMemRepo) RecordOrder(userID int64, productName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.orders = append(r.orders, orderRecord{userID: userID, productName: productName})
+}
+
+// Related 按共同标签、同一分类以及（可选）共同购买为其他已发布商品打分。
+func (r *InMemRepo) Related(ctx context.Context, query domain.RelatedQuery) ([]domain.RelatedProduct, error) {
+	query = query.Normalize()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	source, ok := r.liveProduct(query.ProductID)
+	if !ok {
+		return []domain.RelatedProduct{}, nil
+	}
+	tags := make(map[string]bool, len(source.Tags))
+	for _, t := range source.Tags {
+		tags[strings.ToLower(t)] = true
+	}
+	var coPurchases map[string]int
+	if query.IncludeOrders {
+		coPurchases = r.coPurchasesLocked(source.Name)
+	}
+
+	var candidates []domain.RelatedProduct
+	for _, p := range r.products {
+		if p.ID == source.ID || p.IsDeleted() || p.Status != domain.ProductPublished {
+			continue
+		}
+		item := domain.RelatedProduct{
+			SharedTags:   []string{},
+			SameCategory: p.CategoryID != nil && source.CategoryID != nil && *p.CategoryID == *source.CategoryID,
+			CoPurchases:  coPurchases[strings.ToLower(p.Name)],
+		}
+		for _, t := range p.Tags {
+			if key := strings.ToLower(t); tags[key] {
+				item.SharedTags = append(item.SharedTags, key)
+			}
+		}
+		sort.Strings(item.SharedTags)
+		item.Score = domain.RelatedScore(len(item.SharedTags), item.SameCategory, item.CoPurchases)
+		if item.Score == 0 {
+			continue
+		}
+		item.Product = r.readProductLocked(p)
+		candidates = append(candidates, item)
+	}
+	return domain.RankRelated(candidates, query.Limit), nil
+}
+
+// coPurchasesLocked 统计每个商品名（小写）有多少位用户同时买过 name 对应的商品；调用方需持有读锁。
+func (r *InMemRepo) coPurchasesLocked(name string) map[string]int {
+	buyers := make(map[int64]bool)
+	for _, o := range r.orders {
+		if strings.EqualFold(o.productName, name) {
+			buyers[o.userID] = true
+		}
+	}
+	users := make(map[string]map[int64]bool)
+	for _, o := range r.orders {
+		if !buyers[o.userID] {
+			continue
+		}
+		key := strings.ToLower(o.productName)
+		if users[key] == nil {
+			users[key] = make(map[int64]bool)
+		}
+		users[key][o.userID] = true
+	}
+	counts := make(map[string]int, len(users))
+	for key, ids := range users {
+		counts[key] = len(ids)
+	}
+	return counts
+}
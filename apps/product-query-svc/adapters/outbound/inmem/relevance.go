@@ -0,0 +1,78 @@
+package inmem
+
+import (
+	"strings"
+	"unicode"
+)
+
+// relevance 是一个简单的基于分词的打分器，近似 Postgres 的全文 + trigram 检索：
+// 每个查询词取其在名称中的最佳命中（完全匹配 1、前缀 0.75、子串 0.5、编辑距离为 1 的拼写错误 0.4），
+// 最终得分为各查询词得分的平均值，0 表示不匹配。
+func relevance(query, name string) float64 {
+	qTokens := tokenize(query)
+	if len(qTokens) == 0 {
+		return 0
+	}
+	nTokens := tokenize(name)
+	var total float64
+	for _, q := range qTokens {
+		best := 0.0
+		for _, n := range nTokens {
+			if s := tokenScore(q, n); s > best {
+				best = s
+			}
+		}
+		total += best
+	}
+	return total / float64(len(qTokens))
+}
+
+func tokenScore(q, n string) float64 {
+	switch {
+	case q == n:
+		return 1
+	case strings.HasPrefix(n, q):
+		return 0.75
+	case strings.Contains(n, q):
+		return 0.5
+	case len([]rune(q)) >= 4 && withinOneEdit(q, n):
+		return 0.4
+	default:
+		return 0
+	}
+}
+
+// tokenize 按非字母数字字符切分并转小写。
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// withinOneEdit 判断两个词之间的编辑距离是否不超过 1（插入、删除或替换一个字符）。
+func withinOneEdit(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) > len(rb) {
+		ra, rb = rb, ra
+	}
+	if len(rb)-len(ra) > 1 {
+		return false
+	}
+	i, j, edits := 0, 0, 0
+	for i < len(ra) && j < len(rb) {
+		if ra[i] == rb[j] {
+			i++
+			j++
+			continue
+		}
+		edits++
+		if edits > 1 {
+			return false
+		}
+		if len(ra) == len(rb) {
+			i++
+		}
+		j++
+	}
+	return edits+(len(rb)-j)+(len(ra)-i) <= 1
+}
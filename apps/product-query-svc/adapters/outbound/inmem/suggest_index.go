@@ -0,0 +1,79 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// suggestIndex 是按小写键排序的前缀索引，写操作置空后在下一次联想查询时重建。
+type suggestIndex struct {
+	names []suggestEntry
+	tags  []suggestEntry
+}
+
+type suggestEntry struct {
+	key       string
+	text      string
+	productID int64
+	weight    int
+}
+
+func buildSuggestIndex(products map[int64]domain.Product) *suggestIndex {
+	idx := &suggestIndex{}
+	tagCounts := make(map[string]int)
+	for _, p := range products {
+		// 未发布或在回收站中的商品不出现在联想结果中
+		if !p.IsPublished() || p.IsDeleted() {
+			continue
+		}
+		idx.names = append(idx.names, suggestEntry{key: strings.ToLower(p.Name), text: p.Name, productID: p.ID, weight: 1})
+		for _, t := range p.Tags {
+			tagCounts[strings.ToLower(t)]++
+		}
+	}
+	for tag, n := range tagCounts {
+		idx.tags = append(idx.tags, suggestEntry{key: tag, text: tag, weight: n})
+	}
+	sort.Slice(idx.names, func(i, j int) bool {
+		if idx.names[i].key == idx.names[j].key {
+			return idx.names[i].productID < idx.names[j].productID
+		}
+		return idx.names[i].key < idx.names[j].key
+	})
+	sort.Slice(idx.tags, func(i, j int) bool { return idx.tags[i].key < idx.tags[j].key })
+	return idx
+}
+
+// withPrefix 二分定位前缀起点，返回全部前缀匹配项，由领域排序负责截断。
+func withPrefix(entries []suggestEntry, prefix string) []suggestEntry {
+	start := sort.Search(len(entries), func(i int) bool { return entries[i].key >= prefix })
+	end := start
+	for end < len(entries) && strings.HasPrefix(entries[end].key, prefix) {
+		end++
+	}
+	return entries[start:end]
+}
+
+func (r *InMemRepo) Suggest(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	r.suggestMu.Lock()
+	if r.suggest == nil {
+		r.suggest = buildSuggestIndex(r.products)
+	}
+	idx := r.suggest
+	r.suggestMu.Unlock()
+
+	var out []domain.Suggestion
+	for _, e := range withPrefix(idx.tags, prefix) {
+		out = append(out, domain.Suggestion{Text: e.text, Kind: domain.SuggestionTag, Weight: e.weight})
+	}
+	for _, e := range withPrefix(idx.names, prefix) {
+		out = append(out, domain.Suggestion{Text: e.text, Kind: domain.SuggestionName, ProductID: e.productID, Weight: e.weight})
+	}
+	return out, nil
+}
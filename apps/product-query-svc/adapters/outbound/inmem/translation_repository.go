@@ -0,0 +1,86 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// translationKey 标识商品在某个语言下的译文。
+type translationKey struct {
+	productID int64
+	locale    string
+}
+
+func (r *InMemRepo) ListTranslations(ctx context.Context, productID int64) ([]domain.ProductTranslation, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := []domain.ProductTranslation{}
+	for key, t := range r.translations {
+		if key.productID == productID {
+			out = append(out, t)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Locale < out[j].Locale })
+	return out, nil
+}
+
+// PutTranslation 写入译文，并像其他商品写入一样递增商品版本、刷新更新时间。
+func (r *InMemRepo) PutTranslation(ctx context.Context, translation *domain.ProductTranslation) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.liveProduct(translation.ProductID); !ok {
+		return false, domain.ErrNotFound
+	}
+	key := translationKey{translation.ProductID, translation.Locale}
+	_, exists := r.translations[key]
+	now := time.Now().UTC()
+	translation.UpdatedAt = now
+	r.translations[key] = *translation
+	r.touchProductLocked(translation.ProductID, now)
+	return !exists, nil
+}
+
+func (r *InMemRepo) DeleteTranslation(ctx context.Context, productID int64, locale string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := translationKey{productID, locale}
+	if _, ok := r.translations[key]; !ok {
+		return domain.ErrNotFound
+	}
+	if _, ok := r.liveProduct(productID); !ok {
+		return domain.ErrNotFound
+	}
+	delete(r.translations, key)
+	r.touchProductLocked(productID, time.Now().UTC())
+	return nil
+}
+
+// localizeLocked 把商品名称与描述换成 chain 中第一个存在的译文；调用方需持有读锁。
+func (r *InMemRepo) localizeLocked(p *domain.Product, chain []string) {
+	for _, locale := range chain {
+		if t, ok := r.translations[translationKey{p.ID, locale}]; ok {
+			p.Name, p.Description, p.Locale = t.Name, t.Description, t.Locale
+			return
+		}
+	}
+}
+
+// touchProductLocked 递增商品版本并刷新更新时间，使缓存的商品读取重新校验；调用方需持有写锁。
+func (r *InMemRepo) touchProductLocked(id int64, now time.Time) {
+	p := r.products[id]
+	p.Version++
+	p.UpdatedAt = now
+	r.products[id] = p
+}
+
+// deleteTranslationsLocked 删除商品的全部译文，仅在彻底删除商品时调用；调用方需持有写锁。
+func (r *InMemRepo) deleteTranslationsLocked(id int64) {
+	for key := range r.translations {
+		if key.productID == id {
+			delete(r.translations, key)
+		}
+	}
+}
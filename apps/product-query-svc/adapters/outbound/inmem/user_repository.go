@@ -0,0 +1,111 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+func (r *InMemRepo) FindByID(ctx context.Context, id int64) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	u, ok := r.users[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	uu := u
+	return &uu, nil
+}
+
+func (r *InMemRepo) SearchUsers(ctx context.Context, criteria domain.UserSearch) (*domain.UserSearchResult, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var matched []domain.User
+	for _, u := range r.users {
+		if criteria.Matches(&u) {
+			matched = append(matched, u)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return criteria.Less(criteria.SortKey(&matched[i]), criteria.SortKey(&matched[j]))
+	})
+
+	result := &domain.UserSearchResult{Items: []domain.User{}}
+	if !criteria.SkipTotal {
+		result.Total = len(matched)
+	}
+	// keyset 分页跳过游标及之前的用户，否则按 offset 分页
+	start := min(criteria.Offset(), len(matched))
+	if criteria.After != nil {
+		start = sort.Search(len(matched), func(i int) bool { return criteria.IsAfterCursor(criteria.SortKey(&matched[i])) })
+	}
+	end := min(start+criteria.PageSize, len(matched))
+	result.Items = append(result.Items, matched[start:end]...)
+	if end < len(matched) {
+		next := criteria.SortKey(&matched[end-1])
+		result.Next = &next
+	}
+	return result, nil
+}
+
+func (r *InMemRepo) CreateUser(ctx context.Context, user *domain.User) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkEmailLocked(user); err != nil {
+		return 0, err
+	}
+	user.ID = r.nextUser
+	user.CreatedAt = time.Now().UTC()
+	r.users[user.ID] = *user
+	r.nextUser++
+	return user.ID, nil
+}
+
+func (r *InMemRepo) UpdateUser(ctx context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old, ok := r.users[user.ID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	if err := r.checkEmailLocked(user); err != nil {
+		return err
+	}
+	user.CreatedAt = old.CreatedAt
+	r.users[user.ID] = *user
+	return nil
+}
+
+// DeleteUser 与 Postgres 的外键一致：仍有订单时拒绝删除，评论随用户一并删除。
+func (r *InMemRepo) DeleteUser(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.users[id]; !ok {
+		return domain.ErrNotFound
+	}
+	for _, o := range r.orders {
+		if o.userID == id {
+			return domain.UserHasOrdersError()
+		}
+	}
+	delete(r.users, id)
+	for commentID, c := range r.comments {
+		if c.UserID == id {
+			delete(r.comments, commentID)
+		}
+	}
+	return nil
+}
+
+// checkEmailLocked 与 Postgres 的 citext 唯一约束一致，邮箱不区分大小写地比较；调用方需持有写锁。
+func (r *InMemRepo) checkEmailLocked(user *domain.User) error {
+	for _, other := range r.users {
+		if other.ID != user.ID && strings.EqualFold(other.Email, user.Email) {
+			return domain.DuplicateEmailError()
+		}
+	}
+	return nil
+}
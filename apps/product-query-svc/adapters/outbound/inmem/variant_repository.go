@@ -0,0 +1,112 @@
+package inmem
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+func (r *InMemRepo) ListVariants(ctx context.Context, productID int64) ([]domain.Variant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := []domain.Variant{}
+	for _, v := range r.variants {
+		if v.ProductID == productID {
+			out = append(out, cloneVariant(v))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+func (r *InMemRepo) GetVariant(ctx context.Context, productID, variantID int64) (*domain.Variant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	v, ok := r.variants[variantID]
+	if !ok || v.ProductID != productID {
+		return nil, domain.ErrNotFound
+	}
+	cv := cloneVariant(v)
+	return &cv, nil
+}
+
+func (r *InMemRepo) CreateVariant(ctx context.Context, variant *domain.Variant) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkVariantLocked(variant); err != nil {
+		return 0, err
+	}
+	now := time.Now().UTC()
+	variant.ID = r.nextVariant
+	variant.Version = 1
+	variant.CreatedAt = now
+	variant.UpdatedAt = now
+	r.variants[variant.ID] = cloneVariant(*variant)
+	r.nextVariant++
+	return variant.ID, nil
+}
+
+func (r *InMemRepo) UpdateVariant(ctx context.Context, variant *domain.Variant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old, ok := r.variants[variant.ID]
+	if !ok || old.ProductID != variant.ProductID {
+		return domain.ErrNotFound
+	}
+	if err := domain.CheckVersion(variant.Version, old.Version); err != nil {
+		return err
+	}
+	if err := r.checkVariantLocked(variant); err != nil {
+		return err
+	}
+	variant.Version = old.Version + 1
+	variant.CreatedAt = old.CreatedAt
+	variant.UpdatedAt = time.Now().UTC()
+	r.variants[variant.ID] = cloneVariant(*variant)
+	return nil
+}
+
+func (r *InMemRepo) DeleteVariant(ctx context.Context, productID, variantID int64, version int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	v, ok := r.variants[variantID]
+	if !ok || v.ProductID != productID {
+		return domain.ErrNotFound
+	}
+	if err := domain.CheckVersion(version, v.Version); err != nil {
+		return err
+	}
+	delete(r.variants, variantID)
+	r.deleteStockLocked(productID, &variantID)
+	return nil
+}
+
+// checkVariantLocked 模拟 Postgres 的唯一索引：SKU 在所有商品间唯一，属性组合在同一商品内唯一；调用方需持有写锁。
+func (r *InMemRepo) checkVariantLocked(variant *domain.Variant) error {
+	for _, other := range r.variants {
+		if other.ID == variant.ID {
+			continue
+		}
+		if strings.EqualFold(other.SKU, variant.SKU) {
+			return domain.DuplicateSKUError(variant.SKU)
+		}
+		if other.ProductID == variant.ProductID && maps.Equal(other.Attributes, variant.Attributes) {
+			return domain.DuplicateVariantError()
+		}
+	}
+	return nil
+}
+
+// cloneVariant 深拷贝属性与覆盖价，避免调用方修改仓储内的数据。
+func cloneVariant(v domain.Variant) domain.Variant {
+	v.Attributes = maps.Clone(v.Attributes)
+	if v.PriceCents != nil {
+		price := *v.PriceCents
+		v.PriceCents = &price
+	}
+	return v
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Postgres error codes raised by the constraints of migration 000015.
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+type PGCategoryRepo struct{ pool *pgxpool.Pool }
+
+var _ outbound.CategoryRepository = (*PGCategoryRepo)(nil)
+
+// categoryColumns is the select list scanned into domain.Category, in scan order.
+var categoryColumns = []string{"id", "name", "parent_id", "version", "updated_at"}
+
+// categoryDest returns the scan targets for categoryColumns.
+func categoryDest(c *domain.Category) []any {
+	return []any{&c.ID, &c.Name, &c.ParentID, &c.Version, &c.UpdatedAt}
+}
+
+func NewCategoryRepository(pool *pgxpool.Pool) outbound.CategoryRepository {
+	return &PGCategoryRepo{pool: pool}
+}
+
+func (r *PGCategoryRepo) ListCategories(ctx context.Context) ([]domain.Category, error) {
+	sql, args, err := psql.Select(categoryColumns...).From("categories").OrderBy("id").ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.Category, error) {
+		var c domain.Category
+		err := row.Scan(categoryDest(&c)...)
+		return c, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the whole tree is at hand, so paths are cheaper to build here than per row in SQL
+	domain.BuildCategoryPaths(out)
+	return out, nil
+}
+
+func (r *PGCategoryRepo) GetCategory(ctx context.Context, id int64) (*domain.Category, error) {
+	sql, args, err := psql.Select(categoryColumns...).Column("category_path(id)").
+		From("categories").Where(squirrel.Eq{"id": id}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var c domain.Category
+	if err := r.pool.QueryRow(ctx, sql, args...).Scan(append(categoryDest(&c), &c.Path)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *PGCategoryRepo) CreateCategory(ctx context.Context, category *domain.Category) (int64, error) {
+	sql, args, err := psql.Insert("categories").
+		Columns("name", "parent_id").
+		Values(category.Name, category.ParentID).
+		// RETURNING sees the tree as it was before the insert, so this is the parent's path
+		Suffix("RETURNING id, version, updated_at, category_path(parent_id)").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+	var (
+		id, version int64
+		updatedAt   time.Time
+		parentPath  domain.CategoryPath
+	)
+	if err := r.pool.QueryRow(ctx, sql, args...).Scan(&id, &version, &updatedAt, &parentPath); err != nil {
+		return 0, categoryWriteError(err, category.ParentID)
+	}
+	category.ID = id
+	category.Version = version
+	category.UpdatedAt = updatedAt
+	category.Path = append(parentPath, domain.CategoryRef{ID: id, Name: category.Name})
+	return id, nil
+}
+
+// UpdateCategory serialises category writes with a table lock for the rest of its transaction,
+// so two concurrent moves cannot each pass the cycle check and together form a loop.
+func (r *PGCategoryRepo) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	sql, args, err := psql.Update("categories").
+		Set("name", category.Name).
+		Set("parent_id", category.ParentID).
+		Set("version", squirrel.Expr("version + 1")).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": category.ID}).
+		Suffix("RETURNING version, updated_at, category_path(parent_id)").
+		ToSql()
+	if err != nil {
+		return err
+	}
+	// breadcrumbs of the whole subtree change with a rename or move, so the products' cached
+	// ETags and Last-Modified dates must stop matching
+	touchSQL, touchArgs, err := psql.Update("products").
+		Set("version", squirrel.Expr("version + 1")).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where("category_id IN (SELECT id FROM category_subtree(?))", category.ID).
+		Where("EXISTS (SELECT 1 FROM categories WHERE id = ? AND (name <> ? OR parent_id IS DISTINCT FROM ?))",
+			category.ID, category.Name, category.ParentID).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	var (
+		version    int64
+		updatedAt  time.Time
+		parentPath domain.CategoryPath
+	)
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+			return err
+		}
+		if err := lockCategory(ctx, tx, category.ID, category.Version); err != nil {
+			return err
+		}
+		if category.ParentID != nil {
+			var cycle bool
+			if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM category_subtree($1) WHERE id = $2)", category.ID, *category.ParentID).Scan(&cycle); err != nil {
+				return err
+			}
+			if cycle {
+				return domain.CategoryCycleError()
+			}
+		}
+		if _, err := tx.Exec(ctx, touchSQL, touchArgs...); err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, sql, args...).Scan(&version, &updatedAt, &parentPath)
+	})
+	if err != nil {
+		return categoryWriteError(err, category.ParentID)
+	}
+	category.Version = version
+	category.UpdatedAt = updatedAt
+	category.Path = append(parentPath, domain.CategoryRef{ID: category.ID, Name: category.Name})
+	return nil
+}
+
+// DeleteCategory relies on the ON DELETE RESTRICT foreign keys from subcategories and products.
+func (r *PGCategoryRepo) DeleteCategory(ctx context.Context, id int64, version int64) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockCategory(ctx, tx, id, version); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM categories WHERE id=$1", id); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+				return domain.CategoryInUseError()
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// lockCategory locks a category for the rest of tx and checks the caller's expected version against it.
+func lockCategory(ctx context.Context, tx pgx.Tx, id, expectedVersion int64) error {
+	var current int64
+	if err := tx.QueryRow(ctx, "SELECT version FROM categories WHERE id=$1 FOR UPDATE", id).Scan(&current); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return domain.CheckVersion(expectedVersion, current)
+}
+
+// categoryWriteError maps a duplicate sibling name or a parent deleted in the meantime to domain errors.
+func categoryWriteError(err error, parentID *int64) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch {
+	case pgErr.Code == pgUniqueViolation:
+		return domain.DuplicateCategoryError()
+	case pgErr.Code == pgForeignKeyViolation && parentID != nil:
+		return domain.ValidationError(fmt.Sprintf("parent category %d not found", *parentID))
+	default:
+		return err
+	}
+}
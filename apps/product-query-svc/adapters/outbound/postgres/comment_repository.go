@@ -18,6 +18,14 @@ type PGCommentRepo struct {
 
 var _ outbound.CommentRepository = (*PGCommentRepo)(nil)
 
+// commentColumns is the select list scanned into domain.Comment, in scan order.
+var commentColumns = []string{"id", "product_id", "user_id", "content", "created_at", "updated_at", "version"}
+
+// commentDest returns the scan targets for commentColumns.
+func commentDest(c *domain.Comment) []any {
+	return []any{&c.ID, &c.ProductID, &c.UserID, &c.Content, &c.CreatedAt, &c.UpdatedAt, &c.Version}
+}
+
 func NewCommentRepository(pool *pgxpool.Pool) outbound.CommentRepository {
 	return &PGCommentRepo{pool: pool}
 }
@@ -38,33 +46,34 @@ func (r *PGCommentRepo) CreateComment(ctx context.Context, comment *domain.Comme
 		"content",
 		"created_at",
 		"updated_at",
-	).Values(comment.ProductID, comment.UserID, comment.Content, createdAt, updatedAt).Suffix("RETURNING id")
+	).Values(comment.ProductID, comment.UserID, comment.Content, createdAt, updatedAt).Suffix("RETURNING id, version")
 
 	sql, args, err := qb.ToSql()
 	if err != nil {
 		return 0, err
 	}
 
-	var id int64
-	if err := r.pool.QueryRow(ctx, sql, args...).Scan(&id); err != nil {
+	var id, version int64
+	if err := r.pool.QueryRow(ctx, sql, args...).Scan(&id, &version); err != nil {
 		return 0, err
 	}
 
 	comment.ID = id
+	comment.Version = version
 	comment.CreatedAt = createdAt
 	comment.UpdatedAt = updatedAt
 	return id, nil
 }
 
 func (r *PGCommentRepo) GetCommentByID(ctx context.Context, id int64) (*domain.Comment, error) {
-	qb := psql.Select("id", "product_id", "user_id", "content", "created_at", "updated_at").From("comments").Where(squirrel.Eq{"id": id})
+	qb := psql.Select(commentColumns...).From("comments").Where(squirrel.Eq{"id": id})
 	sql, args, err := qb.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
 	var c domain.Comment
-	if err := r.pool.QueryRow(ctx, sql, args...).Scan(&c.ID, &c.ProductID, &c.UserID, &c.Content, &c.CreatedAt, &c.UpdatedAt); err != nil {
+	if err := r.pool.QueryRow(ctx, sql, args...).Scan(commentDest(&c)...); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrNotFound
 		}
@@ -73,11 +82,18 @@ func (r *PGCommentRepo) GetCommentByID(ctx context.Context, id int64) (*domain.C
 	return &c, nil
 }
 
-func (r *PGCommentRepo) ListCommentsByProduct(ctx context.Context, productID int64) ([]domain.Comment, error) {
-	qb := psql.Select("id", "product_id", "user_id", "content", "created_at", "updated_at").
+func (r *PGCommentRepo) ListCommentsByProduct(ctx context.Context, productID int64, page domain.CommentPage) (*domain.CommentList, error) {
+	qb := psql.Select(commentColumns...).
 		From("comments").
 		Where(squirrel.Eq{"product_id": productID}).
 		OrderBy("created_at DESC", "id DESC")
+	if page.After != nil {
+		qb = qb.Where("(created_at, id) < (?, ?)", page.After.CreatedAt, page.After.ID)
+	}
+	if page.Limit > 0 {
+		// one extra row tells us whether another page follows
+		qb = qb.Limit(uint64(page.Limit) + 1)
+	}
 
 	sql, args, err := qb.ToSql()
 	if err != nil {
@@ -93,7 +109,7 @@ func (r *PGCommentRepo) ListCommentsByProduct(ctx context.Context, productID int
 	var out []domain.Comment
 	for rows.Next() {
 		var c domain.Comment
-		if err := rows.Scan(&c.ID, &c.ProductID, &c.UserID, &c.Content, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		if err := rows.Scan(commentDest(&c)...); err != nil {
 			return nil, err
 		}
 		out = append(out, c)
@@ -101,7 +117,13 @@ func (r *PGCommentRepo) ListCommentsByProduct(ctx context.Context, productID int
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-	return out, nil
+	list := &domain.CommentList{Items: out}
+	if page.Limit > 0 && len(out) > page.Limit {
+		list.Items = out[:page.Limit]
+		last := list.Items[page.Limit-1]
+		list.Next = &domain.CommentCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+	return list, nil
 }
 
 func (r *PGCommentRepo) UpdateComment(ctx context.Context, comment *domain.Comment) error {
@@ -113,26 +135,32 @@ func (r *PGCommentRepo) UpdateComment(ctx context.Context, comment *domain.Comme
 	qb := psql.Update("comments").
 		Set("content", comment.Content).
 		Set("updated_at", updatedAt).
-		Where(squirrel.Eq{"id": comment.ID})
+		Set("version", squirrel.Expr("version + 1")).
+		Where(squirrel.Eq{"id": comment.ID}).
+		Suffix("RETURNING version")
 
 	sql, args, err := qb.ToSql()
 	if err != nil {
 		return err
 	}
 
-	ct, err := r.pool.Exec(ctx, sql, args...)
+	var version int64
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockComment(ctx, tx, comment.ID, comment.Version); err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, sql, args...).Scan(&version)
+	})
 	if err != nil {
 		return err
 	}
-	if ct.RowsAffected() == 0 {
-		return domain.ErrNotFound
-	}
 
 	comment.UpdatedAt = updatedAt
+	comment.Version = version
 	return nil
 }
 
-func (r *PGCommentRepo) DeleteComment(ctx context.Context, id int64) error {
+func (r *PGCommentRepo) DeleteComment(ctx context.Context, id int64, version int64) error {
 	qb := psql.Delete("comments").Where(squirrel.Eq{"id": id})
 
 	sql, args, err := qb.ToSql()
@@ -140,12 +168,23 @@ func (r *PGCommentRepo) DeleteComment(ctx context.Context, id int64) error {
 		return err
 	}
 
-	ct, err := r.pool.Exec(ctx, sql, args...)
-	if err != nil {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockComment(ctx, tx, id, version); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, sql, args...)
+		return err
+	})
+}
+
+// lockComment locks a comment for the rest of tx and checks the caller's expected version against it.
+func lockComment(ctx context.Context, tx pgx.Tx, id, expectedVersion int64) error {
+	var current int64
+	if err := tx.QueryRow(ctx, "SELECT version FROM comments WHERE id=$1 FOR UPDATE", id).Scan(&current); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
 		return err
 	}
-	if ct.RowsAffected() == 0 {
-		return domain.ErrNotFound
-	}
-	return nil
+	return domain.CheckVersion(expectedVersion, current)
 }
@@ -129,8 +129,13 @@ func TestCommentRepository_WithDocker(t *testing.T) {
 	if err != nil {
 		t.Fatalf("get updated comment: %v", err)
 	}
-	if updated.Content != "updated content" {
-		t.Fatalf("expected updated content, got %#v", updated)
+	if updated.Content != "updated content" || updated.Version != 2 || first.Version != 2 {
+		t.Fatalf("expected updated content at version 2, got %#v", updated)
+	}
+	stale := *updated
+	stale.Version = 1
+	if err := commentRepo.UpdateComment(ctx, &stale); !errors.Is(err, domain.ErrPreconditionFailed) {
+		t.Fatalf("expected precondition failure for a stale update, got %v", err)
 	}
 
 	second := &domain.Comment{ProductID: productID, UserID: userID, Content: "second"}
@@ -139,18 +144,37 @@ func TestCommentRepository_WithDocker(t *testing.T) {
 		t.Fatalf("create second comment: %v", err)
 	}
 
-	list, err := commentRepo.ListCommentsByProduct(ctx, productID)
+	list, err := commentRepo.ListCommentsByProduct(ctx, productID, domain.CommentPage{})
 	if err != nil {
 		t.Fatalf("list comments: %v", err)
 	}
-	if len(list) != 2 {
-		t.Fatalf("expected 2 comments, got %d", len(list))
+	if len(list.Items) != 2 || list.Next != nil {
+		t.Fatalf("expected 2 comments and no next cursor, got %#v", list)
 	}
-	if list[0].ID != secondID {
-		t.Fatalf("expected newest comment first, got order %#v", list)
+	if list.Items[0].ID != secondID {
+		t.Fatalf("expected newest comment first, got order %#v", list.Items)
 	}
 
-	if err := commentRepo.DeleteComment(ctx, firstID); err != nil {
+	// keyset pagination walks the same order one comment at a time
+	page, err := commentRepo.ListCommentsByProduct(ctx, productID, domain.CommentPage{Limit: 1})
+	if err != nil {
+		t.Fatalf("list first page: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != secondID || page.Next == nil {
+		t.Fatalf("unexpected first page: %#v", page)
+	}
+	page, err = commentRepo.ListCommentsByProduct(ctx, productID, domain.CommentPage{Limit: 1, After: page.Next})
+	if err != nil {
+		t.Fatalf("list second page: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != firstID || page.Next != nil {
+		t.Fatalf("unexpected second page: %#v", page)
+	}
+
+	if err := commentRepo.DeleteComment(ctx, firstID, 1); !errors.Is(err, domain.ErrPreconditionFailed) {
+		t.Fatalf("expected precondition failure for a stale delete, got %v", err)
+	}
+	if err := commentRepo.DeleteComment(ctx, firstID, 2); err != nil {
 		t.Fatalf("delete comment: %v", err)
 	}
 	if _, err := commentRepo.GetCommentByID(ctx, firstID); !errors.Is(err, domain.ErrNotFound) {
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGImageRepo struct{ pool *pgxpool.Pool }
+
+var _ outbound.ImageRepository = (*PGImageRepo)(nil)
+
+// imageColumns is the select list scanned into domain.ProductImage, in scan order.
+var imageColumns = []string{"id", "product_id", "position", "alt_text", "content_type", "size_bytes", "checksum", "blob_key", "created_at", "updated_at"}
+
+// imageDest returns the scan targets for imageColumns.
+func imageDest(img *domain.ProductImage) []any {
+	return []any{&img.ID, &img.ProductID, &img.Position, &img.AltText, &img.ContentType, &img.Size, &img.Checksum, &img.BlobKey, &img.CreatedAt, &img.UpdatedAt}
+}
+
+func NewImageRepository(pool *pgxpool.Pool) outbound.ImageRepository {
+	return &PGImageRepo{pool: pool}
+}
+
+func (r *PGImageRepo) ListImages(ctx context.Context, productID int64) ([]domain.ProductImage, error) {
+	sql, args, err := imagesQuery(productID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return collectImages(rows)
+}
+
+func (r *PGImageRepo) GetImage(ctx context.Context, productID, imageID int64) (*domain.ProductImage, error) {
+	sql, args, err := psql.Select(imageColumns...).From("product_images").
+		Where(squirrel.Eq{"id": imageID, "product_id": productID}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var img domain.ProductImage
+	if err := r.pool.QueryRow(ctx, sql, args...).Scan(imageDest(&img)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return &img, nil
+}
+
+func (r *PGImageRepo) CreateImage(ctx context.Context, image *domain.ProductImage) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockProductImages(ctx, tx, image.ProductID); err != nil {
+			return err
+		}
+		var count int
+		if err := tx.QueryRow(ctx, "SELECT COUNT(*) FROM product_images WHERE product_id=$1", image.ProductID).Scan(&count); err != nil {
+			return err
+		}
+		if count >= domain.MaxProductImages {
+			return domain.TooManyImagesError()
+		}
+		sql, args, err := psql.Insert("product_images").
+			Columns("product_id", "position", "alt_text", "content_type", "size_bytes", "checksum", "blob_key").
+			Values(image.ProductID, count, image.AltText, image.ContentType, image.Size, image.Checksum, image.BlobKey).
+			Suffix("RETURNING id, position, created_at, updated_at").
+			ToSql()
+		if err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, sql, args...).Scan(&image.ID, &image.Position, &image.CreatedAt, &image.UpdatedAt)
+	})
+}
+
+// UpdateImage renumbers the product's images in Go and writes back the positions that changed;
+// the deferred unique constraint is checked once the transaction commits.
+func (r *PGImageRepo) UpdateImage(ctx context.Context, image *domain.ProductImage) error {
+	sql, args, err := imagesQuery(image.ProductID)
+	if err != nil {
+		return err
+	}
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockProductImages(ctx, tx, image.ProductID); err != nil {
+			return err
+		}
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+		current, err := collectImages(rows)
+		if err != nil {
+			return err
+		}
+		before := make(map[int64]int, len(current))
+		for _, img := range current {
+			before[img.ID] = img.Position
+		}
+		reordered, err := domain.ReorderImages(current, image.ID, image.Position)
+		if err != nil {
+			return err
+		}
+		for _, img := range reordered {
+			if img.ID == image.ID {
+				image.Position = img.Position
+				continue
+			}
+			if before[img.ID] != img.Position {
+				if _, err := tx.Exec(ctx, "UPDATE product_images SET position=$1, updated_at=now() WHERE id=$2", img.Position, img.ID); err != nil {
+					return err
+				}
+			}
+		}
+		return tx.QueryRow(ctx, "UPDATE product_images SET position=$1, alt_text=$2, updated_at=now() WHERE id=$3 RETURNING updated_at",
+			image.Position, image.AltText, image.ID).Scan(&image.UpdatedAt)
+	})
+}
+
+func (r *PGImageRepo) DeleteImage(ctx context.Context, productID, imageID int64) (*domain.ProductImage, error) {
+	sql, args, err := psql.Delete("product_images").
+		Where(squirrel.Eq{"id": imageID, "product_id": productID}).
+		Suffix("RETURNING " + strings.Join(imageColumns, ", ")).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var img domain.ProductImage
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockProductImages(ctx, tx, productID); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(ctx, sql, args...).Scan(imageDest(&img)...); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+		_, err := tx.Exec(ctx, "UPDATE product_images SET position=position-1 WHERE product_id=$1 AND position > $2", productID, img.Position)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &img, nil
+}
+
+// lockProductImages serializes image writes per product by locking the product row, which also
+// turns a product deleted in the meantime into domain.ErrNotFound.
+func lockProductImages(ctx context.Context, tx pgx.Tx, productID int64) error {
+	var id int64
+	err := tx.QueryRow(ctx, "SELECT id FROM products WHERE id=$1 FOR NO KEY UPDATE", productID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+	return err
+}
+
+// imagesQuery selects a product's images by position.
+func imagesQuery(productID int64) (string, []any, error) {
+	return psql.Select(imageColumns...).From("product_images").
+		Where(squirrel.Eq{"product_id": productID}).OrderBy("position").ToSql()
+}
+
+func collectImages(rows pgx.Rows) ([]domain.ProductImage, error) {
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ProductImage, error) {
+		var img domain.ProductImage
+		err := row.Scan(imageDest(&img)...)
+		return img, err
+	})
+}
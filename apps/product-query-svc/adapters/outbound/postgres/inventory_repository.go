@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGInventoryRepo struct{ pool *pgxpool.Pool }
+
+var _ outbound.InventoryRepository = (*PGInventoryRepo)(nil)
+
+// stockItemWhere matches one stock level through the stock_levels_item_uidx expression of
+// migration 000017; $1 is the product id and $2 the variant id, or 0 for the product itself.
+const stockItemWhere = "product_id=$1 AND COALESCE(variant_id, 0)=$2"
+
+// reservationColumns is the select list scanned into domain.Reservation, in scan order.
+const reservationColumns = "id, product_id, variant_id, quantity, status, created_at, updated_at"
+
+// reservationDest returns the scan targets for reservationColumns.
+func reservationDest(res *domain.Reservation) []any {
+	return []any{&res.ID, &res.ProductID, &res.VariantID, &res.Quantity, &res.Status, &res.CreatedAt, &res.UpdatedAt}
+}
+
+func NewInventoryRepository(pool *pgxpool.Pool) outbound.InventoryRepository {
+	return &PGInventoryRepo{pool: pool}
+}
+
+func (r *PGInventoryRepo) ListStock(ctx context.Context, productID int64) ([]domain.StockLevel, error) {
+	rows, err := r.pool.Query(ctx, "SELECT product_id, variant_id, on_hand, reserved, updated_at FROM stock_levels WHERE product_id=$1 ORDER BY COALESCE(variant_id, 0)", productID)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.StockLevel, error) {
+		var s domain.StockLevel
+		err := row.Scan(&s.ProductID, &s.VariantID, &s.OnHand, &s.Reserved, &s.UpdatedAt)
+		return s, err
+	})
+}
+
+// AdjustStock creates the level on first use, then locks it so the reserved quantity it is
+// checked against cannot change before the update.
+func (r *PGInventoryRepo) AdjustStock(ctx context.Context, productID int64, variantID *int64, delta int64) (*domain.StockLevel, error) {
+	level := &domain.StockLevel{ProductID: productID, VariantID: variantID}
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "INSERT INTO stock_levels (product_id, variant_id) VALUES ($1, $2) ON CONFLICT (product_id, COALESCE(variant_id, 0)) DO NOTHING", productID, variantID); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(ctx, "SELECT on_hand, reserved FROM stock_levels WHERE "+stockItemWhere+" FOR UPDATE", productID, stockVariantKey(variantID)).
+			Scan(&level.OnHand, &level.Reserved); err != nil {
+			return err
+		}
+		if err := level.Adjust(delta); err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, "UPDATE stock_levels SET on_hand=$3, updated_at=now() WHERE "+stockItemWhere+" RETURNING updated_at", productID, stockVariantKey(variantID), level.OnHand).
+			Scan(&level.UpdatedAt)
+	})
+	if err != nil {
+		return nil, inventoryWriteError(err)
+	}
+	return level, nil
+}
+
+// Reserve holds the quantity with a single conditional update, so concurrent reservations of the
+// same stock serialize on its row and the last one to find too little available fails.
+func (r *PGInventoryRepo) Reserve(ctx context.Context, reservation *domain.Reservation) error {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		key := stockVariantKey(reservation.VariantID)
+		tag, err := tx.Exec(ctx, "UPDATE stock_levels SET reserved=reserved+$3, updated_at=now() WHERE "+stockItemWhere+" AND on_hand-reserved >= $3",
+			reservation.ProductID, key, reservation.Quantity)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			var available int64
+			if err := tx.QueryRow(ctx, "SELECT COALESCE(MAX(on_hand-reserved), 0) FROM stock_levels WHERE "+stockItemWhere, reservation.ProductID, key).Scan(&available); err != nil {
+				return err
+			}
+			return domain.InsufficientStockError(available)
+		}
+		return tx.QueryRow(ctx, "INSERT INTO stock_reservations (product_id, variant_id, quantity) VALUES ($1, $2, $3) RETURNING "+reservationColumns,
+			reservation.ProductID, reservation.VariantID, reservation.Quantity).Scan(reservationDest(reservation)...)
+	})
+	return inventoryWriteError(err)
+}
+
+func (r *PGInventoryRepo) GetReservation(ctx context.Context, id int64) (*domain.Reservation, error) {
+	var res domain.Reservation
+	if err := r.pool.QueryRow(ctx, "SELECT "+reservationColumns+" FROM stock_reservations WHERE id=$1", id).Scan(reservationDest(&res)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *PGInventoryRepo) CommitReservation(ctx context.Context, id int64) (*domain.Reservation, error) {
+	return r.settleReservation(ctx, id, (*domain.Reservation).Commit, "on_hand=on_hand-$3, reserved=reserved-$3")
+}
+
+func (r *PGInventoryRepo) ReleaseReservation(ctx context.Context, id int64) (*domain.Reservation, error) {
+	return r.settleReservation(ctx, id, (*domain.Reservation).Release, "reserved=reserved-$3")
+}
+
+// settleReservation locks the reservation, applies the domain transition and moves its quantity
+// out of the stock level with the given SET clause, all in one transaction.
+func (r *PGInventoryRepo) settleReservation(ctx context.Context, id int64, transition func(*domain.Reservation) error, set string) (*domain.Reservation, error) {
+	var res domain.Reservation
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, "SELECT "+reservationColumns+" FROM stock_reservations WHERE id=$1 FOR UPDATE", id).Scan(reservationDest(&res)...); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+		if err := transition(&res); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, "UPDATE stock_levels SET "+set+", updated_at=now() WHERE "+stockItemWhere, res.ProductID, stockVariantKey(res.VariantID), res.Quantity); err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, "UPDATE stock_reservations SET status=$1, updated_at=now() WHERE id=$2 RETURNING updated_at", res.Status, id).Scan(&res.UpdatedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// stockVariantKey returns the variant part of the stock_levels_item_uidx key.
+func stockVariantKey(variantID *int64) int64 {
+	if variantID == nil {
+		return 0
+	}
+	return *variantID
+}
+
+// inventoryWriteError reports a product or variant deleted mid-write as not found.
+func inventoryWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+		return domain.ErrNotFound
+	}
+	return err
+}
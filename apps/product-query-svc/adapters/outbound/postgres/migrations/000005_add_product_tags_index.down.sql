@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS products_tags_lower_gin_idx;
+DROP FUNCTION IF EXISTS product_tags_lower(TEXT[]);
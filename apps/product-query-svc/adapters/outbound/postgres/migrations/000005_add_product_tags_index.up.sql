@@ -0,0 +1,7 @@
+-- Lower-cased view of products.tags so tag filters stay case-insensitive
+-- (mirrors domain.Product tag de-duplication) while still using a GIN index.
+CREATE OR REPLACE FUNCTION product_tags_lower(tags TEXT[]) RETURNS TEXT[]
+  LANGUAGE sql IMMUTABLE PARALLEL SAFE
+  AS $$ SELECT COALESCE(array_agg(lower(t)), '{}') FROM unnest(tags) AS t $$;
+
+CREATE INDEX IF NOT EXISTS products_tags_lower_gin_idx ON products USING GIN (product_tags_lower(tags));
@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS comments_product_id_created_at_idx ON comments(product_id, created_at DESC);
+DROP INDEX IF EXISTS comments_product_id_created_at_id_idx;
+DROP INDEX IF EXISTS products_name_c_id_idx;
+DROP INDEX IF EXISTS products_price_id_idx;
@@ -0,0 +1,6 @@
+-- Composite indexes matching the keyset ORDER BY clauses so cursor pages
+-- are served by an index range scan instead of sort + offset.
+CREATE INDEX IF NOT EXISTS products_price_id_idx ON products(price, id);
+CREATE INDEX IF NOT EXISTS products_name_c_id_idx ON products((name COLLATE "C"), id);
+CREATE INDEX IF NOT EXISTS comments_product_id_created_at_id_idx ON comments(product_id, created_at DESC, id DESC);
+DROP INDEX IF EXISTS comments_product_id_created_at_idx;
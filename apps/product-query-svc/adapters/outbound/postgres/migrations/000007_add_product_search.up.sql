@@ -0,0 +1,10 @@
+-- Full-text + fuzzy product search used by sort=relevance.
+-- search_vector gives stemmed matching ranked by ts_rank; pg_trgm covers misspellings.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE products
+  ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+  GENERATED ALWAYS AS (to_tsvector('english', coalesce(name, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS products_search_vector_idx ON products USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS products_name_trgm_idx ON products USING GIN (name gin_trgm_ops);
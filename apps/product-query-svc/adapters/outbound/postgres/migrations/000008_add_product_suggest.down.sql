@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS products_tag_counts_sync ON products;
+DROP FUNCTION IF EXISTS product_tag_counts_sync();
+DROP TABLE IF EXISTS product_tag_counts;
+DROP INDEX IF EXISTS products_name_lower_prefix_idx;
@@ -0,0 +1,41 @@
+-- Prefix lookups for GET /products/suggest.
+-- text_pattern_ops lets `lower(name) LIKE 'abc%'` use a btree range scan regardless of collation.
+CREATE INDEX IF NOT EXISTS products_name_lower_prefix_idx ON products (lower(name) text_pattern_ops);
+
+-- Array elements cannot be prefix-indexed directly, so keep a per-tag product count
+-- (lower-cased, like product_tags_lower) maintained by a trigger on products.
+CREATE TABLE IF NOT EXISTS product_tag_counts (
+  tag TEXT COLLATE "C" PRIMARY KEY,
+  product_count INTEGER NOT NULL CHECK (product_count >= 0)
+);
+
+CREATE OR REPLACE FUNCTION product_tag_counts_sync() RETURNS trigger
+  LANGUAGE plpgsql AS $$
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    UPDATE product_tag_counts AS c SET product_count = c.product_count - 1
+      FROM unnest(product_tags_lower(OLD.tags)) AS t(tag)
+      WHERE c.tag = t.tag;
+  END IF;
+  IF TG_OP IN ('INSERT', 'UPDATE') THEN
+    INSERT INTO product_tag_counts AS c (tag, product_count)
+      SELECT DISTINCT t.tag, 1 FROM unnest(product_tags_lower(NEW.tags)) AS t(tag)
+      ON CONFLICT (tag) DO UPDATE SET product_count = c.product_count + 1;
+  END IF;
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    DELETE FROM product_tag_counts
+      WHERE product_count = 0 AND tag = ANY (product_tags_lower(OLD.tags));
+  END IF;
+  RETURN NULL;
+END $$;
+
+DROP TRIGGER IF EXISTS products_tag_counts_sync ON products;
+CREATE TRIGGER products_tag_counts_sync
+  AFTER INSERT OR DELETE OR UPDATE OF tags ON products
+  FOR EACH ROW EXECUTE FUNCTION product_tag_counts_sync();
+
+INSERT INTO product_tag_counts (tag, product_count)
+  SELECT t.tag, COUNT(DISTINCT products.id)
+  FROM products CROSS JOIN LATERAL unnest(product_tags_lower(products.tags)) AS t(tag)
+  GROUP BY t.tag
+ON CONFLICT (tag) DO NOTHING;
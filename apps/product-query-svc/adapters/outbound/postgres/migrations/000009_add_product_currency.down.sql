@@ -0,0 +1,2 @@
+ALTER TABLE products DROP COLUMN IF EXISTS price_overrides;
+ALTER TABLE products DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,9 @@
+-- Multi-currency pricing: price is kept in minor units of currency, and price_overrides maps
+-- ISO-4217 codes to fixed minor-unit prices that win over exchange-rate conversion.
+ALTER TABLE products
+  ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'USD'
+  CONSTRAINT products_currency_iso CHECK (currency ~ '^[A-Z]{3}$');
+
+ALTER TABLE products
+  ADD COLUMN IF NOT EXISTS price_overrides JSONB NOT NULL DEFAULT '{}'
+  CONSTRAINT products_price_overrides_object CHECK (jsonb_typeof(price_overrides) = 'object');
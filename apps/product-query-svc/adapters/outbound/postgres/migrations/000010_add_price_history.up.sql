@@ -0,0 +1,21 @@
+-- Price audit trail: one applied row per price/currency change, plus pending rows for
+-- scheduled prices that the service's scheduler applies once effective_from has passed.
+CREATE TABLE IF NOT EXISTS price_history (
+  id BIGSERIAL PRIMARY KEY,
+  product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+  price BIGINT NOT NULL CHECK (price >= 0),
+  currency TEXT NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+  effective_from TIMESTAMPTZ NOT NULL,
+  status TEXT NOT NULL CHECK (status IN ('pending', 'applied', 'skipped')),
+  applied_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS price_history_product_idx ON price_history (product_id, effective_from, id);
+-- The scheduler only ever scans pending rows.
+CREATE INDEX IF NOT EXISTS price_history_due_idx ON price_history (effective_from, id) WHERE status = 'pending';
+
+-- Existing prices become the first history entry.
+INSERT INTO price_history (product_id, price, currency, effective_from, status, applied_at)
+  SELECT p.id, p.price, p.currency, now(), 'applied', now() FROM products p
+  WHERE NOT EXISTS (SELECT 1 FROM price_history h WHERE h.product_id = p.id);
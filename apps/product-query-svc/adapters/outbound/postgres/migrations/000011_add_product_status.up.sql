@@ -0,0 +1,53 @@
+-- Product lifecycle: draft -> published -> archived (restore goes back to draft).
+-- Existing rows were already visible, so they are backfilled as published; new rows default to draft.
+ALTER TABLE products
+  ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'published'
+  CONSTRAINT products_status_known CHECK (status IN ('draft', 'published', 'archived'));
+ALTER TABLE products ALTER COLUMN status SET DEFAULT 'draft';
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+UPDATE products SET published_at = now() WHERE status = 'published' AND published_at IS NULL;
+
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_published_at_set;
+ALTER TABLE products
+  ADD CONSTRAINT products_published_at_set CHECK (status <> 'published' OR published_at IS NOT NULL);
+
+-- Search defaults to published products, so filter on status cheaply.
+CREATE INDEX IF NOT EXISTS products_status_idx ON products (status);
+
+-- Tag suggestions only cover published products: count a row's tags while it is published.
+CREATE OR REPLACE FUNCTION product_tag_counts_sync() RETURNS trigger
+  LANGUAGE plpgsql AS $$
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    IF OLD.status = 'published' THEN
+      UPDATE product_tag_counts AS c SET product_count = c.product_count - 1
+        FROM unnest(product_tags_lower(OLD.tags)) AS t(tag)
+        WHERE c.tag = t.tag;
+    END IF;
+  END IF;
+  IF TG_OP IN ('INSERT', 'UPDATE') THEN
+    IF NEW.status = 'published' THEN
+      INSERT INTO product_tag_counts AS c (tag, product_count)
+        SELECT DISTINCT t.tag, 1 FROM unnest(product_tags_lower(NEW.tags)) AS t(tag)
+        ON CONFLICT (tag) DO UPDATE SET product_count = c.product_count + 1;
+    END IF;
+  END IF;
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    DELETE FROM product_tag_counts
+      WHERE product_count = 0 AND tag = ANY (product_tags_lower(OLD.tags));
+  END IF;
+  RETURN NULL;
+END $$;
+
+DROP TRIGGER IF EXISTS products_tag_counts_sync ON products;
+CREATE TRIGGER products_tag_counts_sync
+  AFTER INSERT OR DELETE OR UPDATE OF tags, status ON products
+  FOR EACH ROW EXECUTE FUNCTION product_tag_counts_sync();
+
+DELETE FROM product_tag_counts;
+INSERT INTO product_tag_counts (tag, product_count)
+  SELECT t.tag, COUNT(DISTINCT products.id)
+  FROM products CROSS JOIN LATERAL unnest(product_tags_lower(products.tags)) AS t(tag)
+  WHERE products.status = 'published'
+  GROUP BY t.tag;
@@ -0,0 +1,34 @@
+-- Trashed rows would reappear as live products, so drop them like the old hard delete did.
+DELETE FROM products WHERE deleted_at IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION product_tag_counts_sync() RETURNS trigger
+  LANGUAGE plpgsql AS $$
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    IF OLD.status = 'published' THEN
+      UPDATE product_tag_counts AS c SET product_count = c.product_count - 1
+        FROM unnest(product_tags_lower(OLD.tags)) AS t(tag)
+        WHERE c.tag = t.tag;
+    END IF;
+  END IF;
+  IF TG_OP IN ('INSERT', 'UPDATE') THEN
+    IF NEW.status = 'published' THEN
+      INSERT INTO product_tag_counts AS c (tag, product_count)
+        SELECT DISTINCT t.tag, 1 FROM unnest(product_tags_lower(NEW.tags)) AS t(tag)
+        ON CONFLICT (tag) DO UPDATE SET product_count = c.product_count + 1;
+    END IF;
+  END IF;
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    DELETE FROM product_tag_counts
+      WHERE product_count = 0 AND tag = ANY (product_tags_lower(OLD.tags));
+  END IF;
+  RETURN NULL;
+END $$;
+
+DROP TRIGGER IF EXISTS products_tag_counts_sync ON products;
+CREATE TRIGGER products_tag_counts_sync
+  AFTER INSERT OR DELETE OR UPDATE OF tags, status ON products
+  FOR EACH ROW EXECUTE FUNCTION product_tag_counts_sync();
+
+DROP INDEX IF EXISTS products_deleted_at_idx;
+ALTER TABLE products DROP COLUMN IF EXISTS deleted_at;
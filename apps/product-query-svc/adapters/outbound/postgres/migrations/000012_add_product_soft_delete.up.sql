@@ -0,0 +1,36 @@
+-- Soft delete: DELETE /products/{id} only stamps deleted_at, so comments (ON DELETE CASCADE) and
+-- price history survive until the purge job hard-deletes rows past the retention period.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- The trash listing and the purge job only ever look at trashed rows.
+CREATE INDEX IF NOT EXISTS products_deleted_at_idx ON products (deleted_at, id) WHERE deleted_at IS NOT NULL;
+
+-- Tag suggestions skip trashed products: count a row's tags while it is published and not deleted.
+CREATE OR REPLACE FUNCTION product_tag_counts_sync() RETURNS trigger
+  LANGUAGE plpgsql AS $$
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    IF OLD.status = 'published' AND OLD.deleted_at IS NULL THEN
+      UPDATE product_tag_counts AS c SET product_count = c.product_count - 1
+        FROM unnest(product_tags_lower(OLD.tags)) AS t(tag)
+        WHERE c.tag = t.tag;
+    END IF;
+  END IF;
+  IF TG_OP IN ('INSERT', 'UPDATE') THEN
+    IF NEW.status = 'published' AND NEW.deleted_at IS NULL THEN
+      INSERT INTO product_tag_counts AS c (tag, product_count)
+        SELECT DISTINCT t.tag, 1 FROM unnest(product_tags_lower(NEW.tags)) AS t(tag)
+        ON CONFLICT (tag) DO UPDATE SET product_count = c.product_count + 1;
+    END IF;
+  END IF;
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    DELETE FROM product_tag_counts
+      WHERE product_count = 0 AND tag = ANY (product_tags_lower(OLD.tags));
+  END IF;
+  RETURN NULL;
+END $$;
+
+DROP TRIGGER IF EXISTS products_tag_counts_sync ON products;
+CREATE TRIGGER products_tag_counts_sync
+  AFTER INSERT OR DELETE OR UPDATE OF tags, status, deleted_at ON products
+  FOR EACH ROW EXECUTE FUNCTION product_tag_counts_sync();
@@ -0,0 +1,2 @@
+ALTER TABLE comments DROP COLUMN IF EXISTS version;
+ALTER TABLE products DROP COLUMN IF EXISTS version;
@@ -0,0 +1,3 @@
+-- Optimistic concurrency: every write bumps version and If-Match requests must name the current one.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+-- Backs Last-Modified / If-Modified-Since; every write to a product stamps it.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
@@ -0,0 +1,4 @@
+DROP FUNCTION IF EXISTS category_path(BIGINT);
+DROP FUNCTION IF EXISTS category_subtree(BIGINT);
+ALTER TABLE products DROP COLUMN IF EXISTS category_id;
+DROP TABLE IF EXISTS categories;
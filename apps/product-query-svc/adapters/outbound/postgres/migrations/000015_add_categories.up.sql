@@ -0,0 +1,42 @@
+-- Category tree stored as an adjacency list; names are unique per parent, case-insensitively.
+CREATE TABLE IF NOT EXISTS categories (
+    id          BIGSERIAL PRIMARY KEY,
+    name        TEXT NOT NULL,
+    parent_id   BIGINT REFERENCES categories(id) ON DELETE RESTRICT,
+    version     BIGINT NOT NULL DEFAULT 1,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (parent_id <> id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS categories_parent_name_uidx ON categories (COALESCE(parent_id, 0), lower(name));
+CREATE INDEX IF NOT EXISTS categories_parent_id_idx ON categories (parent_id);
+
+-- A product belongs to at most one category; categories with products (trashed ones included) cannot be deleted.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS category_id BIGINT REFERENCES categories(id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS products_category_id_idx ON products (category_id);
+
+-- Ids of a category and all of its descendants, for the "products in category" filter.
+-- UNION (not UNION ALL) keeps the walk finite even if a cycle ever slipped in.
+CREATE OR REPLACE FUNCTION category_subtree(root BIGINT) RETURNS TABLE (id BIGINT)
+  LANGUAGE sql STABLE PARALLEL SAFE
+  AS $$
+    WITH RECURSIVE sub(id) AS (
+        SELECT c.id FROM categories c WHERE c.id = root
+        UNION
+        SELECT c.id FROM categories c JOIN sub ON c.parent_id = sub.id
+    )
+    SELECT sub.id FROM sub
+  $$;
+
+-- Breadcrumb of a category from the top level down, as a JSON array of {id, name}; NULL for NULL.
+CREATE OR REPLACE FUNCTION category_path(leaf BIGINT) RETURNS JSONB
+  LANGUAGE sql STABLE PARALLEL SAFE
+  AS $$
+    WITH RECURSIVE up(id, name, parent_id, depth) AS (
+        SELECT c.id, c.name, c.parent_id, 0 FROM categories c WHERE c.id = leaf
+        UNION ALL
+        SELECT c.id, c.name, c.parent_id, up.depth + 1 FROM categories c JOIN up ON c.id = up.parent_id
+        WHERE up.depth < 100
+    )
+    SELECT jsonb_agg(jsonb_build_object('id', up.id, 'name', up.name) ORDER BY up.depth DESC) FROM up
+  $$;
@@ -0,0 +1,15 @@
+-- Sellable variants of a product (size, colour, ...). SKUs are stored upper-cased and unique
+-- across all products; a product cannot have two variants with the same attributes.
+CREATE TABLE IF NOT EXISTS product_variants (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    sku         TEXT NOT NULL,
+    attributes  JSONB NOT NULL DEFAULT '{}'::jsonb,
+    price_cents BIGINT CHECK (price_cents >= 0),
+    version     BIGINT NOT NULL DEFAULT 1,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS product_variants_sku_uidx ON product_variants (sku);
+CREATE UNIQUE INDEX IF NOT EXISTS product_variants_attributes_uidx ON product_variants (product_id, attributes);
@@ -0,0 +1,3 @@
+DROP FUNCTION IF EXISTS product_in_stock(BIGINT);
+DROP TABLE IF EXISTS stock_reservations;
+DROP TABLE IF EXISTS stock_levels;
@@ -0,0 +1,32 @@
+-- Stock per product (variant_id NULL) or per variant. reserved is held by pending reservations
+-- and can never exceed what is on hand.
+CREATE TABLE IF NOT EXISTS stock_levels (
+    product_id  BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    variant_id  BIGINT REFERENCES product_variants(id) ON DELETE CASCADE,
+    on_hand     BIGINT NOT NULL DEFAULT 0,
+    reserved    BIGINT NOT NULL DEFAULT 0,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT stock_levels_reserved_check CHECK (reserved >= 0 AND on_hand >= reserved)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS stock_levels_item_uidx ON stock_levels (product_id, COALESCE(variant_id, 0));
+
+-- Reservations move from pending to committed (stock shipped) or released (stock returned), once.
+CREATE TABLE IF NOT EXISTS stock_reservations (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    variant_id  BIGINT REFERENCES product_variants(id) ON DELETE CASCADE,
+    quantity    BIGINT NOT NULL CHECK (quantity > 0),
+    status      TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'committed', 'released')),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS stock_reservations_product_id_idx ON stock_reservations (product_id);
+
+-- Whether a product or any of its variants has stock left to reserve, for the inStock flag.
+CREATE OR REPLACE FUNCTION product_in_stock(pid BIGINT) RETURNS BOOLEAN
+  LANGUAGE sql STABLE PARALLEL SAFE
+  AS $$
+    SELECT EXISTS (SELECT 1 FROM stock_levels s WHERE s.product_id = pid AND s.on_hand > s.reserved)
+  $$;
@@ -0,0 +1,15 @@
+-- Image metadata; the content lives in the blob store under blob_key. Positions run from 0 per
+-- product; their unique constraint is deferred so a reorder can shift them within a transaction.
+CREATE TABLE IF NOT EXISTS product_images (
+    id           BIGSERIAL PRIMARY KEY,
+    product_id   BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    position     INT NOT NULL CHECK (position >= 0),
+    alt_text     TEXT NOT NULL DEFAULT '',
+    content_type TEXT NOT NULL,
+    size_bytes   BIGINT NOT NULL CHECK (size_bytes > 0),
+    checksum     TEXT NOT NULL,
+    blob_key     TEXT NOT NULL UNIQUE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT product_images_position_key UNIQUE (product_id, position) DEFERRABLE INITIALLY DEFERRED
+);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS orders_product_name_lower_idx;
@@ -0,0 +1,3 @@
+-- Orders name their product rather than referencing it; related-product recommendations join
+-- them to products case-insensitively by name, and find a buyer's other orders by user_id.
+CREATE INDEX IF NOT EXISTS orders_product_name_lower_idx ON orders (lower(product_name), user_id);
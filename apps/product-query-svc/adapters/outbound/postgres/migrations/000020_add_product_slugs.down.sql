@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS product_slugs;
+ALTER TABLE products DROP COLUMN IF EXISTS slug;
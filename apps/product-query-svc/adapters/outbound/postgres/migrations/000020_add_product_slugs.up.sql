@@ -0,0 +1,40 @@
+-- Slugs are assigned by the application from the product name (domain.Slugify). product_slugs keeps
+-- every slug a product has held, the current one included, so its primary key stops a slug from
+-- ever moving to another product and old slugs keep resolving after a rename. The foreign key is
+-- deferred so a new product's slug can be claimed before the product row is inserted.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS slug TEXT;
+
+CREATE TABLE IF NOT EXISTS product_slugs (
+    slug       TEXT PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE DEFERRABLE INITIALLY DEFERRED,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS product_slugs_product_id_idx ON product_slugs (product_id);
+
+-- Backfill existing products in id order, following domain.Slugify and domain.SlugCandidate.
+DO $$
+DECLARE
+    p         RECORD;
+    base      TEXT;
+    candidate TEXT;
+    n         INT;
+BEGIN
+    FOR p IN SELECT id, name FROM products WHERE slug IS NULL ORDER BY id LOOP
+        base := rtrim(left(trim(both '-' FROM regexp_replace(lower(p.name), '[^a-z0-9]+', '-', 'g')), 80), '-');
+        IF base = '' THEN
+            base := 'product';
+        END IF;
+        candidate := base;
+        n := 1;
+        WHILE EXISTS (SELECT 1 FROM product_slugs WHERE slug = candidate) LOOP
+            n := n + 1;
+            candidate := rtrim(left(base, 79 - length(n::text)), '-') || '-' || n;
+        END LOOP;
+        INSERT INTO product_slugs (slug, product_id) VALUES (candidate, p.id);
+        UPDATE products SET slug = candidate WHERE id = p.id;
+    END LOOP;
+END $$;
+
+ALTER TABLE products ALTER COLUMN slug SET NOT NULL;
+ALTER TABLE products ADD CONSTRAINT products_slug_key UNIQUE (slug);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS product_translations;
+ALTER TABLE products DROP COLUMN IF EXISTS description;
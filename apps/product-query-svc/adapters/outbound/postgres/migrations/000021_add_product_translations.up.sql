@@ -0,0 +1,14 @@
+-- products.name and products.description hold the text in domain.DefaultLocale; translations into
+-- other locales live in product_translations, at most one per product and locale. Locales are
+-- stored normalized by domain.NormalizeLocale, so equality is enough to look them up.
+ALTER TABLE products ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS product_translations (
+    product_id  BIGINT NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    locale      TEXT NOT NULL,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (product_id, locale)
+);
+
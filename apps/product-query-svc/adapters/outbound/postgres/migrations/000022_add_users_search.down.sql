@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS users_created_at_idx;
+DROP INDEX IF EXISTS users_email_trgm_idx;
+DROP INDEX IF EXISTS users_name_trgm_idx;
@@ -0,0 +1,5 @@
+-- GET /users: case-insensitive substring search on name and email, plus created_at ranges and ordering.
+-- pg_trgm (migration 000007) serves ILIKE '%q%'; email is indexed as text so the same operator applies.
+CREATE INDEX IF NOT EXISTS users_name_trgm_idx ON users USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS users_email_trgm_idx ON users USING GIN ((email::text) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS users_created_at_idx ON users (created_at, id);
@@ -0,0 +1,5 @@
+-- Orders are financial records: deleting a user who still has orders is refused instead of
+-- cascading. Comments keep their ON DELETE CASCADE.
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_user_id_fkey;
+ALTER TABLE orders ADD CONSTRAINT orders_user_id_fkey
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE RESTRICT;
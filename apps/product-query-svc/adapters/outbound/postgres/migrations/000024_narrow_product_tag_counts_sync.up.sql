@@ -0,0 +1,50 @@
+-- Product writes always SET tags, so the old trigger rewrote the shared counter rows of every tag
+-- on any write, serialising concurrent writers on popular tags. Only fire when something the count
+-- depends on changed, and only touch tags that enter or leave the count, one row at a time in tag
+-- order so concurrent writers queue instead of deadlocking.
+CREATE OR REPLACE FUNCTION product_tag_counts_sync() RETURNS trigger
+  LANGUAGE plpgsql AS $$
+DECLARE
+  counted_before TEXT[] := '{}';
+  counted_after TEXT[] := '{}';
+  changed TEXT;
+BEGIN
+  IF TG_OP IN ('UPDATE', 'DELETE') THEN
+    IF OLD.status = 'published' AND OLD.deleted_at IS NULL THEN
+      counted_before := product_tags_lower(OLD.tags);
+    END IF;
+  END IF;
+  IF TG_OP IN ('INSERT', 'UPDATE') THEN
+    IF NEW.status = 'published' AND NEW.deleted_at IS NULL THEN
+      counted_after := product_tags_lower(NEW.tags);
+    END IF;
+  END IF;
+  FOR changed IN
+    SELECT t.tag FROM unnest(counted_before || counted_after) AS t(tag)
+    GROUP BY t.tag
+    HAVING (t.tag = ANY (counted_before)) <> (t.tag = ANY (counted_after))
+    ORDER BY t.tag COLLATE "C"
+  LOOP
+    IF changed = ANY (counted_after) THEN
+      INSERT INTO product_tag_counts AS c (tag, product_count) VALUES (changed, 1)
+        ON CONFLICT (tag) DO UPDATE SET product_count = c.product_count + 1;
+    ELSE
+      UPDATE product_tag_counts SET product_count = product_count - 1 WHERE tag = changed;
+      DELETE FROM product_tag_counts WHERE tag = changed AND product_count = 0;
+    END IF;
+  END LOOP;
+  RETURN NULL;
+END $$;
+
+-- WHEN cannot refer to OLD on INSERT, so updates get a trigger of their own.
+DROP TRIGGER IF EXISTS products_tag_counts_sync ON products;
+CREATE TRIGGER products_tag_counts_sync
+  AFTER INSERT OR DELETE ON products
+  FOR EACH ROW EXECUTE FUNCTION product_tag_counts_sync();
+
+DROP TRIGGER IF EXISTS products_tag_counts_sync_update ON products;
+CREATE TRIGGER products_tag_counts_sync_update
+  AFTER UPDATE OF tags, status, deleted_at ON products
+  FOR EACH ROW
+  WHEN (OLD.tags IS DISTINCT FROM NEW.tags OR OLD.status IS DISTINCT FROM NEW.status OR OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+  EXECUTE FUNCTION product_tag_counts_sync();
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// priceChangeColumns is the select list scanned by scanPriceChange, qualified by table.
+func priceChangeColumns(table string) []string {
+	cols := []string{"id", "product_id", "price", "currency", "effective_from", "status", "applied_at", "created_at"}
+	for i, c := range cols {
+		cols[i] = table + "." + c
+	}
+	return cols
+}
+
+// scanPriceChange reads priceChangeColumns, followed by any extra destinations.
+func scanPriceChange(row pgx.Row, extra ...any) (domain.PriceChange, error) {
+	var c domain.PriceChange
+	var status string
+	dest := append([]any{&c.ID, &c.ProductID, &c.Price, &c.Currency, &c.EffectiveFrom, &status, &c.AppliedAt, &c.CreatedAt}, extra...)
+	err := row.Scan(dest...)
+	c.Status = domain.PriceChangeStatus(status)
+	c.EffectiveFrom = c.EffectiveFrom.UTC()
+	c.CreatedAt = c.CreatedAt.UTC()
+	if c.AppliedAt != nil {
+		at := c.AppliedAt.UTC()
+		c.AppliedAt = &at
+	}
+	return c, err
+}
+
+// insertPriceChange writes a history row inside the caller's transaction.
+func insertPriceChange(ctx context.Context, tx pgx.Tx, c domain.PriceChange) (int64, error) {
+	sql, args, err := psql.Insert("price_history").
+		Columns("product_id", "price", "currency", "effective_from", "status", "applied_at", "created_at").
+		Values(c.ProductID, c.Price, c.Currency, c.EffectiveFrom, string(c.Status), c.AppliedAt, c.CreatedAt).
+		Suffix("RETURNING id").ToSql()
+	if err != nil {
+		return 0, err
+	}
+	var id int64
+	err = tx.QueryRow(ctx, sql, args...).Scan(&id)
+	return id, err
+}
+
+func (r *PGProductRepo) SchedulePriceChange(ctx context.Context, change *domain.PriceChange) (int64, error) {
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		// lock the product so a concurrent delete cannot orphan the row
+		var one int
+		if err := tx.QueryRow(ctx, "SELECT 1 FROM products WHERE id=$1 AND deleted_at IS NULL FOR SHARE", change.ProductID).Scan(&one); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+		id, err := insertPriceChange(ctx, tx, *change)
+		change.ID = id
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return change.ID, nil
+}
+
+func (r *PGProductRepo) ListPriceHistory(ctx context.Context, productID int64) ([]domain.PriceChange, error) {
+	var exists bool
+	if err := r.pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM products WHERE id=$1 AND deleted_at IS NULL)", productID).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrNotFound
+	}
+
+	sql, args, err := psql.Select(priceChangeColumns("price_history")...).From("price_history").
+		Where(squirrel.Eq{"product_id": productID}).
+		OrderBy("effective_from", "id").ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []domain.PriceChange{}
+	for rows.Next() {
+		c, err := scanPriceChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (r *PGProductRepo) ApplyDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]domain.PriceChange, error) {
+	var out []domain.PriceChange
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		// SKIP LOCKED lets several replicas run the scheduler without applying a change twice.
+		sql, args, err := psql.Select(priceChangeColumns("h")...).Column("p.currency").
+			From("price_history h").
+			Join("products p ON p.id = h.product_id").
+			Where(squirrel.Eq{"h.status": string(domain.PriceChangePending), "p.deleted_at": nil}).
+			Where(squirrel.LtOrEq{"h.effective_from": now}).
+			OrderBy("h.effective_from", "h.id").
+			Limit(uint64(limit)).
+			Suffix("FOR UPDATE OF h, p SKIP LOCKED").ToSql()
+		if err != nil {
+			return err
+		}
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+		var products []domain.Product
+		for rows.Next() {
+			var p domain.Product
+			c, err := scanPriceChange(rows, &p.Currency)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			p.ID = c.ProductID
+			out = append(out, c)
+			products = append(products, p)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for i := range out {
+			c := &out[i]
+			c.ApplyTo(&products[i], now)
+			if c.Status == domain.PriceChangeApplied {
+				if _, err := tx.Exec(ctx, "UPDATE products SET price=$1, version=version+1, updated_at=$2 WHERE id=$3", c.Price, c.AppliedAt, c.ProductID); err != nil {
+					return err
+				}
+			}
+			if _, err := tx.Exec(ctx, "UPDATE price_history SET status=$1, applied_at=$2 WHERE id=$3", string(c.Status), c.AppliedAt, c.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
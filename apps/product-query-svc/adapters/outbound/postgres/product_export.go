@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// ExportProducts streams matching rows with pgx, which decodes them as they arrive instead of
+// buffering the result. The dump is a single SELECT in a read-only REPEATABLE READ transaction,
+// so it reflects one snapshot however long the client takes to read it.
+func (r *PGProductRepo) ExportProducts(ctx context.Context, criteria domain.ProductSearch, fn func(*domain.Product) error) error {
+	sql, args, err := applyProductFilters(psql.Select(productColumns...).From("products"), criteria).
+		OrderBy("products.id").ToSql()
+	if err != nil {
+		return err
+	}
+	opts := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	return pgx.BeginTxFunc(ctx, r.pool, opts, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var p domain.Product
+			if err := rows.Scan(productDest(&p)...); err != nil {
+				return err
+			}
+			if err := fn(&p); err != nil {
+				return err
+			}
+		}
+		return rows.Err()
+	})
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// importInsertRows caps the rows of one multi-row INSERT so a statement stays well below the
+// 65535 bind parameters Postgres accepts.
+const importInsertRows = 1000
+
+// ImportProducts inserts the new products with multi-row INSERTs, together with their initial
+// price history, and applies the updates as Update does, all in one transaction.
+func (r *PGProductRepo) ImportProducts(ctx context.Context, creates, updates []*domain.Product) error {
+	var created, updated []productStamp
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		created, updated = created[:0], updated[:0]
+		for start := 0; start < len(creates); start += importInsertRows {
+			chunk := creates[start:min(start+importInsertRows, len(creates))]
+			stamps, err := insertProducts(ctx, tx, chunk)
+			if err != nil {
+				return err
+			}
+			created = append(created, stamps...)
+		}
+		for _, p := range updates {
+			stamp, err := updateProduct(ctx, tx, p)
+			if err != nil {
+				return err
+			}
+			updated = append(updated, stamp)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for i, p := range creates {
+		p.ID, p.Slug, p.Version, p.UpdatedAt = created[i].id, created[i].slug, created[i].version, created[i].updatedAt
+	}
+	for i, p := range updates {
+		p.Slug, p.Version, p.UpdatedAt = updated[i].slug, updated[i].version, updated[i].updatedAt
+	}
+	return nil
+}
+
+// insertProducts writes products and their applied price changes with one statement each, after
+// allocating their ids and claiming their slugs. The returned stamps follow the order of products;
+// RETURNING rows are matched by the pre-allocated id, since their order is not guaranteed.
+func insertProducts(ctx context.Context, tx pgx.Tx, products []*domain.Product) ([]productStamp, error) {
+	ids, err := nextProductIDs(ctx, tx, len(products))
+	if err != nil {
+		return nil, err
+	}
+	claims := make([]slugClaim, len(products))
+	for i, p := range products {
+		claims[i] = slugClaim{id: ids[i], name: p.Name}
+	}
+	slugs, err := claimSlugs(ctx, tx, claims)
+	if err != nil {
+		return nil, err
+	}
+	ib := psql.Insert("products").
+		Columns("id", "name", "slug", "description", "price", "currency", "price_overrides", "tags", "category_id", "status", "published_at").
+		Suffix("RETURNING id, slug, version, updated_at")
+	for i, p := range products {
+		ib = ib.Values(ids[i], p.Name, slugs[i], p.Description, p.Price, p.BaseCurrency(), priceOverrides(p), p.Tags, p.CategoryID, string(p.LifecycleStatus()), p.PublishedAt)
+	}
+	sql, args, err := ib.ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	returned, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (productStamp, error) {
+		var s productStamp
+		err := row.Scan(&s.id, &s.slug, &s.version, &s.updatedAt)
+		return s, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]productStamp, len(returned))
+	for _, s := range returned {
+		byID[s.id] = s
+	}
+	stamps := make([]productStamp, len(products))
+	for i, id := range ids {
+		stamp, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("import: no row returned for product %d", id)
+		}
+		stamps[i] = stamp
+	}
+
+	now := time.Now()
+	hb := psql.Insert("price_history").
+		Columns("product_id", "price", "currency", "effective_from", "status", "applied_at", "created_at")
+	for i, p := range products {
+		created := *p
+		created.ID = ids[i]
+		c := domain.AppliedPriceChange(&created, now)
+		hb = hb.Values(c.ProductID, c.Price, c.Currency, c.EffectiveFrom, string(c.Status), c.AppliedAt, c.CreatedAt)
+	}
+	if sql, args, err = hb.ToSql(); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		return nil, err
+	}
+	return stamps, nil
+}
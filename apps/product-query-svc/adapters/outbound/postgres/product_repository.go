@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
@@ -16,6 +17,16 @@ var psql = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
 type PGProductRepo struct{ pool *pgxpool.Pool }
 
+// productColumns is the select list scanned into domain.Product, in scan order. The breadcrumb
+// comes from category_path (migration 000015), so it always reflects the current category tree;
+// likewise the stock flag comes from product_in_stock (migration 000017).
+var productColumns = []string{"id", "name", "slug", "description", "price", "currency", "price_overrides", "tags", "category_id", "category_path(category_id)", "product_in_stock(id)", "status", "published_at", "deleted_at", "version", "updated_at"}
+
+// productDest returns the scan targets for productColumns.
+func productDest(p *domain.Product) []any {
+	return []any{&p.ID, &p.Name, &p.Slug, &p.Description, &p.Price, &p.Currency, &p.PriceOverrides, &p.Tags, &p.CategoryID, &p.Category, &p.InStock, &p.Status, &p.PublishedAt, &p.DeletedAt, &p.Version, &p.UpdatedAt}
+}
+
 var _ outbound.ProductRepository = (*PGProductRepo)(nil)
 
 func NewProductRepository(pool *pgxpool.Pool) outbound.ProductRepository {
@@ -23,103 +34,387 @@ func NewProductRepository(pool *pgxpool.Pool) outbound.ProductRepository {
 }
 
 func (r *PGProductRepo) GetByID(ctx context.Context, id int64) (*domain.Product, error) {
-	q, args, err := psql.Select("id", "name", "price", "tags").From("products").Where(squirrel.Eq{"id": id}).ToSql()
+	q, args, err := psql.Select(productColumns...).From("products").
+		Where(squirrel.Eq{"id": id, "deleted_at": nil}).ToSql()
 	if err != nil {
 		return nil, err
 	}
 	var p domain.Product
-	var tags []string
-	if err := r.pool.QueryRow(ctx, q, args...).Scan(&p.ID, &p.Name, &p.Price, &tags); err != nil {
+	if err := r.pool.QueryRow(ctx, q, args...).Scan(productDest(&p)...); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrNotFound
 		}
 		return nil, err
 	}
-	p.Tags = tags
 	return &p, nil
 }
 
-func (r *PGProductRepo) Search(ctx context.Context, q string, page, pageSize int) ([]domain.Product, int, error) {
-	if page < 1 {
-		page = 1
+func (r *PGProductRepo) Search(ctx context.Context, criteria domain.ProductSearch) (*domain.ProductSearchResult, error) {
+	criteria = criteria.Normalize()
+	ranked := criteria.SortBy == domain.SortByRelevance
+	localized := len(criteria.Locales) > 0
+	columns := fromProducts(psql.Select(productColumns...), criteria.Locales)
+	if localized {
+		columns = columns.Column("locale")
 	}
-	offset := (page - 1) * pageSize
-	base := psql.Select("id", "name", "price", "tags").From("products")
-	countB := psql.Select("COUNT(*)").From("products")
-	if strings.TrimSpace(q) != "" {
-		base = base.Where("name ILIKE ?", "%"+q+"%")
-		countB = countB.Where("name ILIKE ?", "%"+q+"%")
+	if ranked {
+		columns = columns.Column(squirrel.Alias(relevanceScore(criteria.Query), "score"))
 	}
-	builder := base.OrderBy("id").Limit(uint64(pageSize)).Offset(uint64(offset))
+	base := applyProductFilters(columns, criteria)
+	// fetch one extra row to learn whether a next page exists without counting
+	builder := applyProductCursor(base, criteria).
+		OrderBy(productOrderBy(criteria)...).
+		Limit(uint64(criteria.PageSize) + 1).
+		Offset(uint64(criteria.Offset()))
 
 	sql, args, err := builder.ToSql()
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	rows, err := r.pool.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer rows.Close()
 
-	var out []domain.Product
+	out := []domain.Product{}
+	var scores []float64
 	for rows.Next() {
 		var p domain.Product
-		var tags []string
-		if err := rows.Scan(&p.ID, &p.Name, &p.Price, &tags); err != nil {
-			return nil, 0, err
+		dest := productDest(&p)
+		if localized {
+			dest = append(dest, &p.Locale)
+		}
+		var score float64
+		if ranked {
+			dest = append(dest, &score)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
 		}
-		p.Tags = tags
 		out = append(out, p)
+		if ranked {
+			scores = append(scores, score)
+		}
 	}
 	if err := rows.Err(); err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+
+	result := &domain.ProductSearchResult{Items: out, Scores: scores}
+	if len(out) > criteria.PageSize {
+		result.Items = out[:criteria.PageSize]
+		var score float64
+		if ranked {
+			result.Scores = scores[:criteria.PageSize]
+			score = result.Scores[criteria.PageSize-1]
+		}
+		next := criteria.SortKey(result.Items[criteria.PageSize-1], score)
+		result.Next = &next
+	}
+	if criteria.SkipTotal {
+		return result, nil
 	}
 
 	// total count
-	cq, cargs, err := countB.ToSql()
+	cq, cargs, err := applyProductFilters(fromProducts(psql.Select("COUNT(*)"), criteria.Locales), criteria).ToSql()
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+	if err := r.pool.QueryRow(ctx, cq, cargs...).Scan(&result.Total); err != nil {
+		return nil, err
 	}
-	var total int
-	if err := r.pool.QueryRow(ctx, cq, cargs...).Scan(&total); err != nil {
-		return nil, 0, err
+
+	if result.Facets, err = r.tagFacets(ctx, criteria); err != nil {
+		return nil, err
 	}
-	return out, total, nil
+	return result, nil
 }
 
-func (r *PGProductRepo) Create(ctx context.Context, p *domain.Product) (int64, error) {
-	ib := psql.Insert("products").Columns("name", "price", "tags").Values(p.Name, p.Price, p.Tags).Suffix("RETURNING id")
-	sql, args, err := ib.ToSql()
+// tagFacets counts products per tag across the whole filtered result set.
+func (r *PGProductRepo) tagFacets(ctx context.Context, criteria domain.ProductSearch) ([]domain.TagCount, error) {
+	fb := fromProducts(psql.Select(`MIN(t.tag COLLATE "C")`, "COUNT(*)"), criteria.Locales).
+		CrossJoin("LATERAL unnest(products.tags) AS t(tag)").
+		GroupBy("lower(t.tag)").
+		OrderBy("2 DESC", "1")
+	sql, args, err := applyProductFilters(fb, criteria).ToSql()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	var id int64
-	if err := r.pool.QueryRow(ctx, sql, args...).Scan(&id); err != nil {
-		return 0, err
+
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
 	}
-	return id, nil
+	defer rows.Close()
+
+	facets := []domain.TagCount{}
+	for rows.Next() {
+		var f domain.TagCount
+		if err := rows.Scan(&f.Tag, &f.Count); err != nil {
+			return nil, err
+		}
+		facets = append(facets, f)
+	}
+	return facets, rows.Err()
+}
+
+// fromProducts reads the products table, or with locales a derived table of the same name and
+// columns whose name, description and search_vector come from each product's first translation
+// along the chain. Filters, ordering and cursors then all see the translated text; the extra
+// locale column names the translation used, empty for the product's own text. Translated names are
+// indexed with the english configuration the search query is parsed with.
+func fromProducts(b squirrel.SelectBuilder, locales []string) squirrel.SelectBuilder {
+	if len(locales) == 0 {
+		return b.From("products")
+	}
+	localized := squirrel.Select("p.id", "COALESCE(tr.name, p.name) AS name", "p.slug", "COALESCE(tr.description, p.description) AS description",
+		"p.price", "p.currency", "p.price_overrides", "p.tags", "p.category_id", "p.status", "p.published_at", "p.deleted_at", "p.version", "p.updated_at",
+		"CASE WHEN tr.name IS NULL THEN p.search_vector ELSE to_tsvector('english', tr.name) END AS search_vector",
+		"COALESCE(tr.locale, '') AS locale").
+		From("products p").
+		JoinClause("LEFT JOIN LATERAL (SELECT t.locale, t.name, t.description FROM product_translations t WHERE t.product_id = p.id AND t.locale = ANY(?::text[]) ORDER BY array_position(?::text[], t.locale) LIMIT 1) tr ON true", locales, locales)
+	return b.FromSelect(localized, "products")
 }
 
-func (r *PGProductRepo) Delete(ctx context.Context, id int64) error {
-	ct, err := r.pool.Exec(ctx, "DELETE FROM products WHERE id=$1", id)
+// applyProductFilters adds the WHERE clauses shared by the page, count and facet queries.
+func applyProductFilters(b squirrel.SelectBuilder, criteria domain.ProductSearch) squirrel.SelectBuilder {
+	b = b.Where(squirrel.Eq{"products.deleted_at": nil})
+	if !criteria.IncludeUnpublished {
+		b = b.Where(squirrel.Eq{"products.status": string(domain.ProductPublished)})
+	}
+	if criteria.Query != "" && criteria.SortBy == domain.SortByRelevance {
+		// full-text match (stemmed) or trigram word similarity for misspellings; see migration 000007
+		b = b.Where("(products.search_vector @@ websearch_to_tsquery('english', ?) OR ? <% products.name OR products.name ILIKE ?)",
+			criteria.Query, criteria.Query, "%"+criteria.Query+"%")
+	} else if criteria.Query != "" {
+		b = b.Where("products.name ILIKE ?", "%"+criteria.Query+"%")
+	}
+	if len(criteria.Tags) > 0 {
+		// product_tags_lower matches the GIN expression index from migration 000005.
+		op := "&&"
+		if criteria.TagMatch == domain.TagMatchAll {
+			op = "@>"
+		}
+		b = b.Where("product_tags_lower(products.tags) "+op+" ?::text[]", criteria.Tags)
+	}
+	if criteria.CategoryID != nil {
+		b = b.Where("products.category_id IN (SELECT id FROM category_subtree(?))", *criteria.CategoryID)
+	}
+	if criteria.MinPrice != nil {
+		b = b.Where(squirrel.GtOrEq{"products.price": *criteria.MinPrice})
+	}
+	if criteria.MaxPrice != nil {
+		b = b.Where(squirrel.LtOrEq{"products.price": *criteria.MaxPrice})
+	}
+	return b
+}
+
+// applyProductCursor restricts the page to rows strictly after the keyset cursor.
+// Row comparisons work because the id tie-breaker always follows the sort direction.
+func applyProductCursor(b squirrel.SelectBuilder, criteria domain.ProductSearch) squirrel.SelectBuilder {
+	c := criteria.After
+	if c == nil {
+		return b
+	}
+	op := ">"
+	if criteria.Order == domain.SortDesc {
+		op = "<"
+	}
+	switch criteria.SortBy {
+	case domain.SortByRelevance:
+		sql, args, _ := relevanceScore(criteria.Query).ToSql()
+		return b.Where("("+sql+", products.id) "+op+" (?, ?)", append(args, c.Score, c.ID)...)
+	case domain.SortByName:
+		return b.Where(`(products.name COLLATE "C", products.id) `+op+" (?, ?)", c.Name, c.ID)
+	case domain.SortByPrice:
+		return b.Where("(products.price, products.id) "+op+" (?, ?)", c.Price, c.ID)
+	default:
+		return b.Where("products.id "+op+" ?", c.ID)
+	}
+}
+
+// relevanceScore ranks full-text hits by ts_rank and falls back to trigram word similarity,
+// cast to float8 so the value round-trips through the cursor unchanged.
+func relevanceScore(query string) squirrel.Sqlizer {
+	return squirrel.Expr("GREATEST(ts_rank(products.search_vector, websearch_to_tsquery('english', ?)), word_similarity(?, products.name))::float8", query, query)
+}
+
+// productOrderBy mirrors domain.ProductSearch.Less: byte-wise name ordering and id as the tie-breaker.
+func productOrderBy(criteria domain.ProductSearch) []string {
+	dir := "ASC"
+	if criteria.Order == domain.SortDesc {
+		dir = "DESC"
+	}
+	switch criteria.SortBy {
+	case domain.SortByRelevance:
+		return []string{"score " + dir, "id " + dir}
+	case domain.SortByName:
+		return []string{`name COLLATE "C" ` + dir, "id " + dir}
+	case domain.SortByPrice:
+		return []string{"price " + dir, "id " + dir}
+	default:
+		return []string{"id " + dir}
+	}
+}
+
+// Suggest reads name candidates through the lower(name) text_pattern_ops index and tag candidates
+// from product_tag_counts (both added in migration 000008), each ordered like domain.RankSuggestions.
+// Only published, untrashed products are suggested; migrations 000011 and 000012 keep the tag counts
+// to the same rows.
+func (r *PGProductRepo) Suggest(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error) {
+	pattern := escapeLike(prefix) + "%"
+	out := []domain.Suggestion{}
+
+	tq, targs, err := psql.Select("tag", "product_count").
+		From("product_tag_counts").
+		Where("tag LIKE ?", pattern).
+		OrderByClause("tag = ? DESC", prefix).
+		OrderBy("product_count DESC", "length(tag)", "tag").
+		Limit(uint64(limit)).
+		ToSql()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if ct.RowsAffected() == 0 {
-		return domain.ErrNotFound
+	rows, err := r.pool.Query(ctx, tq, targs...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	for rows.Next() {
+		s := domain.Suggestion{Kind: domain.SuggestionTag}
+		if err := rows.Scan(&s.Text, &s.Weight); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	nq, nargs, err := psql.Select("id", "name").
+		From("products").
+		Where("lower(name) LIKE ?", pattern).
+		Where(squirrel.Eq{"status": string(domain.ProductPublished), "deleted_at": nil}).
+		OrderByClause("lower(name) = ? DESC", prefix).
+		OrderBy("length(name)", "name", "id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err = r.pool.Query(ctx, nq, nargs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		s := domain.Suggestion{Kind: domain.SuggestionName, Weight: 1}
+		if err := rows.Scan(&s.ProductID, &s.Text); err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+// escapeLike escapes LIKE wildcards so user input only ever matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// productStamp is what the database assigns to a written product.
+type productStamp struct {
+	id, version int64
+	slug        string
+	updatedAt   time.Time
+}
+
+func (r *PGProductRepo) Create(ctx context.Context, p *domain.Product) (int64, error) {
+	var stamps []productStamp
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var err error
+		stamps, err = insertProducts(ctx, tx, []*domain.Product{p})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	p.ID, p.Slug, p.Version, p.UpdatedAt = stamps[0].id, stamps[0].slug, stamps[0].version, stamps[0].updatedAt
+	return p.ID, nil
+}
+
+func (r *PGProductRepo) Delete(ctx context.Context, id int64, version int64) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := lockLiveProduct(ctx, tx, id, version); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, "UPDATE products SET deleted_at=now(), version=version+1, updated_at=now() WHERE id=$1", id)
+		return err
+	})
 }
 
 func (r *PGProductRepo) Update(ctx context.Context, p *domain.Product) error {
-	ct, err := r.pool.Exec(ctx, "UPDATE products SET name=$1, price=$2, tags=$3 WHERE id=$4", p.Name, p.Price, p.Tags, p.ID)
+	var stamp productStamp
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var err error
+		stamp, err = updateProduct(ctx, tx, p)
+		return err
+	})
 	if err != nil {
 		return err
 	}
-	if ct.RowsAffected() == 0 {
-		return domain.ErrNotFound
-	}
+	p.Slug, p.Version, p.UpdatedAt = stamp.slug, stamp.version, stamp.updatedAt
 	return nil
 }
+
+// updateProduct writes p inside tx as Update does and returns the stored slug, version and
+// updated_at; p itself is left untouched so a rolled back tx does not leak into it.
+func updateProduct(ctx context.Context, tx pgx.Tx, p *domain.Product) (productStamp, error) {
+	stamp := productStamp{id: p.ID}
+	old, err := lockLiveProduct(ctx, tx, p.ID, p.Version)
+	if err != nil {
+		return productStamp{}, err
+	}
+	slugs, err := claimSlugs(ctx, tx, []slugClaim{{id: p.ID, current: old.Slug, name: p.Name}})
+	if err != nil {
+		return productStamp{}, err
+	}
+	stamp.slug = slugs[0]
+	if err := tx.QueryRow(ctx, "UPDATE products SET name=$1, slug=$2, description=$3, price=$4, currency=$5, price_overrides=$6, tags=$7, category_id=$8, status=$9, published_at=$10, version=version+1, updated_at=now() WHERE id=$11 RETURNING version, updated_at",
+		p.Name, stamp.slug, p.Description, p.Price, p.BaseCurrency(), priceOverrides(p), p.Tags, p.CategoryID, string(p.LifecycleStatus()), p.PublishedAt, p.ID).Scan(&stamp.version, &stamp.updatedAt); err != nil {
+		return productStamp{}, err
+	}
+	if domain.PriceChanged(old, p) {
+		if _, err := insertPriceChange(ctx, tx, domain.AppliedPriceChange(p, time.Now())); err != nil {
+			return productStamp{}, err
+		}
+	}
+	return stamp, nil
+}
+
+// lockLiveProduct locks a product that is not in the trash for the rest of tx and checks the
+// caller's expected version against it. Only the slug, price, currency and version are loaded.
+func lockLiveProduct(ctx context.Context, tx pgx.Tx, id, expectedVersion int64) (*domain.Product, error) {
+	var p domain.Product
+	if err := tx.QueryRow(ctx, "SELECT slug, price, currency, version FROM products WHERE id=$1 AND deleted_at IS NULL FOR UPDATE", id).Scan(&p.Slug, &p.Price, &p.Currency, &p.Version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	if err := domain.CheckVersion(expectedVersion, p.Version); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// priceOverrides never returns nil so the column always holds a JSON object, not JSON null.
+func priceOverrides(p *domain.Product) map[string]int64 {
+	if p.PriceOverrides == nil {
+		return map[string]int64{}
+	}
+	return p.PriceOverrides
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetBySlug resolves current and former slugs alike through product_slugs (migration 000020).
+func (r *PGProductRepo) GetBySlug(ctx context.Context, slug string) (*domain.Product, error) {
+	q, args, err := psql.Select(productColumns...).From("products").
+		Where("id = (SELECT product_id FROM product_slugs WHERE slug = ?)", slug).
+		Where(squirrel.Eq{"deleted_at": nil}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var p domain.Product
+	if err := r.pool.QueryRow(ctx, q, args...).Scan(productDest(&p)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+// slugClaim is a product about to be written: id is already allocated and current is the stored
+// slug, empty for a new product.
+type slugClaim struct {
+	id            int64
+	current, name string
+}
+
+// claimSlugs returns the slug each claim should be written with, in order. A current slug that
+// still fits the name is kept; otherwise the first candidate no other product has held is recorded
+// in product_slugs. Its primary key settles races with concurrent writers: the insert waits for
+// them, and a candidate they took is skipped on the next round.
+func claimSlugs(ctx context.Context, tx pgx.Tx, claims []slugClaim) ([]string, error) {
+	slugs := make([]string, len(claims))
+	var pending []int
+	for i, c := range claims {
+		if c.current != "" && domain.SlugFits(c.current, c.name) {
+			slugs[i] = c.current
+			continue
+		}
+		pending = append(pending, i)
+	}
+	for len(pending) > 0 {
+		patterns := make([]string, len(pending))
+		for j, i := range pending {
+			patterns[j] = slugPattern(domain.Slugify(claims[i].name))
+		}
+		owners, err := slugOwners(ctx, tx, patterns)
+		if err != nil {
+			return nil, err
+		}
+
+		ib := psql.Insert("product_slugs").Columns("slug", "product_id")
+		for _, i := range pending {
+			c := claims[i]
+			slug := domain.FreeSlug(domain.Slugify(c.name), func(slug string) bool {
+				owner, ok := owners[slug]
+				return ok && owner != c.id
+			})
+			// later claims in the batch must not pick the same candidate
+			owners[slug] = c.id
+			slugs[i] = slug
+			ib = ib.Values(slug, c.id)
+		}
+		// a product taking back one of its own former slugs updates nothing but still returns it
+		sql, args, err := ib.Suffix("ON CONFLICT (slug) DO UPDATE SET product_id = EXCLUDED.product_id WHERE product_slugs.product_id = EXCLUDED.product_id RETURNING slug").ToSql()
+		if err != nil {
+			return nil, err
+		}
+		rows, err := tx.Query(ctx, sql, args...)
+		if err != nil {
+			return nil, err
+		}
+		claimed, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return nil, err
+		}
+		won := make(map[string]bool, len(claimed))
+		for _, slug := range claimed {
+			won[slug] = true
+		}
+		var lost []int
+		for _, i := range pending {
+			if !won[slugs[i]] {
+				lost = append(lost, i)
+			}
+		}
+		pending = lost
+	}
+	return slugs, nil
+}
+
+// slugOwners maps every slug matching one of the LIKE patterns to the product holding it.
+func slugOwners(ctx context.Context, tx pgx.Tx, patterns []string) (map[string]int64, error) {
+	rows, err := tx.Query(ctx, "SELECT slug, product_id FROM product_slugs WHERE slug LIKE ANY($1::text[])", patterns)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	owners := make(map[string]int64)
+	for rows.Next() {
+		var (
+			slug string
+			id   int64
+		)
+		if err := rows.Scan(&slug, &id); err != nil {
+			return nil, err
+		}
+		owners[slug] = id
+	}
+	return owners, rows.Err()
+}
+
+// slugPattern matches every candidate domain.SlugCandidate derives from base. Long bases are cut
+// to make room for the suffix, so only a prefix that survives any suffix is matched; slugs never
+// contain LIKE wildcards.
+func slugPattern(base string) string {
+	prefix := base[:min(len(base), domain.MaxSlugLength-20)]
+	return strings.TrimRight(prefix, "-") + "%"
+}
+
+// nextProductIDs allocates n product ids up front so slugs can be claimed before the rows exist.
+func nextProductIDs(ctx context.Context, tx pgx.Tx, n int) ([]int64, error) {
+	rows, err := tx.Query(ctx, "SELECT nextval(pg_get_serial_sequence('products', 'id')) FROM generate_series(1, $1)", n)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[int64])
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+func (r *PGProductRepo) Restore(ctx context.Context, id int64) error {
+	ct, err := r.pool.Exec(ctx, "UPDATE products SET deleted_at=NULL, version=version+1, updated_at=now() WHERE id=$1 AND deleted_at IS NOT NULL", id)
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() == 1 {
+		return nil
+	}
+	var exists bool
+	if err := r.pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM products WHERE id=$1)", id).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return domain.ConflictError("product is not in the trash")
+	}
+	return domain.ErrNotFound
+}
+
+// ListDeleted reads the trash through the partial deleted_at index from migration 000012.
+func (r *PGProductRepo) ListDeleted(ctx context.Context, page domain.TrashPage) (*domain.TrashList, error) {
+	page = page.Normalize()
+	trashed := squirrel.NotEq{"deleted_at": nil}
+	sql, args, err := psql.Select(productColumns...).From("products").
+		Where(trashed).
+		OrderBy("deleted_at DESC", "id DESC").
+		Limit(uint64(page.PageSize)).
+		Offset(uint64(page.Offset())).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := &domain.TrashList{Items: []domain.Product{}}
+	for rows.Next() {
+		var p domain.Product
+		if err := rows.Scan(productDest(&p)...); err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	cq, cargs, err := psql.Select("COUNT(*)").From("products").Where(trashed).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.pool.QueryRow(ctx, cq, cargs...).Scan(&list.Total); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// PurgeDeleted hard-deletes the oldest expired rows; comments, price history and image rows go
+// with them through ON DELETE CASCADE. The outer query still sees the image rows the cascade
+// removes, as every part of the statement shares one snapshot. SKIP LOCKED keeps concurrent
+// purgers from waiting on each other.
+func (r *PGProductRepo) PurgeDeleted(ctx context.Context, cutoff time.Time, limit int) (int, []string, error) {
+	var (
+		purged   int
+		blobKeys []string
+	)
+	err := r.pool.QueryRow(ctx, `WITH purged AS (
+		DELETE FROM products WHERE id IN (
+			SELECT id FROM products
+			WHERE deleted_at IS NOT NULL AND deleted_at < $1
+			ORDER BY deleted_at, id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED)
+		RETURNING id)
+	SELECT (SELECT count(*) FROM purged),
+		COALESCE((SELECT array_agg(blob_key) FROM product_images WHERE product_id IN (SELECT id FROM purged)), '{}')`,
+		cutoff, limit).Scan(&purged, &blobKeys)
+	if err != nil {
+		return 0, nil, err
+	}
+	return purged, blobKeys, nil
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGRecommendationSource struct{ pool *pgxpool.Pool }
+
+var _ outbound.RecommendationSource = (*PGRecommendationSource)(nil)
+
+func NewRecommendationSource(pool *pgxpool.Pool) outbound.RecommendationSource {
+	return &PGRecommendationSource{pool: pool}
+}
+
+// relatedQuery scores every published, untrashed product against the source product $1 in
+// one statement. Orders are only read when $2 is true; they reach products through
+// lower(product_name) (migration 000019). $3..$5 are the domain weights and $6 the limit;
+// the ORDER BY mirrors domain.RankRelated.
+var relatedQuery = `
+WITH source AS (
+	SELECT id, product_tags_lower(tags) AS tags, category_id, lower(name) AS product_name
+	FROM products WHERE id = $1 AND deleted_at IS NULL
+), buyers AS (
+	SELECT DISTINCT o.user_id FROM orders o JOIN source s ON lower(o.product_name) = s.product_name
+	WHERE $2
+), co_purchases AS (
+	SELECT lower(o.product_name) AS product_name, COUNT(DISTINCT o.user_id) AS buyers
+	FROM orders o JOIN buyers b ON b.user_id = o.user_id
+	GROUP BY 1
+), scored AS (
+	SELECT p.id AS candidate_id,
+		ARRAY(SELECT DISTINCT t FROM unnest(product_tags_lower(p.tags)) t WHERE t = ANY(s.tags) ORDER BY t) AS shared_tags,
+		COALESCE(p.category_id = s.category_id, false) AS same_category,
+		COALESCE(c.buyers, 0)::int AS co_purchases
+	FROM products p
+	CROSS JOIN source s
+	LEFT JOIN co_purchases c ON c.product_name = lower(p.name)
+	WHERE p.id <> s.id AND p.deleted_at IS NULL AND p.status = 'published'
+), ranked AS (
+	SELECT *, cardinality(shared_tags) * $3 + CASE WHEN same_category THEN $4 ELSE 0 END + co_purchases * $5 AS score
+	FROM scored
+)
+SELECT ` + strings.Join(productColumns, ", ") + `, ranked.shared_tags, ranked.same_category, ranked.co_purchases, ranked.score
+FROM products JOIN ranked ON ranked.candidate_id = products.id
+WHERE ranked.score > 0
+ORDER BY ranked.score DESC, products.id
+LIMIT $6`
+
+func (s *PGRecommendationSource) Related(ctx context.Context, query domain.RelatedQuery) ([]domain.RelatedProduct, error) {
+	query = query.Normalize()
+	rows, err := s.pool.Query(ctx, relatedQuery, query.ProductID, query.IncludeOrders,
+		domain.RelatedTagWeight, domain.RelatedCategoryWeight, domain.RelatedCoPurchaseWeight, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	items, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.RelatedProduct, error) {
+		var item domain.RelatedProduct
+		dest := append(productDest(&item.Product), &item.SharedTags, &item.SameCategory, &item.CoPurchases, &item.Score)
+		err := row.Scan(dest...)
+		return item, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = []domain.RelatedProduct{}
+	}
+	return items, nil
+}
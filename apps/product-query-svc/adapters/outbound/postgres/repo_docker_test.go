@@ -4,11 +4,13 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -93,10 +95,71 @@ func TestPostgresRepo_WithDocker(t *testing.T) {
 	repo := NewProductRepository(pool)
 
 	// Search should work on seeded data (may be empty if seeds change)
-	if items, total, err := repo.Search(ctx, "pro", 1, 10); err != nil {
+	if res, err := repo.Search(ctx, domain.ProductSearch{Query: "pro", Page: 1, PageSize: 10}); err != nil {
 		t.Fatalf("repo.Search: %v", err)
-	} else if total < 0 || len(items) < 0 { // sanity
-		t.Fatalf("unexpected search result: total=%d items=%d", total, len(items))
+	} else if res.Total < len(res.Items) { // sanity
+		t.Fatalf("unexpected search result: total=%d items=%d", res.Total, len(res.Items))
+	}
+
+	// Tag filters are case-insensitive and facets cover the whole result set.
+	res, err := repo.Search(ctx, domain.ProductSearch{Tags: []string{"SUBSCRIPTION", "starter"}, TagMatch: domain.TagMatchAll, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("repo.Search tags: %v", err)
+	}
+	if res.Total != 1 || len(res.Items) != 1 || res.Items[0].Name != "Basic Plan" {
+		t.Fatalf("unexpected all-of tag result: %#v", res)
+	}
+	res, err = repo.Search(ctx, domain.ProductSearch{Tags: []string{"starter", "enterprise"}, Page: 1, PageSize: 1})
+	if err != nil {
+		t.Fatalf("repo.Search any tags: %v", err)
+	}
+	if res.Total != 2 || len(res.Items) != 1 {
+		t.Fatalf("unexpected any-of tag result: total=%d items=%d", res.Total, len(res.Items))
+	}
+	if len(res.Facets) == 0 || res.Facets[0].Tag != "subscription" || res.Facets[0].Count != 2 {
+		t.Fatalf("unexpected facets: %#v", res.Facets)
+	}
+
+	// Price bounds are inclusive and sort honours the requested direction.
+	minPrice, maxPrice := int64(9900), int64(19900)
+	res, err = repo.Search(ctx, domain.ProductSearch{MinPrice: &minPrice, MaxPrice: &maxPrice, SortBy: domain.SortByPrice, Order: domain.SortDesc, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("repo.Search price range: %v", err)
+	}
+	if res.Total != 2 || len(res.Items) != 2 || res.Items[0].Name != "Pro Plan" || res.Items[1].Name != "Basic Plan" {
+		t.Fatalf("unexpected price range result: %#v", res)
+	}
+
+	// Keyset pagination continues after the cursor and can skip counting.
+	res, err = repo.Search(ctx, domain.ProductSearch{Tags: []string{"subscription"}, SortBy: domain.SortByPrice, Order: domain.SortAsc, SkipTotal: true, Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("repo.Search first page: %v", err)
+	}
+	if len(res.Items) != 2 || res.Next == nil || res.Total != 0 || res.Facets != nil {
+		t.Fatalf("unexpected first keyset page: %#v", res)
+	}
+	res, err = repo.Search(ctx, domain.ProductSearch{Tags: []string{"subscription"}, SortBy: domain.SortByPrice, Order: domain.SortAsc, After: res.Next, SkipTotal: true, Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("repo.Search next page: %v", err)
+	}
+	if len(res.Items) != 1 || res.Items[0].Name != "Enterprise Plan" || res.Next != nil {
+		t.Fatalf("unexpected second keyset page: %#v", res)
+	}
+
+	// Relevance mode: stemming ("plans" -> plan) and typo tolerance via trigram similarity.
+	res, err = repo.Search(ctx, domain.ProductSearch{Query: "plans", SortBy: domain.SortByRelevance, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("repo.Search relevance: %v", err)
+	}
+	if res.Total != 3 || len(res.Scores) != len(res.Items) || res.Scores[0] <= 0 {
+		t.Fatalf("unexpected stemmed relevance result: %#v", res)
+	}
+	res, err = repo.Search(ctx, domain.ProductSearch{Query: "enterprse", SortBy: domain.SortByRelevance, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("repo.Search misspelled: %v", err)
+	}
+	if len(res.Items) == 0 || res.Items[0].Name != "Enterprise Plan" {
+		t.Fatalf("expected fuzzy match on Enterprise Plan, got %#v", res)
 	}
 
 	// Create -> Get -> Delete roundtrip
@@ -112,10 +175,642 @@ func TestPostgresRepo_WithDocker(t *testing.T) {
 	if err != nil {
 		t.Fatalf("repo.GetByID: %v", err)
 	}
-	if p.ID != id || p.Name != "DockerTest" || p.Price != 1234 {
+	if p.ID != id || p.Name != "DockerTest" || p.Price != 1234 || p.Currency != domain.DefaultCurrency || len(p.PriceOverrides) != 0 {
 		t.Fatalf("unexpected product: %#v", p)
 	}
-	if err := repo.Delete(ctx, id); err != nil {
+	if p.UpdatedAt.IsZero() {
+		t.Fatalf("expected updated_at to be stamped on create, got %#v", p)
+	}
+	createdAt := p.UpdatedAt
+
+	// Currency and price overrides round-trip through the row.
+	if err := p.SetPricing("eur", map[string]int64{"usd": 1399, "JPY": 200}); err != nil {
+		t.Fatalf("SetPricing: %v", err)
+	}
+	if err := repo.Update(ctx, p); err != nil {
+		t.Fatalf("repo.Update pricing: %v", err)
+	}
+	if p, err = repo.GetByID(ctx, id); err != nil {
+		t.Fatalf("repo.GetByID after pricing: %v", err)
+	}
+	if p.Currency != "EUR" || len(p.PriceOverrides) != 2 || p.PriceOverrides["USD"] != 1399 || p.PriceOverrides["JPY"] != 200 {
+		t.Fatalf("unexpected pricing: %#v", p)
+	}
+	if !p.UpdatedAt.After(createdAt) {
+		t.Fatalf("expected updated_at to advance past %s, got %s", createdAt, p.UpdatedAt)
+	}
+
+	// Optimistic concurrency: every write bumps the version and a stale one is refused.
+	if p.Version != 2 {
+		t.Fatalf("expected version 2 after one update, got %d", p.Version)
+	}
+	stale := *p
+	stale.Version = 1
+	if err := repo.Update(ctx, &stale); !errors.Is(err, domain.ErrPreconditionFailed) {
+		t.Fatalf("expected precondition failure for a stale update, got %v", err)
+	}
+	if err := repo.Delete(ctx, id, 1); !errors.Is(err, domain.ErrPreconditionFailed) {
+		t.Fatalf("expected precondition failure for a stale delete, got %v", err)
+	}
+
+	// Price history: create and each price/currency change append an applied row; due
+	// scheduled prices apply once, and ones outliving a currency switch are skipped.
+	hist, err := repo.ListPriceHistory(ctx, id)
+	if err != nil {
+		t.Fatalf("repo.ListPriceHistory: %v", err)
+	}
+	if len(hist) != 2 || hist[0].Currency != "USD" || hist[1].Currency != "EUR" || hist[1].Status != domain.PriceChangeApplied {
+		t.Fatalf("unexpected price history: %#v", hist)
+	}
+	now := time.Now()
+	due, err := domain.SchedulePriceChange(p, 1500, now.Add(time.Millisecond), now)
+	if err != nil {
+		t.Fatalf("SchedulePriceChange: %v", err)
+	}
+	if _, err := repo.SchedulePriceChange(ctx, due); err != nil {
+		t.Fatalf("repo.SchedulePriceChange: %v", err)
+	}
+	pending, _ := domain.SchedulePriceChange(p, 1600, now.Add(time.Hour), now)
+	if _, err := repo.SchedulePriceChange(ctx, pending); err != nil {
+		t.Fatalf("repo.SchedulePriceChange pending: %v", err)
+	}
+	applied, err := repo.ApplyDuePriceChanges(ctx, now.Add(time.Second), 10)
+	if err != nil {
+		t.Fatalf("repo.ApplyDuePriceChanges: %v", err)
+	}
+	if len(applied) != 1 || applied[0].ID != due.ID || applied[0].Status != domain.PriceChangeApplied {
+		t.Fatalf("unexpected applied changes: %#v", applied)
+	}
+	if again, err := repo.ApplyDuePriceChanges(ctx, now.Add(time.Second), 10); err != nil || len(again) != 0 {
+		t.Fatalf("expected no second application, got %#v (err=%v)", again, err)
+	}
+	if p, err = repo.GetByID(ctx, id); err != nil || p.Price != 1500 {
+		t.Fatalf("expected scheduled price 1500, got %#v (err=%v)", p, err)
+	}
+	if p.Version != 3 {
+		t.Fatalf("expected the applied price to bump the version to 3, got %d", p.Version)
+	}
+	if hist, err = repo.ListPriceHistory(ctx, id); err != nil || len(hist) != 4 || hist[3].Status != domain.PriceChangePending || hist[3].AppliedAt != nil {
+		t.Fatalf("unexpected history after apply: %#v (err=%v)", hist, err)
+	}
+
+	// Import: a batch inserts with one multi-row statement and rolls back as a whole.
+	first, _ := domain.NewProduct("Import One", 100, nil)
+	second, _ := domain.NewProduct("Import Two", 200, []string{"import"})
+	if err := repo.ImportProducts(ctx, []*domain.Product{first, second}, nil); err != nil {
+		t.Fatalf("repo.ImportProducts: %v", err)
+	}
+	if first.ID == 0 || second.ID <= first.ID || second.Version != 1 || second.UpdatedAt.IsZero() {
+		t.Fatalf("expected stored ids and versions on imported products, got %#v %#v", first, second)
+	}
+	if hist, err := repo.ListPriceHistory(ctx, second.ID); err != nil || len(hist) != 1 || hist[0].Price != 200 {
+		t.Fatalf("expected an applied price for the imported product, got %#v (err=%v)", hist, err)
+	}
+	third, _ := domain.NewProduct("Import Three", 300, nil)
+	staleImport := *first
+	staleImport.Version = 7
+	if err := repo.ImportProducts(ctx, []*domain.Product{third}, []*domain.Product{&staleImport}); !errors.Is(err, domain.ErrPreconditionFailed) {
+		t.Fatalf("expected the stale update to fail the batch, got %v", err)
+	}
+	res, err = repo.Search(ctx, domain.ProductSearch{Query: "Import Three", IncludeUnpublished: true, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("repo.Search imports: %v", err)
+	}
+	for _, item := range res.Items {
+		if item.Name == "Import Three" {
+			t.Fatalf("expected the failed batch to be rolled back, got %#v", item)
+		}
+	}
+
+	// Export: filters apply, results stream in id order and paging is ignored.
+	var exported []*domain.Product
+	err = repo.ExportProducts(ctx, domain.ProductSearch{Query: "Import", IncludeUnpublished: true, Page: 2, PageSize: 1}, func(p *domain.Product) error {
+		exported = append(exported, p)
+		return nil
+	})
+	if err != nil || len(exported) != 2 || exported[0].ID != first.ID || exported[1].ID != second.ID || len(exported[1].Tags) != 1 {
+		t.Fatalf("expected both imported products in id order, got %#v (err=%v)", exported, err)
+	}
+
+	// Lifecycle: the new product is a draft, hidden from search and suggestions until published.
+	if p.Status != domain.ProductDraft || p.PublishedAt != nil {
+		t.Fatalf("expected a draft product, got %#v", p)
+	}
+	if res, err := repo.Search(ctx, domain.ProductSearch{Query: "dockertest", Page: 1, PageSize: 10}); err != nil || res.Total != 0 {
+		t.Fatalf("expected draft to be hidden from search, got %#v (err=%v)", res, err)
+	}
+	if res, err := repo.Search(ctx, domain.ProductSearch{Query: "dockertest", IncludeUnpublished: true, Page: 1, PageSize: 10}); err != nil || res.Total != 1 {
+		t.Fatalf("expected draft with includeUnpublished, got %#v (err=%v)", res, err)
+	}
+	if sugg, err := repo.Suggest(ctx, "tc", 5); err != nil || len(sugg) != 0 {
+		t.Fatalf("expected draft tags to stay out of suggestions, got %#v (err=%v)", sugg, err)
+	}
+	if err := p.Publish(time.Now()); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	if err := repo.Update(ctx, p); err != nil {
+		t.Fatalf("repo.Update publish: %v", err)
+	}
+	if p, err = repo.GetByID(ctx, id); err != nil || p.Status != domain.ProductPublished || p.PublishedAt == nil {
+		t.Fatalf("expected a published product, got %#v (err=%v)", p, err)
+	}
+
+	// Suggest: names via the prefix index, tags via the trigger-maintained counts.
+	sugg, err := repo.Suggest(ctx, "dockert", 5)
+	if err != nil {
+		t.Fatalf("repo.Suggest name: %v", err)
+	}
+	if len(sugg) != 1 || sugg[0].Kind != domain.SuggestionName || sugg[0].ProductID != id {
+		t.Fatalf("unexpected name suggestions: %#v", sugg)
+	}
+	sugg, err = repo.Suggest(ctx, "sub", 5)
+	if err != nil {
+		t.Fatalf("repo.Suggest tag: %v", err)
+	}
+	if len(sugg) != 1 || sugg[0].Text != "subscription" || sugg[0].Weight != 3 {
+		t.Fatalf("unexpected tag suggestions: %#v", sugg)
+	}
+	if sugg, err = repo.Suggest(ctx, "t_", 5); err != nil || len(sugg) != 0 {
+		t.Fatalf("expected LIKE wildcards to match literally, got %#v (err=%v)", sugg, err)
+	}
+	// writes that leave tags, status and trash state alone must not touch the shared counter rows
+	tagCountRow := func() string {
+		var xmin string
+		if err := pool.QueryRow(ctx, "SELECT xmin::text FROM product_tag_counts WHERE tag = 'subscription'").Scan(&xmin); err != nil {
+			t.Fatalf("read tag count: %v", err)
+		}
+		return xmin
+	}
+	countedXmin := tagCountRow()
+	if err := p.ChangePrice(p.Price + 1); err != nil {
+		t.Fatalf("ChangePrice: %v", err)
+	}
+	if err := repo.Update(ctx, p); err != nil {
+		t.Fatalf("repo.Update price: %v", err)
+	}
+	if after := tagCountRow(); after != countedXmin {
+		t.Fatalf("expected a price change to leave tag counts alone, row went from xmin %s to %s", countedXmin, after)
+	}
+
+	if err := repo.Delete(ctx, id, 0); err != nil {
 		t.Fatalf("repo.Delete: %v", err)
 	}
+	if sugg, err = repo.Suggest(ctx, "tc", 5); err != nil || len(sugg) != 0 {
+		t.Fatalf("expected tag count to drop with the product, got %#v (err=%v)", sugg, err)
+	}
+
+	// Soft delete: the row waits in the trash until restored or purged.
+	if _, err := repo.GetByID(ctx, id); !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("expected trashed product to be hidden, got %v", err)
+	}
+	if err := repo.Delete(ctx, id, 0); !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("expected second delete to miss, got %v", err)
+	}
+	trash, err := repo.ListDeleted(ctx, domain.TrashPage{Page: 1, PageSize: 10})
+	if err != nil || trash.Total < 1 || trash.Items[0].ID != id || trash.Items[0].DeletedAt == nil {
+		t.Fatalf("unexpected trash: %#v (err=%v)", trash, err)
+	}
+	if err := repo.Restore(ctx, id); err != nil {
+		t.Fatalf("repo.Restore: %v", err)
+	}
+	if err := repo.Restore(ctx, id); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("expected conflict restoring a live product, got %v", err)
+	}
+	if sugg, err = repo.Suggest(ctx, "tc", 5); err != nil || len(sugg) != 1 {
+		t.Fatalf("expected tag count to return with the product, got %#v (err=%v)", sugg, err)
+	}
+	if hist, err = repo.ListPriceHistory(ctx, id); err != nil || len(hist) != 4 {
+		t.Fatalf("expected history to survive the trash, got %#v (err=%v)", hist, err)
+	}
+	if err := repo.Delete(ctx, id, 0); err != nil {
+		t.Fatalf("repo.Delete: %v", err)
+	}
+	if n, _, err := repo.PurgeDeleted(ctx, time.Now().Add(-time.Hour), 10); err != nil || n != 0 {
+		t.Fatalf("expected nothing past retention, got %d (err=%v)", n, err)
+	}
+	if n, _, err := repo.PurgeDeleted(ctx, time.Now().Add(time.Minute), 10); err != nil || n < 1 {
+		t.Fatalf("expected the product to be purged, got %d (err=%v)", n, err)
+	}
+	if err := repo.Restore(ctx, id); !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("expected purged product to be gone, got %v", err)
+	}
+
+	// Categories: breadcrumbs come from category_path, subtree filters from category_subtree.
+	categories := NewCategoryRepository(pool)
+	root := &domain.Category{Name: "Docker Root"}
+	if _, err := categories.CreateCategory(ctx, root); err != nil {
+		t.Fatalf("CreateCategory root: %v", err)
+	}
+	leaf := &domain.Category{Name: "Docker Leaf", ParentID: &root.ID}
+	if _, err := categories.CreateCategory(ctx, leaf); err != nil {
+		t.Fatalf("CreateCategory leaf: %v", err)
+	}
+	if len(leaf.Path) != 2 || leaf.Path[0].ID != root.ID || leaf.Path[1].Name != "Docker Leaf" {
+		t.Fatalf("unexpected leaf path: %#v", leaf.Path)
+	}
+	if _, err := categories.CreateCategory(ctx, &domain.Category{Name: "docker leaf", ParentID: &root.ID}); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("expected duplicate sibling name to conflict, got %v", err)
+	}
+	root.ParentID = &leaf.ID
+	if err := categories.UpdateCategory(ctx, root); !errors.Is(err, domain.ErrValidation) {
+		t.Fatalf("expected a cycle to be rejected, got %v", err)
+	}
+	root.ParentID = nil
+
+	filed, err := domain.NewProduct("Docker Filed", 100, nil)
+	if err != nil {
+		t.Fatalf("NewProduct: %v", err)
+	}
+	if err := filed.SetCategory(&leaf.ID); err != nil {
+		t.Fatalf("SetCategory: %v", err)
+	}
+	filedID, err := repo.Create(ctx, filed)
+	if err != nil {
+		t.Fatalf("repo.Create filed: %v", err)
+	}
+	if got, err := repo.GetByID(ctx, filedID); err != nil || len(got.Category) != 2 || got.Category[1].ID != leaf.ID {
+		t.Fatalf("expected the breadcrumb on read, got %#v (err=%v)", got, err)
+	}
+	res, err = repo.Search(ctx, domain.ProductSearch{CategoryID: &root.ID, IncludeUnpublished: true, Page: 1, PageSize: 10})
+	if err != nil || res.Total != 1 || res.Items[0].ID != filedID {
+		t.Fatalf("expected the subtree search to find the product, got %#v (err=%v)", res, err)
+	}
+	// a rename reaches the breadcrumb of products further down, so their validators move too
+	before, err := repo.GetByID(ctx, filedID)
+	if err != nil {
+		t.Fatalf("repo.GetByID filed: %v", err)
+	}
+	root.Name = "Docker Renamed"
+	if err := categories.UpdateCategory(ctx, root); err != nil {
+		t.Fatalf("UpdateCategory rename: %v", err)
+	}
+	if got, err := repo.GetByID(ctx, filedID); err != nil || got.Version != before.Version+1 || !got.UpdatedAt.After(before.UpdatedAt) || got.Category[0].Name != "Docker Renamed" {
+		t.Fatalf("expected the rename to bump the product, got %#v (err=%v)", got, err)
+	}
+	if err := categories.UpdateCategory(ctx, root); err != nil {
+		t.Fatalf("UpdateCategory unchanged: %v", err)
+	}
+	if got, err := repo.GetByID(ctx, filedID); err != nil || got.Version != before.Version+1 {
+		t.Fatalf("expected an unchanged category to leave the product alone, got %#v (err=%v)", got, err)
+	}
+	if err := categories.DeleteCategory(ctx, leaf.ID, 0); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("expected a category in use to conflict, got %v", err)
+	}
+	if err := categories.DeleteCategory(ctx, root.ID, 0); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("expected a category with subcategories to conflict, got %v", err)
+	}
+	all, err := categories.ListCategories(ctx)
+	if err != nil || len(all) != 2 || len(all[1].Path) != 2 {
+		t.Fatalf("unexpected category list: %#v (err=%v)", all, err)
+	}
+
+	// Variants: SKUs are unique across products, attribute sets within a product.
+	variants := NewVariantRepository(pool)
+	price := int64(150)
+	small, err := domain.NewVariant(filedID, "dock-s", map[string]string{"size": "S"}, &price)
+	if err != nil {
+		t.Fatalf("NewVariant: %v", err)
+	}
+	if _, err := variants.CreateVariant(ctx, small); err != nil || small.Version != 1 {
+		t.Fatalf("CreateVariant: %#v (err=%v)", small, err)
+	}
+	for _, clash := range []*domain.Variant{
+		{ProductID: filedID, SKU: "DOCK-S", Attributes: map[string]string{"size": "M"}},
+		{ProductID: filedID, SKU: "DOCK-S2", Attributes: map[string]string{"size": "S"}},
+	} {
+		if _, err := variants.CreateVariant(ctx, clash); !errors.Is(err, domain.ErrConflict) {
+			t.Fatalf("expected %s to conflict, got %v", clash.SKU, err)
+		}
+	}
+	small.PriceCents = nil
+	if err := variants.UpdateVariant(ctx, small); err != nil || small.Version != 2 {
+		t.Fatalf("UpdateVariant: %#v (err=%v)", small, err)
+	}
+	if got, err := variants.GetVariant(ctx, filedID, small.ID); err != nil || got.SKU != "DOCK-S" || got.Attributes["size"] != "S" || got.PriceCents != nil {
+		t.Fatalf("unexpected variant: %#v (err=%v)", got, err)
+	}
+	if err := variants.DeleteVariant(ctx, filedID, small.ID, 1); !errors.Is(err, domain.ErrPreconditionFailed) {
+		t.Fatalf("expected a stale delete to fail, got %v", err)
+	}
+	if err := variants.DeleteVariant(ctx, filedID, small.ID, 2); err != nil {
+		t.Fatalf("DeleteVariant: %v", err)
+	}
+	if list, err := variants.ListVariants(ctx, filedID); err != nil || len(list) != 0 {
+		t.Fatalf("expected no variants left, got %#v (err=%v)", list, err)
+	}
+
+	// Inventory: reservations never take more than is on hand, even when racing.
+	inventory := NewInventoryRepository(pool)
+	large, err := domain.NewVariant(filedID, "dock-l", map[string]string{"size": "L"}, nil)
+	if err != nil {
+		t.Fatalf("NewVariant: %v", err)
+	}
+	if _, err := variants.CreateVariant(ctx, large); err != nil {
+		t.Fatalf("CreateVariant: %v", err)
+	}
+	if _, err := inventory.AdjustStock(ctx, filedID, nil, -1); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("expected booking out of empty stock to conflict, got %v", err)
+	}
+	if level, err := inventory.AdjustStock(ctx, filedID, &large.ID, 3); err != nil || level.OnHand != 3 || level.Available() != 3 {
+		t.Fatalf("AdjustStock: %#v (err=%v)", level, err)
+	}
+	if got, err := repo.GetByID(ctx, filedID); err != nil || !got.InStock {
+		t.Fatalf("expected a stocked variant to put the product in stock, got %#v (err=%v)", got, err)
+	}
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		reserved []*domain.Reservation
+	)
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, _ := domain.NewReservation(filedID, &large.ID, 1)
+			if err := inventory.Reserve(ctx, res); err == nil {
+				mu.Lock()
+				reserved = append(reserved, res)
+				mu.Unlock()
+			} else if !errors.Is(err, domain.ErrConflict) {
+				t.Errorf("Reserve: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if len(reserved) != 3 {
+		t.Fatalf("expected exactly 3 reservations to succeed, got %d", len(reserved))
+	}
+	if committed, err := inventory.CommitReservation(ctx, reserved[0].ID); err != nil || committed.Status != domain.ReservationCommitted {
+		t.Fatalf("CommitReservation: %#v (err=%v)", committed, err)
+	}
+	if _, err := inventory.ReleaseReservation(ctx, reserved[0].ID); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("expected releasing a committed reservation to conflict, got %v", err)
+	}
+	if _, err := inventory.ReleaseReservation(ctx, reserved[1].ID); err != nil {
+		t.Fatalf("ReleaseReservation: %v", err)
+	}
+	levels, err := inventory.ListStock(ctx, filedID)
+	if err != nil || len(levels) != 1 || levels[0].VariantID == nil || levels[0].OnHand != 2 || levels[0].Reserved != 1 {
+		t.Fatalf("unexpected stock levels: %#v (err=%v)", levels, err)
+	}
+
+	// Images: positions stay dense through moves and deletes despite the unique constraint.
+	images := NewImageRepository(pool)
+	var uploaded []*domain.ProductImage
+	for i, key := range []string{"a.png", "b.png", "c.png"} {
+		img, err := domain.NewProductImage(filedID, "image/png", "", []byte("\x89PNG\r\n\x1a\n"+key))
+		if err != nil {
+			t.Fatalf("NewProductImage: %v", err)
+		}
+		img.BlobKey = "docker/" + key
+		if err := images.CreateImage(ctx, img); err != nil || img.Position != i {
+			t.Fatalf("CreateImage: %#v (err=%v)", img, err)
+		}
+		uploaded = append(uploaded, img)
+	}
+	last := *uploaded[2]
+	last.Position = 0
+	last.AltText = "Back"
+	if err := images.UpdateImage(ctx, &last); err != nil {
+		t.Fatalf("UpdateImage: %v", err)
+	}
+	if _, err := images.DeleteImage(ctx, filedID, uploaded[0].ID); err != nil {
+		t.Fatalf("DeleteImage: %v", err)
+	}
+	gallery, err := images.ListImages(ctx, filedID)
+	if err != nil || len(gallery) != 2 || gallery[0].ID != last.ID || gallery[0].AltText != "Back" || gallery[1].ID != uploaded[1].ID || gallery[1].Position != 1 {
+		t.Fatalf("unexpected images: %#v (err=%v)", gallery, err)
+	}
+	if _, err := images.GetImage(ctx, filedID+1000, last.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("expected another product's image to be missing, got %v", err)
+	}
+	// purging hands back the keys of the cascaded image rows so their content can go too
+	if err := repo.Delete(ctx, filedID, 0); err != nil {
+		t.Fatalf("repo.Delete filed: %v", err)
+	}
+	n, blobKeys, err := repo.PurgeDeleted(ctx, time.Now().Add(time.Minute), 10)
+	sort.Strings(blobKeys)
+	if err != nil || n != 1 || strings.Join(blobKeys, ",") != "docker/b.png,docker/c.png" {
+		t.Fatalf("expected the purge to return the image keys, got %d %v (err=%v)", n, blobKeys, err)
+	}
+	if gallery, err := images.ListImages(ctx, filedID); err != nil || len(gallery) != 0 {
+		t.Fatalf("expected the image rows to be gone, got %#v (err=%v)", gallery, err)
+	}
+
+	// Related products: shared tags and co-purchases from orders, ties broken by id.
+	var relatedIDs []int64
+	for _, name := range []string{"Related Source", "Related Twin", "Related Tie", "Related Bundle"} {
+		tags := []string{"Related-Docker"}
+		if name == "Related Bundle" {
+			tags = nil
+		}
+		p, _ := domain.NewProduct(name, 100, tags)
+		if err := p.Publish(time.Now()); err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+		id, err := repo.Create(ctx, p)
+		if err != nil {
+			t.Fatalf("Create %s: %v", name, err)
+		}
+		relatedIDs = append(relatedIDs, id)
+	}
+	if _, err := pool.Exec(ctx, `INSERT INTO orders (user_id, product_name, total)
+		SELECT id, unnest(ARRAY['related source', 'Related Bundle']), 100 FROM users WHERE email = 'alice@example.com'`); err != nil {
+		t.Fatalf("insert orders: %v", err)
+	}
+	recommendations := NewRecommendationSource(pool)
+	related, err := recommendations.Related(ctx, domain.RelatedQuery{ProductID: relatedIDs[0]})
+	if err != nil || len(related) != 2 || related[0].Product.ID != relatedIDs[1] || related[1].Product.ID != relatedIDs[2] ||
+		related[0].Score != domain.RelatedTagWeight || related[0].SharedTags[0] != "related-docker" {
+		t.Fatalf("unexpected related products: %#v (err=%v)", related, err)
+	}
+	related, err = recommendations.Related(ctx, domain.RelatedQuery{ProductID: relatedIDs[0], Limit: 3, IncludeOrders: true})
+	if err != nil || len(related) != 3 || related[2].Product.ID != relatedIDs[3] || related[2].CoPurchases != 1 || len(related[2].SharedTags) != 0 {
+		t.Fatalf("unexpected related products with orders: %#v (err=%v)", related, err)
+	}
+
+	// Slugs: backfilled by migration 000020, suffixed on collision and kept as redirects after a rename.
+	if seeded, err := repo.GetBySlug(ctx, "basic-plan"); err != nil || seeded.Name != "Basic Plan" {
+		t.Fatalf("expected the backfilled basic-plan slug, got %#v (err=%v)", seeded, err)
+	}
+	renamed, _ := domain.NewProduct("Docker Slug", 100, nil)
+	if _, err := repo.Create(ctx, renamed); err != nil || renamed.Slug != "docker-slug" {
+		t.Fatalf("Create: slug %q (err=%v)", renamed.Slug, err)
+	}
+	batch := []*domain.Product{}
+	for range 2 {
+		p, _ := domain.NewProduct("docker slug!", 100, nil)
+		batch = append(batch, p)
+	}
+	if err := repo.ImportProducts(ctx, batch, nil); err != nil || batch[0].Slug != "docker-slug-2" || batch[1].Slug != "docker-slug-3" {
+		t.Fatalf("ImportProducts: slugs %q, %q (err=%v)", batch[0].Slug, batch[1].Slug, err)
+	}
+	if err := renamed.Rename("Docker Renamed"); err != nil {
+		t.Fatalf("Rename: %v", err)
+	}
+	if err := repo.Update(ctx, renamed); err != nil || renamed.Slug != "docker-renamed" {
+		t.Fatalf("Update: slug %q (err=%v)", renamed.Slug, err)
+	}
+	if moved, err := repo.GetBySlug(ctx, "docker-slug"); err != nil || moved.ID != renamed.ID || moved.Slug != "docker-renamed" {
+		t.Fatalf("expected the old slug to resolve to the renamed product, got %#v (err=%v)", moved, err)
+	}
+	again, _ := domain.NewProduct("Docker Slug", 100, nil)
+	if _, err := repo.Create(ctx, again); err != nil || again.Slug != "docker-slug-4" {
+		t.Fatalf("expected the old slug to stay reserved, got %q (err=%v)", again.Slug, err)
+	}
+	if err := renamed.Rename("Docker Slug"); err != nil {
+		t.Fatalf("Rename: %v", err)
+	}
+	if err := repo.Update(ctx, renamed); err != nil || renamed.Slug != "docker-slug" {
+		t.Fatalf("expected the product to take its old slug back, got %q (err=%v)", renamed.Slug, err)
+	}
+	if _, err := repo.GetBySlug(ctx, "no-such-slug"); !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	// Translations: upserted per locale, bumping the product version, and matched by localized search.
+	translated, _ := domain.NewProduct("Docker Chair", 100, nil)
+	if err := translated.Describe("Folding chair"); err != nil {
+		t.Fatalf("Describe: %v", err)
+	}
+	translatedID, err := repo.Create(ctx, translated)
+	if err != nil {
+		t.Fatalf("Create translated: %v", err)
+	}
+	translations := NewTranslationRepository(pool)
+	de, _ := domain.NewProductTranslation(translatedID, "de", "Docker Stuhl", "Klappstuhl")
+	if created, err := translations.PutTranslation(ctx, de); err != nil || !created {
+		t.Fatalf("PutTranslation: created=%v err=%v", created, err)
+	}
+	de.Name = "Docker Gartenstuhl"
+	if created, err := translations.PutTranslation(ctx, de); err != nil || created {
+		t.Fatalf("PutTranslation replace: created=%v err=%v", created, err)
+	}
+	if got, err := repo.GetByID(ctx, translatedID); err != nil || got.Description != "Folding chair" || got.Version <= translated.Version {
+		t.Fatalf("expected the description and a bumped version, got %#v (err=%v)", got, err)
+	}
+	if list, err := translations.ListTranslations(ctx, translatedID); err != nil || len(list) != 1 || list[0].Name != "Docker Gartenstuhl" {
+		t.Fatalf("unexpected translations: %#v (err=%v)", list, err)
+	}
+	res, err = repo.Search(ctx, domain.ProductSearch{Query: "gartenstuhl", Locales: []string{"de"}, IncludeUnpublished: true, Page: 1, PageSize: 10})
+	if err != nil || res.Total != 1 || len(res.Items) != 1 || res.Items[0].ID != translatedID || res.Items[0].Locale != "de" || res.Items[0].Description != "Klappstuhl" {
+		t.Fatalf("unexpected localized search: %#v (err=%v)", res, err)
+	}
+	if res, err = repo.Search(ctx, domain.ProductSearch{Query: "gartenstuhl", IncludeUnpublished: true, Page: 1, PageSize: 10}); err != nil || res.Total != 0 {
+		t.Fatalf("expected no match without locales, got %#v (err=%v)", res, err)
+	}
+	if err := translations.DeleteTranslation(ctx, translatedID, "de"); err != nil {
+		t.Fatalf("DeleteTranslation: %v", err)
+	}
+	if err := translations.DeleteTranslation(ctx, translatedID, "de"); !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	// Users: the citext UNIQUE constraint on email surfaces as a conflict; deletes cascade to orders.
+	users := NewUserRepository(pool)
+	carol, _ := domain.NewUser("Carol", "carol@example.com")
+	if _, err := users.CreateUser(ctx, carol); err != nil || carol.ID == 0 || carol.CreatedAt.IsZero() {
+		t.Fatalf("CreateUser: %#v (err=%v)", carol, err)
+	}
+	duplicate, _ := domain.NewUser("Other Alice", "ALICE@example.com")
+	if _, err := users.CreateUser(ctx, duplicate); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+	if err := carol.ChangeEmail("Bob@Example.com"); err != nil {
+		t.Fatalf("ChangeEmail: %v", err)
+	}
+	if err := users.UpdateUser(ctx, carol); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+	if err := carol.ChangeEmail("Carol@example.com"); err != nil {
+		t.Fatalf("ChangeEmail: %v", err)
+	}
+	if err := users.UpdateUser(ctx, carol); err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+	if got, err := users.FindByID(ctx, carol.ID); err != nil || got.Email != "Carol@example.com" {
+		t.Fatalf("unexpected user: %#v (err=%v)", got, err)
+	}
+	if _, err := pool.Exec(ctx, "INSERT INTO orders (user_id, product_name, total) VALUES ($1, 'Docker Chair', 100)", carol.ID); err != nil {
+		t.Fatalf("insert order: %v", err)
+	}
+	// orders are kept as financial records, so a user who has them is not deleted
+	if err := users.DeleteUser(ctx, carol.ID); !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("expected ErrConflict for a user with orders, got %v", err)
+	}
+	var kept int
+	if err := pool.QueryRow(ctx, "SELECT count(*) FROM orders WHERE user_id = $1", carol.ID).Scan(&kept); err != nil || kept != 1 {
+		t.Fatalf("expected the user's order to survive, got %d (err=%v)", kept, err)
+	}
+	if _, err := users.FindByID(ctx, carol.ID); err != nil {
+		t.Fatalf("expected the user to survive, got %v", err)
+	}
+	if _, err := pool.Exec(ctx, "DELETE FROM orders WHERE user_id = $1", carol.ID); err != nil {
+		t.Fatalf("delete order: %v", err)
+	}
+	if err := users.DeleteUser(ctx, carol.ID); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+	if err := users.DeleteUser(ctx, carol.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	carol.ID = 0
+	if err := users.UpdateUser(ctx, carol); !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	// User search: substring matches ignore case on both columns, LIKE wildcards match literally.
+	for _, u := range []*domain.User{{Name: "dave", Email: "Dave@Search.example"}, {Name: "Erin 100%", Email: "erin@search.example"}} {
+		if _, err := users.CreateUser(ctx, u); err != nil {
+			t.Fatalf("CreateUser %s: %v", u.Name, err)
+		}
+	}
+	found, err := users.SearchUsers(ctx, domain.UserSearch{Query: "SEARCH.EXAMPLE", SortBy: domain.UserSortByName, Order: domain.SortDesc}.Normalize())
+	if err != nil || found.Total != 2 || len(found.Items) != 2 || found.Items[0].Name != "Erin 100%" || found.Items[1].Name != "dave" {
+		t.Fatalf("unexpected user search: %#v (err=%v)", found, err)
+	}
+	if found, err = users.SearchUsers(ctx, domain.UserSearch{Query: "%"}.Normalize()); err != nil || found.Total != 1 || found.Items[0].Name != "Erin 100%" {
+		t.Fatalf("expected %% to match literally, got %#v (err=%v)", found, err)
+	}
+	seededBefore := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if found, err = users.SearchUsers(ctx, domain.UserSearch{CreatedTo: &seededBefore}.Normalize()); err != nil || found.Total != 0 {
+		t.Fatalf("expected no users created before 2000, got %#v (err=%v)", found, err)
+	}
+	if found, err = users.SearchUsers(ctx, domain.UserSearch{SortBy: domain.UserSortByEmail, PageSize: 1, Page: 2, SkipTotal: true}.Normalize()); err != nil || len(found.Items) != 1 || found.Items[0].Email != "bob@example.com" {
+		t.Fatalf("unexpected second page by email: %#v (err=%v)", found, err)
+	}
+
+	// Keyset pages walked one user at a time add up to the single-page order under every sort.
+	for _, sortBy := range []domain.UserSortField{domain.UserSortByID, domain.UserSortByName, domain.UserSortByEmail, domain.UserSortByCreatedAt} {
+		for _, order := range []domain.SortOrder{domain.SortAsc, domain.SortDesc} {
+			criteria := domain.UserSearch{SortBy: sortBy, Order: order, PageSize: 100}.Normalize()
+			all, err := users.SearchUsers(ctx, criteria)
+			if err != nil || all.Next != nil {
+				t.Fatalf("%s %s: unexpected single page %#v (err=%v)", sortBy, order, all, err)
+			}
+			var walked []int64
+			criteria.PageSize = 1
+			for {
+				page, err := users.SearchUsers(ctx, criteria)
+				if err != nil || page.Total != len(all.Items) {
+					t.Fatalf("%s %s: unexpected page %#v (err=%v)", sortBy, order, page, err)
+				}
+				for _, u := range page.Items {
+					walked = append(walked, u.ID)
+				}
+				if page.Next == nil {
+					break
+				}
+				criteria.After = page.Next
+			}
+			if len(walked) != len(all.Items) {
+				t.Fatalf("%s %s: walked %v, expected %#v", sortBy, order, walked, all.Items)
+			}
+			for i, u := range all.Items {
+				if walked[i] != u.ID {
+					t.Fatalf("%s %s: walked %v, expected %#v", sortBy, order, walked, all.Items)
+				}
+			}
+		}
+	}
 }
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGTranslationRepo struct{ pool *pgxpool.Pool }
+
+var _ outbound.TranslationRepository = (*PGTranslationRepo)(nil)
+
+func NewTranslationRepository(pool *pgxpool.Pool) outbound.TranslationRepository {
+	return &PGTranslationRepo{pool: pool}
+}
+
+func (r *PGTranslationRepo) ListTranslations(ctx context.Context, productID int64) ([]domain.ProductTranslation, error) {
+	rows, err := r.pool.Query(ctx, `SELECT product_id, locale, name, description, updated_at FROM product_translations
+		WHERE product_id=$1 ORDER BY locale COLLATE "C"`, productID)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ProductTranslation, error) {
+		var t domain.ProductTranslation
+		err := row.Scan(&t.ProductID, &t.Locale, &t.Name, &t.Description, &t.UpdatedAt)
+		return t, err
+	})
+}
+
+// PutTranslation upserts under the product's row lock and bumps the product's version in the same
+// transaction. xmax is 0 only on a freshly inserted row, which tells a create from a replace.
+func (r *PGTranslationRepo) PutTranslation(ctx context.Context, translation *domain.ProductTranslation) (bool, error) {
+	var created bool
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := lockLiveProduct(ctx, tx, translation.ProductID, 0); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(ctx, `INSERT INTO product_translations (product_id, locale, name, description) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (product_id, locale) DO UPDATE SET name=EXCLUDED.name, description=EXCLUDED.description, updated_at=now()
+			RETURNING updated_at, xmax = 0`,
+			translation.ProductID, translation.Locale, translation.Name, translation.Description).Scan(&translation.UpdatedAt, &created); err != nil {
+			return err
+		}
+		return touchProduct(ctx, tx, translation.ProductID)
+	})
+	return created, err
+}
+
+func (r *PGTranslationRepo) DeleteTranslation(ctx context.Context, productID int64, locale string) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if _, err := lockLiveProduct(ctx, tx, productID, 0); err != nil {
+			return err
+		}
+		tag, err := tx.Exec(ctx, "DELETE FROM product_translations WHERE product_id=$1 AND locale=$2", productID, locale)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return domain.ErrNotFound
+		}
+		return touchProduct(ctx, tx, productID)
+	})
+}
+
+// touchProduct bumps a locked product's version and updated_at so cached reads of it revalidate.
+func touchProduct(ctx context.Context, tx pgx.Tx, id int64) error {
+	_, err := tx.Exec(ctx, "UPDATE products SET version=version+1, updated_at=now() WHERE id=$1", id)
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -17,8 +18,11 @@ var _ outbound.UserRepository = (*PGUserRepo)(nil)
 
 func NewUserRepository(pool *pgxpool.Pool) outbound.UserRepository { return &PGUserRepo{pool: pool} }
 
+// userColumns is the select list scanned into domain.User, in scan order.
+var userColumns = []string{"id", "name", "email", "created_at"}
+
 func (r *PGUserRepo) FindByID(ctx context.Context, id int64) (*domain.User, error) {
-	q, args, err := psql.Select("id", "name", "email", "created_at").From("users").Where(squirrel.Eq{"id": id}).ToSql()
+	q, args, err := psql.Select(userColumns...).From("users").Where(squirrel.Eq{"id": id}).ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -32,3 +36,168 @@ func (r *PGUserRepo) FindByID(ctx context.Context, id int64) (*domain.User, erro
 	u.CreatedAt = u.CreatedAt.UTC()
 	return &u, nil
 }
+
+// SearchUsers matches name and email with ILIKE, email cast to text so the trigram indexes of
+// migration 000022 apply; the result is the same case-insensitive match citext gives.
+func (r *PGUserRepo) SearchUsers(ctx context.Context, criteria domain.UserSearch) (*domain.UserSearchResult, error) {
+	q, args, err := applyUserCursor(applyUserFilters(psql.Select(userColumns...), criteria), criteria).
+		OrderBy(userOrderBy(criteria)...).
+		Limit(uint64(criteria.PageSize) + 1).
+		Offset(uint64(criteria.Offset())).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &domain.UserSearchResult{Items: []domain.User{}}
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.CreatedAt); err != nil {
+			return nil, err
+		}
+		u.CreatedAt = u.CreatedAt.UTC()
+		result.Items = append(result.Items, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(result.Items) > criteria.PageSize {
+		result.Items = result.Items[:criteria.PageSize]
+		next := criteria.SortKey(&result.Items[criteria.PageSize-1])
+		result.Next = &next
+	}
+	if criteria.SkipTotal {
+		return result, nil
+	}
+
+	cq, cargs, err := applyUserFilters(psql.Select("COUNT(*)"), criteria).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.pool.QueryRow(ctx, cq, cargs...).Scan(&result.Total); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func applyUserFilters(b squirrel.SelectBuilder, criteria domain.UserSearch) squirrel.SelectBuilder {
+	b = b.From("users")
+	if criteria.Query != "" {
+		pattern := "%" + escapeLike(criteria.Query) + "%"
+		b = b.Where("(name ILIKE ? OR email::text ILIKE ?)", pattern, pattern)
+	}
+	if criteria.CreatedFrom != nil {
+		b = b.Where(squirrel.GtOrEq{"created_at": *criteria.CreatedFrom})
+	}
+	if criteria.CreatedTo != nil {
+		b = b.Where(squirrel.Lt{"created_at": *criteria.CreatedTo})
+	}
+	return b
+}
+
+// applyUserCursor restricts the page to rows strictly after the keyset cursor, comparing the same
+// expressions userOrderBy sorts by.
+func applyUserCursor(b squirrel.SelectBuilder, criteria domain.UserSearch) squirrel.SelectBuilder {
+	c := criteria.After
+	if c == nil {
+		return b
+	}
+	op := ">"
+	if criteria.Order == domain.SortDesc {
+		op = "<"
+	}
+	switch criteria.SortBy {
+	case domain.UserSortByName:
+		return b.Where(`(lower(name) COLLATE "C", id) `+op+` (lower(?::text) COLLATE "C", ?)`, c.Name, c.ID)
+	case domain.UserSortByEmail:
+		return b.Where(`(lower(email::text) COLLATE "C", id) `+op+` (lower(?::text) COLLATE "C", ?)`, c.Email, c.ID)
+	case domain.UserSortByCreatedAt:
+		return b.Where("(created_at, id) "+op+" (?, ?)", c.CreatedAt, c.ID)
+	default:
+		return b.Where("id "+op+" ?", c.ID)
+	}
+}
+
+// userOrderBy mirrors domain.UserSearch.Less: byte-wise comparison of the lower-cased name or email
+// and id as the tie-breaker.
+func userOrderBy(criteria domain.UserSearch) []string {
+	dir := "ASC"
+	if criteria.Order == domain.SortDesc {
+		dir = "DESC"
+	}
+	switch criteria.SortBy {
+	case domain.UserSortByName:
+		return []string{`lower(name) COLLATE "C" ` + dir, "id " + dir}
+	case domain.UserSortByEmail:
+		return []string{`lower(email::text) COLLATE "C" ` + dir, "id " + dir}
+	case domain.UserSortByCreatedAt:
+		return []string{"created_at " + dir, "id " + dir}
+	default:
+		return []string{"id " + dir}
+	}
+}
+
+// CreateUser relies on the citext UNIQUE constraint on users.email (migration 000003).
+func (r *PGUserRepo) CreateUser(ctx context.Context, user *domain.User) (int64, error) {
+	q, args, err := psql.Insert("users").Columns("name", "email").Values(user.Name, user.Email).
+		Suffix("RETURNING id, created_at").ToSql()
+	if err != nil {
+		return 0, err
+	}
+	if err := r.pool.QueryRow(ctx, q, args...).Scan(&user.ID, &user.CreatedAt); err != nil {
+		return 0, userWriteError(err)
+	}
+	user.CreatedAt = user.CreatedAt.UTC()
+	return user.ID, nil
+}
+
+func (r *PGUserRepo) UpdateUser(ctx context.Context, user *domain.User) error {
+	q, args, err := psql.Update("users").Set("name", user.Name).Set("email", user.Email).
+		Where(squirrel.Eq{"id": user.ID}).Suffix("RETURNING created_at").ToSql()
+	if err != nil {
+		return err
+	}
+	if err := r.pool.QueryRow(ctx, q, args...).Scan(&user.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return userWriteError(err)
+	}
+	user.CreatedAt = user.CreatedAt.UTC()
+	return nil
+}
+
+// DeleteUser leaves the user's comments to their ON DELETE CASCADE foreign key; the orders
+// foreign key restricts deletes (migration 000023), so a user with orders is refused.
+func (r *PGUserRepo) DeleteUser(ctx context.Context, id int64) error {
+	q, args, err := psql.Delete("users").Where(squirrel.Eq{"id": id}).ToSql()
+	if err != nil {
+		return err
+	}
+	tag, err := r.pool.Exec(ctx, q, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return domain.UserHasOrdersError()
+		}
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// userWriteError maps the unique violation on users.email to a conflict.
+func userWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return domain.DuplicateEmailError()
+	}
+	return err
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// variantSKUIndex is the unique index of migration 000016 that keeps SKUs unique across products.
+const variantSKUIndex = "product_variants_sku_uidx"
+
+type PGVariantRepo struct{ pool *pgxpool.Pool }
+
+var _ outbound.VariantRepository = (*PGVariantRepo)(nil)
+
+// variantColumns is the select list scanned into domain.Variant, in scan order.
+var variantColumns = []string{"id", "product_id", "sku", "attributes", "price_cents", "version", "created_at", "updated_at"}
+
+// variantDest returns the scan targets for variantColumns.
+func variantDest(v *domain.Variant) []any {
+	return []any{&v.ID, &v.ProductID, &v.SKU, &v.Attributes, &v.PriceCents, &v.Version, &v.CreatedAt, &v.UpdatedAt}
+}
+
+func NewVariantRepository(pool *pgxpool.Pool) outbound.VariantRepository {
+	return &PGVariantRepo{pool: pool}
+}
+
+func (r *PGVariantRepo) ListVariants(ctx context.Context, productID int64) ([]domain.Variant, error) {
+	sql, args, err := psql.Select(variantColumns...).From("product_variants").
+		Where(squirrel.Eq{"product_id": productID}).OrderBy("id").ToSql()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.Variant, error) {
+		var v domain.Variant
+		err := row.Scan(variantDest(&v)...)
+		return v, err
+	})
+}
+
+func (r *PGVariantRepo) GetVariant(ctx context.Context, productID, variantID int64) (*domain.Variant, error) {
+	sql, args, err := psql.Select(variantColumns...).From("product_variants").
+		Where(squirrel.Eq{"id": variantID, "product_id": productID}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+	var v domain.Variant
+	if err := r.pool.QueryRow(ctx, sql, args...).Scan(variantDest(&v)...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (r *PGVariantRepo) CreateVariant(ctx context.Context, variant *domain.Variant) (int64, error) {
+	sql, args, err := psql.Insert("product_variants").
+		Columns("product_id", "sku", "attributes", "price_cents").
+		Values(variant.ProductID, variant.SKU, variant.Attributes, variant.PriceCents).
+		Suffix("RETURNING id, version, created_at, updated_at").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+	if err := r.pool.QueryRow(ctx, sql, args...).Scan(&variant.ID, &variant.Version, &variant.CreatedAt, &variant.UpdatedAt); err != nil {
+		return 0, variantWriteError(err, variant.SKU)
+	}
+	return variant.ID, nil
+}
+
+func (r *PGVariantRepo) UpdateVariant(ctx context.Context, variant *domain.Variant) error {
+	sql, args, err := psql.Update("product_variants").
+		Set("sku", variant.SKU).
+		Set("attributes", variant.Attributes).
+		Set("price_cents", variant.PriceCents).
+		Set("version", squirrel.Expr("version + 1")).
+		Set("updated_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{"id": variant.ID}).
+		Suffix("RETURNING version, updated_at").
+		ToSql()
+	if err != nil {
+		return err
+	}
+	err = pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockVariant(ctx, tx, variant.ProductID, variant.ID, variant.Version); err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, sql, args...).Scan(&variant.Version, &variant.UpdatedAt)
+	})
+	return variantWriteError(err, variant.SKU)
+}
+
+func (r *PGVariantRepo) DeleteVariant(ctx context.Context, productID, variantID int64, version int64) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		if err := lockVariant(ctx, tx, productID, variantID, version); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, "DELETE FROM product_variants WHERE id=$1", variantID)
+		return err
+	})
+}
+
+// lockVariant locks a product's variant for the rest of tx and checks the caller's expected version against it.
+func lockVariant(ctx context.Context, tx pgx.Tx, productID, variantID, expectedVersion int64) error {
+	var current int64
+	err := tx.QueryRow(ctx, "SELECT version FROM product_variants WHERE id=$1 AND product_id=$2 FOR UPDATE", variantID, productID).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		return err
+	}
+	return domain.CheckVersion(expectedVersion, current)
+}
+
+// variantWriteError maps the unique indexes of migration 000016 to domain conflicts.
+func variantWriteError(err error, sku string) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != pgUniqueViolation {
+		return err
+	}
+	if pgErr.ConstraintName == variantSKUIndex {
+		return domain.DuplicateSKUError(sku)
+	}
+	return domain.DuplicateVariantError()
+}
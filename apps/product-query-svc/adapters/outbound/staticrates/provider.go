@@ -0,0 +1,86 @@
+package staticrates
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+)
+
+var _ outbound.ExchangeRateProvider = (*Provider)(nil)
+
+// Provider serves fixed rates quoted against a single base currency. Cross rates
+// are derived exactly, so USD->EUR->JPY and USD->JPY always agree.
+type Provider struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+// file is the on-disk format, e.g. {"base":"USD","rates":{"EUR":"0.92","JPY":151.37}}.
+// Rates may be JSON strings or numbers; both are parsed as exact decimals.
+type file struct {
+	Base  string                 `json:"base"`
+	Rates map[string]json.Number `json:"rates"`
+}
+
+// New builds a provider from decimal rates meaning "units of currency per one unit of base".
+func New(base string, rates map[string]string) (*Provider, error) {
+	b, err := domain.NormalizeCurrency(base)
+	if err != nil {
+		return nil, fmt.Errorf("base: %w", err)
+	}
+	p := &Provider{base: b, rates: map[string]*big.Rat{b: big.NewRat(1, 1)}}
+	for code, raw := range rates {
+		c, err := domain.NormalizeCurrency(code)
+		if err != nil {
+			return nil, fmt.Errorf("rate %q: %w", code, err)
+		}
+		r, ok := new(big.Rat).SetString(raw)
+		if !ok || r.Sign() <= 0 {
+			return nil, fmt.Errorf("rate %q: %q is not a positive decimal", code, raw)
+		}
+		p.rates[c] = r
+	}
+	return p, nil
+}
+
+// LoadFile reads a JSON rate table from path.
+func LoadFile(path string) (*Provider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	dec.DisallowUnknownFields()
+	var f file
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	rates := make(map[string]string, len(f.Rates))
+	for code, n := range f.Rates {
+		rates[code] = n.String()
+	}
+	p, err := New(f.Base, rates)
+	if err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+	return p, nil
+}
+
+func (p *Provider) Rate(ctx context.Context, from, to string) (*big.Rat, error) {
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return nil, domain.UnsupportedCurrencyError(from)
+	}
+	toRate, ok := p.rates[to]
+	if !ok {
+		return nil, domain.UnsupportedCurrencyError(to)
+	}
+	return new(big.Rat).Quo(toRate, fromRate), nil
+}
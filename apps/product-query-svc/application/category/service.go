@@ -0,0 +1,98 @@
+package categoryapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+)
+
+var _ inbound.CategoryUseCases = (*Service)(nil)
+
+// Service manages the category tree products are filed under.
+type Service struct {
+	repository outbound.CategoryRepository
+}
+
+func NewService(repository outbound.CategoryRepository) *Service {
+	return &Service{repository: repository}
+}
+
+func (s *Service) List(ctx context.Context) ([]domain.Category, error) {
+	return s.repository.ListCategories(ctx)
+}
+
+func (s *Service) FetchByID(ctx context.Context, id int64) (*domain.Category, error) {
+	if id <= 0 {
+		return nil, domain.ValidationError("id must be a positive integer")
+	}
+	return s.repository.GetCategory(ctx, id)
+}
+
+// Create adds a category under parentID, or at the top level when parentID is nil.
+func (s *Service) Create(ctx context.Context, name string, parentID *int64) (*domain.Category, error) {
+	category, err := domain.NewCategory(name, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkParent(ctx, parentID); err != nil {
+		return nil, err
+	}
+	if _, err := s.repository.CreateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// Update renames a category and moves it under parentID, taking its subcategories along; a
+// non-zero version must match the stored category.
+func (s *Service) Update(ctx context.Context, id int64, name string, parentID *int64, version int64) (*domain.Category, error) {
+	if id <= 0 {
+		return nil, domain.ValidationError("id must be a positive integer")
+	}
+	category, err := s.repository.GetCategory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.CheckVersion(version, category.Version); err != nil {
+		return nil, err
+	}
+	if err := category.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := category.MoveTo(parentID); err != nil {
+		return nil, err
+	}
+	if err := s.checkParent(ctx, parentID); err != nil {
+		return nil, err
+	}
+	if err := s.repository.UpdateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// Delete removes an empty category; a non-zero version must match the stored category.
+func (s *Service) Delete(ctx context.Context, id int64, version int64) error {
+	if id <= 0 {
+		return domain.ValidationError("id must be a positive integer")
+	}
+	return s.repository.DeleteCategory(ctx, id, version)
+}
+
+// checkParent reports a missing parent as a validation error rather than a missing category.
+func (s *Service) checkParent(ctx context.Context, parentID *int64) error {
+	if parentID == nil {
+		return nil
+	}
+	if _, err := s.repository.GetCategory(ctx, *parentID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ValidationError(fmt.Sprintf("parent category %d not found", *parentID))
+		}
+		return err
+	}
+	return nil
+}
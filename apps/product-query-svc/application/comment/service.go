@@ -22,14 +22,17 @@ func NewService(comments outbound.CommentRepository, products outbound.ProductRe
 	return &Service{comments: comments, products: products, users: users}
 }
 
-func (s *Service) ListByProduct(ctx context.Context, productID int64) ([]domain.Comment, error) {
+func (s *Service) ListByProduct(ctx context.Context, productID int64, page domain.CommentPage) (*domain.CommentList, error) {
 	if productID <= 0 {
 		return nil, domain.ValidationError("product id must be a positive integer")
 	}
+	if page.Limit < 0 {
+		return nil, domain.ValidationError("limit must be a positive integer")
+	}
 	if _, err := s.products.GetByID(ctx, productID); err != nil {
 		return nil, err
 	}
-	return s.comments.ListCommentsByProduct(ctx, productID)
+	return s.comments.ListCommentsByProduct(ctx, productID, page)
 }
 
 func (s *Service) Create(ctx context.Context, productID, userID int64, content string) (*domain.Comment, error) {
@@ -65,7 +68,8 @@ func (s *Service) Create(ctx context.Context, productID, userID int64, content s
 	return comment, nil
 }
 
-func (s *Service) Update(ctx context.Context, productID, commentID, userID int64, content string) (*domain.Comment, error) {
+// Update edits a comment's content; a non-zero version must match the stored comment.
+func (s *Service) Update(ctx context.Context, productID, commentID, userID int64, content string, version int64) (*domain.Comment, error) {
 	if productID <= 0 {
 		return nil, domain.ValidationError("product id must be a positive integer")
 	}
@@ -91,6 +95,9 @@ func (s *Service) Update(ctx context.Context, productID, commentID, userID int64
 	if existing.UserID != userID {
 		return nil, domain.ForbiddenError("cannot modify another user's comment")
 	}
+	if err := domain.CheckVersion(version, existing.Version); err != nil {
+		return nil, err
+	}
 
 	if err := existing.UpdateContent(trimmed); err != nil {
 		return nil, err
@@ -101,7 +108,8 @@ func (s *Service) Update(ctx context.Context, productID, commentID, userID int64
 	return existing, nil
 }
 
-func (s *Service) Delete(ctx context.Context, productID, commentID, userID int64) error {
+// Delete removes a comment; a non-zero version must match the stored comment.
+func (s *Service) Delete(ctx context.Context, productID, commentID, userID int64, version int64) error {
 	if productID <= 0 {
 		return domain.ValidationError("product id must be a positive integer")
 	}
@@ -122,6 +130,9 @@ func (s *Service) Delete(ctx context.Context, productID, commentID, userID int64
 	if existing.UserID != userID {
 		return domain.ForbiddenError("cannot delete another user's comment")
 	}
+	if err := domain.CheckVersion(version, existing.Version); err != nil {
+		return err
+	}
 
-	return s.comments.DeleteComment(ctx, commentID)
+	return s.comments.DeleteComment(ctx, commentID, existing.Version)
 }
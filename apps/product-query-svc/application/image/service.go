@@ -0,0 +1,150 @@
+package imageapp
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+)
+
+var _ inbound.ImageUseCases = (*Service)(nil)
+
+// Service manages the images of live products: metadata goes to the image repository and
+// content to the blob store.
+type Service struct {
+	images   outbound.ImageRepository
+	blobs    outbound.BlobStore
+	products outbound.ProductRepository
+}
+
+func NewService(images outbound.ImageRepository, blobs outbound.BlobStore, products outbound.ProductRepository) *Service {
+	return &Service{images: images, blobs: blobs, products: products}
+}
+
+func (s *Service) List(ctx context.Context, productID int64) ([]domain.ProductImage, error) {
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+	return s.images.ListImages(ctx, productID)
+}
+
+func (s *Service) FetchByID(ctx context.Context, productID, imageID int64) (*domain.ProductImage, error) {
+	if err := s.checkIDs(productID, imageID); err != nil {
+		return nil, err
+	}
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+	return s.images.GetImage(ctx, productID, imageID)
+}
+
+// Upload stores the content under a fresh key before recording the metadata, so an image is
+// never listed without content; the content is removed again if recording fails.
+func (s *Service) Upload(ctx context.Context, productID int64, contentType, altText string, data []byte) (*domain.ProductImage, error) {
+	image, err := domain.NewProductImage(productID, contentType, altText, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+	key, err := newBlobKey(image)
+	if err != nil {
+		return nil, err
+	}
+	image.BlobKey = key
+	if err := s.blobs.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	if err := s.images.CreateImage(ctx, image); err != nil {
+		_ = s.blobs.Delete(context.WithoutCancel(ctx), key)
+		return nil, err
+	}
+	return image, nil
+}
+
+// Update replaces an image's alt text and, when position is set, moves it there; positions past
+// the last image move it to the end.
+func (s *Service) Update(ctx context.Context, productID, imageID int64, altText string, position *int) (*domain.ProductImage, error) {
+	image, err := s.FetchByID(ctx, productID, imageID)
+	if err != nil {
+		return nil, err
+	}
+	if err := image.SetAltText(altText); err != nil {
+		return nil, err
+	}
+	if position != nil {
+		if *position < 0 {
+			return nil, domain.ValidationError("position must be >= 0")
+		}
+		image.Position = *position
+	}
+	if err := s.images.UpdateImage(ctx, image); err != nil {
+		return nil, err
+	}
+	return image, nil
+}
+
+// Delete removes an image's metadata and then its content. A failure to delete the content is
+// not reported: the image is already gone and an unreferenced blob is harmless.
+func (s *Service) Delete(ctx context.Context, productID, imageID int64) error {
+	if err := s.checkIDs(productID, imageID); err != nil {
+		return err
+	}
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return err
+	}
+	image, err := s.images.DeleteImage(ctx, productID, imageID)
+	if err != nil {
+		return err
+	}
+	_ = s.blobs.Delete(ctx, image.BlobKey)
+	return nil
+}
+
+func (s *Service) Open(ctx context.Context, productID, imageID int64) (*domain.ProductImage, io.ReadSeekCloser, error) {
+	image, err := s.FetchByID(ctx, productID, imageID)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := s.blobs.Open(ctx, image.BlobKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return image, content, nil
+}
+
+func (s *Service) checkIDs(productID, imageID int64) error {
+	if productID <= 0 {
+		return domain.ValidationError("product id must be a positive integer")
+	}
+	if imageID <= 0 {
+		return domain.ValidationError("image id must be a positive integer")
+	}
+	return nil
+}
+
+// checkProduct reports a missing or trashed product as domain.ErrNotFound.
+func (s *Service) checkProduct(ctx context.Context, productID int64) error {
+	if productID <= 0 {
+		return domain.ValidationError("product id must be a positive integer")
+	}
+	_, err := s.products.GetByID(ctx, productID)
+	return err
+}
+
+// newBlobKey returns a random key under the product's prefix; keys are never reused, so a
+// replaced or deleted image cannot be served from a stale key.
+func newBlobKey(image *domain.ProductImage) (string, error) {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return "", fmt.Errorf("generate blob key: %w", err)
+	}
+	return fmt.Sprintf("products/%d/%s%s", image.ProductID, hex.EncodeToString(buf[:]), domain.ImageExtension(image.ContentType)), nil
+}
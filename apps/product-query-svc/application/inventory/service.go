@@ -0,0 +1,94 @@
+package inventoryapp
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+)
+
+var _ inbound.InventoryUseCases = (*Service)(nil)
+
+// Service tracks the stock of live products and their variants.
+type Service struct {
+	inventory outbound.InventoryRepository
+	products  outbound.ProductRepository
+	variants  outbound.VariantRepository
+}
+
+func NewService(inventory outbound.InventoryRepository, products outbound.ProductRepository, variants outbound.VariantRepository) *Service {
+	return &Service{inventory: inventory, products: products, variants: variants}
+}
+
+func (s *Service) Stock(ctx context.Context, productID int64) ([]domain.StockLevel, error) {
+	if err := s.checkItem(ctx, productID, nil); err != nil {
+		return nil, err
+	}
+	return s.inventory.ListStock(ctx, productID)
+}
+
+// AdjustStock books goods in (positive delta) or out (negative delta) outside of reservations.
+func (s *Service) AdjustStock(ctx context.Context, productID int64, variantID *int64, delta int64) (*domain.StockLevel, error) {
+	if delta == 0 {
+		return nil, domain.ValidationError("delta must not be zero")
+	}
+	if err := s.checkItem(ctx, productID, variantID); err != nil {
+		return nil, err
+	}
+	return s.inventory.AdjustStock(ctx, productID, variantID, delta)
+}
+
+// Reserve holds quantity units of a product or variant until the reservation is committed or released.
+func (s *Service) Reserve(ctx context.Context, productID int64, variantID *int64, quantity int64) (*domain.Reservation, error) {
+	reservation, err := domain.NewReservation(productID, variantID, quantity)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkItem(ctx, productID, variantID); err != nil {
+		return nil, err
+	}
+	if err := s.inventory.Reserve(ctx, reservation); err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+func (s *Service) FetchReservation(ctx context.Context, id int64) (*domain.Reservation, error) {
+	if id <= 0 {
+		return nil, domain.ValidationError("id must be a positive integer")
+	}
+	return s.inventory.GetReservation(ctx, id)
+}
+
+func (s *Service) CommitReservation(ctx context.Context, id int64) (*domain.Reservation, error) {
+	if id <= 0 {
+		return nil, domain.ValidationError("id must be a positive integer")
+	}
+	return s.inventory.CommitReservation(ctx, id)
+}
+
+func (s *Service) ReleaseReservation(ctx context.Context, id int64) (*domain.Reservation, error) {
+	if id <= 0 {
+		return nil, domain.ValidationError("id must be a positive integer")
+	}
+	return s.inventory.ReleaseReservation(ctx, id)
+}
+
+// checkItem reports a missing or trashed product, or a variant of another product, as domain.ErrNotFound.
+func (s *Service) checkItem(ctx context.Context, productID int64, variantID *int64) error {
+	if productID <= 0 {
+		return domain.ValidationError("product id must be a positive integer")
+	}
+	if _, err := s.products.GetByID(ctx, productID); err != nil {
+		return err
+	}
+	if variantID == nil {
+		return nil
+	}
+	if *variantID <= 0 {
+		return domain.ValidationError("variant id must be a positive integer")
+	}
+	_, err := s.variants.GetVariant(ctx, productID, *variantID)
+	return err
+}
@@ -0,0 +1,24 @@
+package productapp
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// Export streams every product matching criteria's filters, in id order, to fn without
+// collecting them; prices are converted as in Search when criteria.Currency is set.
+func (s *Service) Export(ctx context.Context, criteria domain.ProductSearch, fn func(*domain.Product) error) error {
+	criteria = criteria.Normalize()
+	criteria.SortBy, criteria.Order, criteria.After = domain.SortByID, domain.SortAsc, nil
+	if err := criteria.Validate(); err != nil {
+		return err
+	}
+	rates := rateCache{}
+	return s.repository.ExportProducts(ctx, criteria, func(p *domain.Product) error {
+		if err := s.convertPrices(ctx, criteria.Currency, rates, p); err != nil {
+			return err
+		}
+		return fn(p)
+	})
+}
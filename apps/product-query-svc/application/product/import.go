@@ -0,0 +1,282 @@
+package productapp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// maxImportLine bounds a single NDJSON record.
+const maxImportLine = 1 << 20
+
+// csvTagSeparator splits the tags column of a CSV import.
+const csvTagSeparator = "|"
+
+// importItem pairs a validated product with the row it came from.
+type importItem struct {
+	row     domain.ImportRow
+	product *domain.Product
+}
+
+// Import validates every row of an import file and, unless opts.DryRun, writes the valid ones in
+// batches of opts.BatchSize, one transaction per batch. Rows with an id update that product,
+// the rest create drafts. Rows that fail validation, or whose batch is rejected by the
+// repository, are reported in the result instead of failing the import.
+func (s *Service) Import(ctx context.Context, src io.Reader, opts domain.ImportOptions) (*domain.ImportResult, error) {
+	result := &domain.ImportResult{DryRun: opts.DryRun}
+	rows, err := decodeImport(src, opts.Format, result)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize := opts.NormalizedBatchSize()
+	seen := make(map[int64]bool)
+	var creates, updates []importItem
+	flush := func() error {
+		err := s.writeImportBatch(ctx, creates, updates, result)
+		creates, updates = creates[:0], updates[:0]
+		return err
+	}
+	for _, row := range rows {
+		if row.ID == 0 {
+			product, err := row.Product()
+			if err != nil {
+				result.Reject(row.Line, 0, err)
+				continue
+			}
+			creates = append(creates, importItem{row: row, product: product})
+		} else {
+			if seen[row.ID] {
+				result.Reject(row.Line, row.ID, domain.ConflictError("product appears more than once in the import"))
+				continue
+			}
+			seen[row.ID] = true
+			product, err := s.repository.GetByID(ctx, row.ID)
+			if err != nil {
+				if errors.Is(err, domain.ErrNotFound) {
+					result.Reject(row.Line, row.ID, domain.ValidationError(fmt.Sprintf("product %d not found", row.ID)))
+					continue
+				}
+				return nil, err
+			}
+			if err := row.ApplyTo(product); err != nil {
+				result.Reject(row.Line, row.ID, err)
+				continue
+			}
+			updates = append(updates, importItem{row: row, product: product})
+		}
+		if len(creates)+len(updates) >= batchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	result.SortErrors()
+	return result, nil
+}
+
+// writeImportBatch stores one batch and records its outcome. A batch refused for a domain
+// reason (typically a concurrent write bumping a version) fails all of its rows; any other
+// error aborts the import, leaving earlier batches committed.
+func (s *Service) writeImportBatch(ctx context.Context, creates, updates []importItem, result *domain.ImportResult) error {
+	if len(creates)+len(updates) == 0 {
+		return nil
+	}
+	if !result.DryRun {
+		err := s.repository.ImportProducts(ctx, importProducts(creates), importProducts(updates))
+		if err != nil {
+			if !isDomainError(err) {
+				return err
+			}
+			for _, item := range append(creates, updates...) {
+				result.Reject(item.row.Line, item.row.ID, err)
+			}
+			return nil
+		}
+		s.suggest.clear()
+	}
+	result.Created += len(creates)
+	result.Updated += len(updates)
+	return nil
+}
+
+func importProducts(items []importItem) []*domain.Product {
+	out := make([]*domain.Product, len(items))
+	for i, item := range items {
+		out[i] = item.product
+	}
+	return out
+}
+
+func isDomainError(err error) bool {
+	for _, target := range []error{domain.ErrValidation, domain.ErrNotFound, domain.ErrConflict, domain.ErrPreconditionFailed} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeImport reads all rows of src. Rows that cannot be parsed are rejected in result;
+// a missing or malformed CSV header or an unreadable stream fails the whole import.
+func decodeImport(src io.Reader, format domain.ImportFormat, result *domain.ImportResult) ([]domain.ImportRow, error) {
+	switch format {
+	case domain.ImportCSV:
+		return decodeCSVImport(src, result)
+	case domain.ImportNDJSON:
+		return decodeNDJSONImport(src, result)
+	}
+	return nil, domain.ValidationError("import format must be csv or ndjson")
+}
+
+// decodeCSVImport expects a header row naming the columns id, version, name, priceCents,
+// currency and tags in any order; name and priceCents are required and tags are separated by "|".
+func decodeCSVImport(src io.Reader, result *domain.ImportResult) ([]domain.ImportRow, error) {
+	r := csv.NewReader(src)
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, domain.ValidationError("csv import needs a header row")
+	}
+	if err != nil {
+		return nil, domain.ValidationError(fmt.Sprintf("read csv header: %v", err))
+	}
+	r.FieldsPerRecord = len(header)
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		switch name {
+		case "id", "version", "name", "priceCents", "currency", "tags":
+		default:
+			return nil, domain.ValidationError(fmt.Sprintf("unknown csv column %q", name))
+		}
+		if _, dup := columns[name]; dup {
+			return nil, domain.ValidationError(fmt.Sprintf("duplicate csv column %q", name))
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"name", "priceCents"} {
+		if _, ok := columns[required]; !ok {
+			return nil, domain.ValidationError(fmt.Sprintf("csv header must include %s", required))
+		}
+	}
+
+	var rows []domain.ImportRow
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			result.Reject(parseErr.StartLine, 0, domain.ValidationError(parseErr.Err.Error()))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		row, err := csvImportRow(columns, record)
+		row.Line = line
+		if err != nil {
+			result.Reject(line, row.ID, err)
+			continue
+		}
+		rows = append(rows, row)
+	}
+}
+
+func csvImportRow(columns map[string]int, record []string) (domain.ImportRow, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	var row domain.ImportRow
+	for _, c := range []struct {
+		name string
+		dest *int64
+	}{{"id", &row.ID}, {"version", &row.Version}, {"priceCents", &row.Price}} {
+		raw := field(c.name)
+		if raw == "" {
+			if c.name == "priceCents" {
+				return row, domain.ValidationError("priceCents required")
+			}
+			continue
+		}
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return row, domain.ValidationError(fmt.Sprintf("%s must be an integer", c.name))
+		}
+		*c.dest = v
+	}
+	row.Name = field("name")
+	row.Currency = field("currency")
+	if tags := field("tags"); tags != "" {
+		row.Tags = strings.Split(tags, csvTagSeparator)
+	}
+	return row, nil
+}
+
+// ndjsonImportRow is one line of an NDJSON import.
+type ndjsonImportRow struct {
+	ID         int64    `json:"id"`
+	Version    int64    `json:"version"`
+	Name       string   `json:"name"`
+	PriceCents *int64   `json:"priceCents"`
+	Currency   string   `json:"currency"`
+	Tags       []string `json:"tags"`
+}
+
+// decodeNDJSONImport reads one JSON object per line; blank lines are skipped.
+func decodeNDJSONImport(src io.Reader, result *domain.ImportResult) ([]domain.ImportRow, error) {
+	scanner := bufio.NewScanner(src)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLine)
+	var rows []domain.ImportRow
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		var in ndjsonImportRow
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&in); err != nil || dec.More() {
+			result.Reject(line, 0, domain.ValidationError("line is not a valid product object"))
+			continue
+		}
+		if in.PriceCents == nil {
+			result.Reject(line, in.ID, domain.ValidationError("priceCents required"))
+			continue
+		}
+		rows = append(rows, domain.ImportRow{
+			Line:     line,
+			ID:       in.ID,
+			Version:  in.Version,
+			Name:     in.Name,
+			Price:    *in.PriceCents,
+			Currency: in.Currency,
+			Tags:     in.Tags,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, domain.ValidationError("ndjson line exceeds 1 MiB")
+		}
+		return nil, err
+	}
+	return rows, nil
+}
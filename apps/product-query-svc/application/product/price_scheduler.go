@@ -0,0 +1,48 @@
+package productapp
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
+)
+
+// priceChangeBatchSize bounds how many scheduled prices one repository call applies.
+const priceChangeBatchSize = 100
+
+// PriceScheduler periodically applies scheduled prices that have come into effect.
+// Running it on several replicas is safe; repositories never apply a change twice.
+type PriceScheduler struct {
+	products inbound.ProductUseCases
+	interval time.Duration
+}
+
+func NewPriceScheduler(products inbound.ProductUseCases, interval time.Duration) *PriceScheduler {
+	return &PriceScheduler{products: products, interval: interval}
+}
+
+// Run applies due changes immediately and then on every tick until ctx is cancelled.
+func (s *PriceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.tick(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PriceScheduler) tick(ctx context.Context) {
+	changes, err := s.products.ApplyDuePriceChanges(ctx)
+	if err != nil && ctx.Err() == nil {
+		log.Printf("price scheduler: %v", err)
+	}
+	for _, c := range changes {
+		log.Printf("price scheduler: product %d change %d %s (%d %s effective %s)",
+			c.ProductID, c.ID, c.Status, c.Price, c.Currency, c.EffectiveFrom.Format(time.RFC3339))
+	}
+}
@@ -2,6 +2,10 @@ package productapp
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
@@ -10,43 +14,379 @@ import (
 
 var _ inbound.ProductUseCases = (*Service)(nil)
 
+// maxWriteAttempts bounds how often an unconditional read-modify-write is retried after losing a race.
+const maxWriteAttempts = 3
+
 // Service orchestrates product-related use cases across outbound dependencies.
 type Service struct {
-	repository outbound.ProductRepository
+	repository   outbound.ProductRepository
+	categories   outbound.CategoryRepository
+	translations outbound.TranslationRepository
+	blobs        outbound.BlobStore
+	rates        outbound.ExchangeRateProvider
+	suggest      *suggestCache
+	now          func() time.Time
 }
 
-func NewService(repository outbound.ProductRepository) *Service {
-	return &Service{repository: repository}
+// NewService wires the product use cases. blobs holds image content, which PurgeTrash deletes
+// along with the products. rates may be nil, in which case prices can only be shown in a
+// product's own currency or one of its overrides.
+func NewService(repository outbound.ProductRepository, categories outbound.CategoryRepository, translations outbound.TranslationRepository, blobs outbound.BlobStore, rates outbound.ExchangeRateProvider) *Service {
+	return &Service{
+		repository:   repository,
+		categories:   categories,
+		translations: translations,
+		blobs:        blobs,
+		rates:        rates,
+		suggest:      newSuggestCache(defaultSuggestCacheTTL, defaultSuggestCacheEntries),
+		now:          time.Now,
+	}
 }
 
-func (s *Service) FetchByID(ctx context.Context, id int64) (*domain.Product, error) {
-	return s.repository.GetByID(ctx, id)
+// FetchByID loads a product; a non-empty currency converts its price for display and locales,
+// a lookup chain from domain.ParseAcceptLanguage, picks the translation shown.
+func (s *Service) FetchByID(ctx context.Context, id int64, currency string, locales []string) (*domain.Product, error) {
+	if currency != "" {
+		var err error
+		if currency, err = domain.NormalizeCurrency(currency); err != nil {
+			return nil, err
+		}
+	}
+	product, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.localize(ctx, product, locales); err != nil {
+		return nil, err
+	}
+	if err := s.convertPrices(ctx, currency, rateCache{}, product); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// FetchBySlug loads a product by its current or a former slug, matched case-insensitively.
+func (s *Service) FetchBySlug(ctx context.Context, slug string, currency string, locales []string) (*domain.Product, error) {
+	slug, err := domain.NormalizeSlug(slug)
+	if err != nil {
+		return nil, err
+	}
+	if currency != "" {
+		if currency, err = domain.NormalizeCurrency(currency); err != nil {
+			return nil, err
+		}
+	}
+	product, err := s.repository.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.localize(ctx, product, locales); err != nil {
+		return nil, err
+	}
+	if err := s.convertPrices(ctx, currency, rateCache{}, product); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+func (s *Service) Search(ctx context.Context, criteria domain.ProductSearch) (*domain.ProductSearchResult, error) {
+	criteria = criteria.Normalize()
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+	result, err := s.repository.Search(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*domain.Product, len(result.Items))
+	for i := range result.Items {
+		items[i] = &result.Items[i]
+	}
+	if err := s.convertPrices(ctx, criteria.Currency, rateCache{}, items...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// localize swaps in the product's first translation along locales; an empty chain keeps the
+// product's own text without a lookup.
+func (s *Service) localize(ctx context.Context, product *domain.Product, locales []string) error {
+	if len(locales) == 0 {
+		return nil
+	}
+	translations, err := s.translations.ListTranslations(ctx, product.ID)
+	if err != nil {
+		return err
+	}
+	product.Localize(translations, locales)
+	return nil
+}
+
+// rateCache holds the rates to one target currency already fetched, keyed by source currency.
+type rateCache map[string]*big.Rat
+
+// convertPrices rewrites each product's price into currency, asking the rate provider at most
+// once per source currency while rates is reused. An empty currency leaves prices as stored.
+func (s *Service) convertPrices(ctx context.Context, currency string, rates rateCache, products ...*domain.Product) error {
+	if currency == "" {
+		return nil
+	}
+	for _, p := range products {
+		var rate *big.Rat
+		if p.NeedsRate(currency) {
+			from := p.BaseCurrency()
+			var ok bool
+			if rate, ok = rates[from]; !ok {
+				if s.rates == nil {
+					return domain.UnsupportedCurrencyError(currency)
+				}
+				var err error
+				if rate, err = s.rates.Rate(ctx, from, currency); err != nil {
+					return err
+				}
+				rates[from] = rate
+			}
+		}
+		if err := p.ConvertTo(currency, rate); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (s *Service) Search(ctx context.Context, query string, page, pageSize int) ([]domain.Product, int, error) {
-	return s.repository.Search(ctx, query, page, pageSize)
+func (s *Service) Suggest(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error) {
+	prefix, err := domain.NormalizeSuggestPrefix(prefix)
+	if err != nil {
+		return nil, err
+	}
+	limit = domain.NormalizeSuggestLimit(limit)
+
+	key := suggestCacheKey(prefix, limit)
+	if items, ok := s.suggest.get(key); ok {
+		return items, nil
+	}
+	candidates, err := s.repository.Suggest(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	items := domain.RankSuggestions(prefix, candidates, limit)
+	s.suggest.put(key, items)
+	return items, nil
 }
 
-func (s *Service) Remove(ctx context.Context, id int64) error {
-	return s.repository.Delete(ctx, id)
+// Remove moves the product to the trash; Restore brings it back until PurgeTrash removes it.
+// A non-zero version must match the stored product.
+func (s *Service) Remove(ctx context.Context, id int64, version int64) error {
+	if err := s.repository.Delete(ctx, id, version); err != nil {
+		return err
+	}
+	s.suggest.clear()
+	return nil
 }
 
 func (s *Service) Create(ctx context.Context, product *domain.Product) (int64, error) {
 	if err := product.Validate(); err != nil {
 		return 0, err
 	}
-	return s.repository.Create(ctx, product)
+	if err := s.resolveCategory(ctx, product); err != nil {
+		return 0, err
+	}
+	id, err := s.repository.Create(ctx, product)
+	if err != nil {
+		return 0, err
+	}
+	s.suggest.clear()
+	return id, nil
 }
 
+// Update replaces a product; a non-zero product.Version must match the stored product.
 func (s *Service) Update(ctx context.Context, product *domain.Product) (*domain.Product, error) {
 	if product.ID <= 0 {
 		return nil, domain.ValidationError("id must be a positive integer")
 	}
-	if err := product.Validate(); err != nil {
+	replacement := *product
+	_, err := s.modify(ctx, product.ID, product.Version, func(stored *domain.Product) error {
+		next := replacement
+		next.Version = stored.Version
+		// status only changes through Publish/Archive/Unarchive
+		next.KeepLifecycle(stored)
+		if err := next.Validate(); err != nil {
+			return err
+		}
+		if err := s.resolveCategory(ctx, &next); err != nil {
+			return err
+		}
+		*stored = next
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	if err := s.repository.Update(ctx, product); err != nil {
+	return s.repository.GetByID(ctx, product.ID)
+}
+
+// Patch applies only the fields present in patch to the stored product; a non-zero version must
+// match it. An empty patch returns the product unchanged without writing.
+func (s *Service) Patch(ctx context.Context, id int64, patch domain.ProductPatch, version int64) (*domain.Product, error) {
+	if patch.IsEmpty() {
+		product, err := s.repository.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := domain.CheckVersion(version, product.Version); err != nil {
+			return nil, err
+		}
+		return product, nil
+	}
+	return s.modify(ctx, id, version, func(p *domain.Product) error {
+		if err := p.ApplyPatch(patch); err != nil {
+			return err
+		}
+		return s.resolveCategory(ctx, p)
+	})
+}
+
+// resolveCategory checks that the product's category exists and fills in its breadcrumb.
+func (s *Service) resolveCategory(ctx context.Context, product *domain.Product) error {
+	product.Category = nil
+	if product.CategoryID == nil {
+		return nil
+	}
+	category, err := s.categories.GetCategory(ctx, *product.CategoryID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ValidationError(fmt.Sprintf("category %d not found", *product.CategoryID))
+		}
+		return err
+	}
+	product.Category = category.Path
+	return nil
+}
+
+// Publish, Archive and Unarchive apply a lifecycle change; a non-zero version must match the
+// stored product.
+func (s *Service) Publish(ctx context.Context, id int64, version int64) (*domain.Product, error) {
+	return s.modify(ctx, id, version, func(p *domain.Product) error { return p.Publish(s.now()) })
+}
+
+func (s *Service) Archive(ctx context.Context, id int64, version int64) (*domain.Product, error) {
+	return s.modify(ctx, id, version, (*domain.Product).Archive)
+}
+
+func (s *Service) Unarchive(ctx context.Context, id int64, version int64) (*domain.Product, error) {
+	return s.modify(ctx, id, version, (*domain.Product).Unarchive)
+}
+
+// AddTag and RemoveTag change the product's tags; a non-zero version must match the stored product.
+func (s *Service) AddTag(ctx context.Context, id int64, tag string, version int64) (*domain.Product, error) {
+	return s.modify(ctx, id, version, func(p *domain.Product) error { return p.AddTag(tag) })
+}
+
+func (s *Service) RemoveTag(ctx context.Context, id int64, tag string, version int64) (*domain.Product, error) {
+	return s.modify(ctx, id, version, func(p *domain.Product) error {
+		p.RemoveTag(tag)
+		return nil
+	})
+}
+
+// modify reads the product, applies change and writes it back conditional on the version read.
+// With a version the caller owns the conflict and gets ErrPreconditionFailed; without one, a
+// write that lost a race is retried on a fresh read so the change lands on the latest state, and
+// a caller that never sent a precondition gets a conflict once the retries run out.
+func (s *Service) modify(ctx context.Context, id int64, version int64, change func(*domain.Product) error) (*domain.Product, error) {
+	for attempt := 1; ; attempt++ {
+		product, err := s.repository.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := domain.CheckVersion(version, product.Version); err != nil {
+			return nil, err
+		}
+		if err := change(product); err != nil {
+			return nil, err
+		}
+		err = s.repository.Update(ctx, product)
+		if err == nil {
+			// tags and visibility both feed suggestions
+			s.suggest.clear()
+			return product, nil
+		}
+		if version != 0 || !errors.Is(err, domain.ErrPreconditionFailed) {
+			return nil, err
+		}
+		if attempt == maxWriteAttempts {
+			return nil, domain.ConflictError("product keeps changing concurrently, retry the request")
+		}
+	}
+}
+
+// SchedulePrice books a future price in the product's current currency.
+func (s *Service) SchedulePrice(ctx context.Context, productID int64, priceCents int64, effectiveFrom time.Time) (*domain.PriceChange, error) {
+	product, err := s.repository.GetByID(ctx, productID)
+	if err != nil {
 		return nil, err
 	}
-	return s.repository.GetByID(ctx, product.ID)
+	change, err := domain.SchedulePriceChange(product, priceCents, effectiveFrom, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repository.SchedulePriceChange(ctx, change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+func (s *Service) PriceHistory(ctx context.Context, productID int64) ([]domain.PriceChange, error) {
+	return s.repository.ListPriceHistory(ctx, productID)
+}
+
+// ApplyDuePriceChanges applies every scheduled price that has come into effect, in batches.
+func (s *Service) ApplyDuePriceChanges(ctx context.Context) ([]domain.PriceChange, error) {
+	now := s.now()
+	var applied []domain.PriceChange
+	for {
+		batch, err := s.repository.ApplyDuePriceChanges(ctx, now, priceChangeBatchSize)
+		if err != nil {
+			return applied, err
+		}
+		applied = append(applied, batch...)
+		if len(batch) < priceChangeBatchSize {
+			return applied, nil
+		}
+	}
+}
+
+// Restore takes a product out of the trash with its lifecycle status unchanged.
+func (s *Service) Restore(ctx context.Context, id int64) (*domain.Product, error) {
+	if err := s.repository.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+	s.suggest.clear()
+	return s.repository.GetByID(ctx, id)
+}
+
+// Trash lists trashed products, most recently deleted first.
+func (s *Service) Trash(ctx context.Context, page domain.TrashPage) (*domain.TrashList, error) {
+	return s.repository.ListDeleted(ctx, page.Normalize())
+}
+
+// PurgeTrash permanently removes products that have been in the trash longer than retention, in
+// batches, together with their image content.
+func (s *Service) PurgeTrash(ctx context.Context, retention time.Duration) (int, error) {
+	if retention < 0 {
+		return 0, domain.ValidationError("retention must be >= 0")
+	}
+	cutoff := s.now().Add(-retention)
+	purged := 0
+	for {
+		n, blobKeys, err := s.repository.PurgeDeleted(ctx, cutoff, trashPurgeBatchSize)
+		purged += n
+		// the metadata is gone either way, so a failed delete only leaves an orphaned blob behind
+		for _, key := range blobKeys {
+			_ = s.blobs.Delete(ctx, key)
+		}
+		if err != nil || n < trashPurgeBatchSize {
+			return purged, err
+		}
+	}
 }
@@ -0,0 +1,91 @@
+package productapp
+
+import (
+	"container/list"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+const (
+	defaultSuggestCacheTTL     = 30 * time.Second
+	defaultSuggestCacheEntries = 512
+)
+
+// suggestCache is a small LRU with a TTL for hot type-ahead prefixes.
+// Writes through this service clear it; the TTL bounds staleness from other instances.
+type suggestCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	max     int
+	now     func() time.Time
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type suggestCacheEntry struct {
+	key       string
+	items     []domain.Suggestion
+	expiresAt time.Time
+}
+
+func newSuggestCache(ttl time.Duration, max int) *suggestCache {
+	return &suggestCache{
+		ttl:     ttl,
+		max:     max,
+		now:     time.Now,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func suggestCacheKey(prefix string, limit int) string {
+	return strconv.Itoa(limit) + ":" + prefix
+}
+
+func (c *suggestCache) get(key string) ([]domain.Suggestion, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*suggestCacheEntry)
+	if c.now().After(entry.expiresAt) {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return cloneSuggestions(entry.items), true
+}
+
+func (c *suggestCache) put(key string, items []domain.Suggestion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := &suggestCacheEntry{key: key, items: cloneSuggestions(items), expiresAt: c.now().Add(c.ttl)}
+	if el, ok := c.entries[key]; ok {
+		el.Value = entry
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*suggestCacheEntry).key)
+	}
+}
+
+func (c *suggestCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+func cloneSuggestions(items []domain.Suggestion) []domain.Suggestion {
+	return append([]domain.Suggestion{}, items...)
+}
@@ -0,0 +1,47 @@
+package productapp
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
+)
+
+// trashPurgeBatchSize bounds how many trashed products one repository call removes.
+const trashPurgeBatchSize = 100
+
+// TrashPurger periodically hard-deletes products that have been in the trash longer than retention.
+type TrashPurger struct {
+	products  inbound.ProductUseCases
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewTrashPurger(products inbound.ProductUseCases, retention, interval time.Duration) *TrashPurger {
+	return &TrashPurger{products: products, retention: retention, interval: interval}
+}
+
+// Run purges expired products immediately and then on every tick until ctx is cancelled.
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.tick(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) tick(ctx context.Context) {
+	n, err := p.products.PurgeTrash(ctx, p.retention)
+	if err != nil && ctx.Err() == nil {
+		log.Printf("trash purger: %v", err)
+	}
+	if n > 0 {
+		log.Printf("trash purger: removed %d products trashed more than %s ago", n, p.retention)
+	}
+}
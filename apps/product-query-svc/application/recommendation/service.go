@@ -0,0 +1,32 @@
+package recommendationapp
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+)
+
+var _ inbound.RecommendationQueries = (*Service)(nil)
+
+// Service recommends products related to a live product; the scoring itself is left to the
+// recommendation source so a different engine can be plugged in.
+type Service struct {
+	source   outbound.RecommendationSource
+	products outbound.ProductRepository
+}
+
+func NewService(source outbound.RecommendationSource, products outbound.ProductRepository) *Service {
+	return &Service{source: source, products: products}
+}
+
+func (s *Service) Related(ctx context.Context, query domain.RelatedQuery) ([]domain.RelatedProduct, error) {
+	if query.ProductID <= 0 {
+		return nil, domain.ValidationError("product id must be a positive integer")
+	}
+	if _, err := s.products.GetByID(ctx, query.ProductID); err != nil {
+		return nil, err
+	}
+	return s.source.Related(ctx, query.Normalize())
+}
@@ -0,0 +1,64 @@
+package translationapp
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+)
+
+var _ inbound.TranslationUseCases = (*Service)(nil)
+
+// Service manages the translations of live products; translations of trashed products are hidden with them.
+type Service struct {
+	translations outbound.TranslationRepository
+	products     outbound.ProductRepository
+}
+
+func NewService(translations outbound.TranslationRepository, products outbound.ProductRepository) *Service {
+	return &Service{translations: translations, products: products}
+}
+
+func (s *Service) List(ctx context.Context, productID int64) ([]domain.ProductTranslation, error) {
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+	return s.translations.ListTranslations(ctx, productID)
+}
+
+func (s *Service) Put(ctx context.Context, productID int64, locale, name, description string) (*domain.ProductTranslation, bool, error) {
+	translation, err := domain.NewProductTranslation(productID, locale, name, description)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, false, err
+	}
+	created, err := s.translations.PutTranslation(ctx, translation)
+	if err != nil {
+		return nil, false, err
+	}
+	return translation, created, nil
+}
+
+// Delete accepts the locale in any case or separator style NormalizeLocale does.
+func (s *Service) Delete(ctx context.Context, productID int64, locale string) error {
+	locale, err := domain.NormalizeLocale(locale)
+	if err != nil {
+		return err
+	}
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return err
+	}
+	return s.translations.DeleteTranslation(ctx, productID, locale)
+}
+
+// checkProduct reports a missing or trashed product as domain.ErrNotFound.
+func (s *Service) checkProduct(ctx context.Context, productID int64) error {
+	if productID <= 0 {
+		return domain.ValidationError("product id must be a positive integer")
+	}
+	_, err := s.products.GetByID(ctx, productID)
+	return err
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
 )
 
-var _ inbound.UserQueries = (*Service)(nil)
+var _ inbound.UserUseCases = (*Service)(nil)
 
 // Service exposes user-specific use cases backed by a persistent repository.
 type Service struct {
@@ -25,3 +25,52 @@ func (s *Service) FetchByID(ctx context.Context, id int64) (*domain.User, error)
 	}
 	return s.repository.FindByID(ctx, id)
 }
+
+// Search lists users matching the criteria, one page at a time.
+func (s *Service) Search(ctx context.Context, criteria domain.UserSearch) (*domain.UserSearchResult, error) {
+	criteria = criteria.Normalize()
+	if err := criteria.Validate(); err != nil {
+		return nil, err
+	}
+	return s.repository.SearchUsers(ctx, criteria)
+}
+
+// Create registers a user; the repository rejects an email another user already has.
+func (s *Service) Create(ctx context.Context, name, email string) (*domain.User, error) {
+	user, err := domain.NewUser(name, email)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repository.CreateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Update replaces the user's name and email.
+func (s *Service) Update(ctx context.Context, id int64, name, email string) (*domain.User, error) {
+	if id <= 0 {
+		return nil, domain.ValidationError("id must be a positive integer")
+	}
+	user, err := s.repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := user.ChangeName(name); err != nil {
+		return nil, err
+	}
+	if err := user.ChangeEmail(email); err != nil {
+		return nil, err
+	}
+	if err := s.repository.UpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return domain.ValidationError("id must be a positive integer")
+	}
+	return s.repository.DeleteUser(ctx, id)
+}
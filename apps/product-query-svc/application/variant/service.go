@@ -0,0 +1,123 @@
+package variantapp
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/inbound"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
+)
+
+var _ inbound.VariantUseCases = (*Service)(nil)
+
+// Service manages the variants of live products; variants of trashed products are hidden with them.
+type Service struct {
+	variants outbound.VariantRepository
+	products outbound.ProductRepository
+}
+
+func NewService(variants outbound.VariantRepository, products outbound.ProductRepository) *Service {
+	return &Service{variants: variants, products: products}
+}
+
+func (s *Service) List(ctx context.Context, productID int64) ([]domain.Variant, error) {
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+	return s.variants.ListVariants(ctx, productID)
+}
+
+func (s *Service) FetchByID(ctx context.Context, productID, variantID int64) (*domain.Variant, error) {
+	if err := s.checkIDs(productID, variantID); err != nil {
+		return nil, err
+	}
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+	return s.variants.GetVariant(ctx, productID, variantID)
+}
+
+// Create adds a variant to a product; priceCents nil sells it at the product's price.
+func (s *Service) Create(ctx context.Context, productID int64, sku string, attributes map[string]string, priceCents *int64) (*domain.Variant, error) {
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+	variant, err := domain.NewVariant(productID, sku, attributes, priceCents)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkSiblings(ctx, variant); err != nil {
+		return nil, err
+	}
+	if _, err := s.variants.CreateVariant(ctx, variant); err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+// Update replaces a variant's SKU, attributes and price; a non-zero version must match the stored variant.
+func (s *Service) Update(ctx context.Context, productID, variantID int64, sku string, attributes map[string]string, priceCents *int64, version int64) (*domain.Variant, error) {
+	if err := s.checkIDs(productID, variantID); err != nil {
+		return nil, err
+	}
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+	variant, err := s.variants.GetVariant(ctx, productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.CheckVersion(version, variant.Version); err != nil {
+		return nil, err
+	}
+	if err := variant.Update(sku, attributes, priceCents); err != nil {
+		return nil, err
+	}
+	if err := s.checkSiblings(ctx, variant); err != nil {
+		return nil, err
+	}
+	if err := s.variants.UpdateVariant(ctx, variant); err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+// Delete removes a variant; a non-zero version must match the stored variant.
+func (s *Service) Delete(ctx context.Context, productID, variantID int64, version int64) error {
+	if err := s.checkIDs(productID, variantID); err != nil {
+		return err
+	}
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return err
+	}
+	return s.variants.DeleteVariant(ctx, productID, variantID, version)
+}
+
+func (s *Service) checkIDs(productID, variantID int64) error {
+	if productID <= 0 {
+		return domain.ValidationError("product id must be a positive integer")
+	}
+	if variantID <= 0 {
+		return domain.ValidationError("variant id must be a positive integer")
+	}
+	return nil
+}
+
+// checkProduct reports a missing or trashed product as domain.ErrNotFound.
+func (s *Service) checkProduct(ctx context.Context, productID int64) error {
+	if productID <= 0 {
+		return domain.ValidationError("product id must be a positive integer")
+	}
+	_, err := s.products.GetByID(ctx, productID)
+	return err
+}
+
+// checkSiblings gives a precise error for clashes within the product before the repository's
+// unique indexes, which also cover SKUs of other products, have the final say.
+func (s *Service) checkSiblings(ctx context.Context, variant *domain.Variant) error {
+	siblings, err := s.variants.ListVariants(ctx, variant.ProductID)
+	if err != nil {
+		return err
+	}
+	return domain.CheckVariantUnique(siblings, variant)
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxCategoryNameLength 限制分类名称的字符数。
+const MaxCategoryNameLength = 80
+
+// Category 是商品分类树中的一个节点，以邻接表保存：ParentID 为 nil 表示顶级分类。
+// 同一父分类下的名称不区分大小写唯一；Path 是从顶级分类到自身的面包屑，由仓储在读取时填充。
+// Version 与 UpdatedAt 的语义同 Product。
+type Category struct {
+	ID        int64
+	Name      string
+	ParentID  *int64
+	Path      CategoryPath
+	Version   int64
+	UpdatedAt time.Time
+}
+
+// CategoryRef 是面包屑中的一级分类。
+type CategoryRef struct {
+	ID   int64
+	Name string
+}
+
+// CategoryPath 是从顶级分类开始、到某个分类为止的面包屑。
+type CategoryPath []CategoryRef
+
+// NewCategory 构建并校验分类，parentID 为 nil 时创建顶级分类。
+func NewCategory(name string, parentID *int64) (*Category, error) {
+	c := &Category{}
+	if err := c.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := c.MoveTo(parentID); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate 检查分类的不变式；父分类是否存在、是否成环由应用层与仓储检查。
+func (c *Category) Validate() error {
+	name := strings.TrimSpace(c.Name)
+	if name == "" {
+		return ValidationError("category name required")
+	}
+	if utf8.RuneCountInString(name) > MaxCategoryNameLength {
+		return ValidationError("category name too long")
+	}
+	if c.ParentID != nil {
+		if *c.ParentID <= 0 {
+			return ValidationError("parent id must be a positive integer")
+		}
+		if *c.ParentID == c.ID {
+			return CategoryCycleError()
+		}
+	}
+	return nil
+}
+
+// Rename 修改分类名称（去除首尾空白后不能为空）。
+func (c *Category) Rename(name string) error {
+	cleaned := strings.TrimSpace(name)
+	if cleaned == "" {
+		return ValidationError("category name required")
+	}
+	if utf8.RuneCountInString(cleaned) > MaxCategoryNameLength {
+		return ValidationError("category name too long")
+	}
+	c.Name = cleaned
+	return nil
+}
+
+// MoveTo 把分类挂到 parentID 之下，nil 表示移动为顶级分类。
+func (c *Category) MoveTo(parentID *int64) error {
+	if parentID != nil {
+		if *parentID <= 0 {
+			return ValidationError("parent id must be a positive integer")
+		}
+		if *parentID == c.ID {
+			return CategoryCycleError()
+		}
+		id := *parentID
+		parentID = &id
+	}
+	c.ParentID = parentID
+	return nil
+}
+
+// CategoryCycleError 表示要把分类移动到自身或其子孙分类之下。
+func CategoryCycleError() error {
+	return ValidationError("category cannot be moved under itself or one of its subcategories")
+}
+
+// CategoryInUseError 表示分类下仍有子分类或商品（包括回收站中的商品），不能删除。
+func CategoryInUseError() error {
+	return ConflictError("category still has subcategories or products")
+}
+
+// DuplicateCategoryError 表示同一父分类下已有同名分类。
+func DuplicateCategoryError() error {
+	return ConflictError("a category with this name already exists under the same parent")
+}
+
+// BuildCategoryPaths 为一组完整的分类（通常是全部分类）填充 Path，父分类不在 all 中的分类只包含已知部分。
+func BuildCategoryPaths(all []Category) {
+	byID := make(map[int64]*Category, len(all))
+	for i := range all {
+		byID[all[i].ID] = &all[i]
+	}
+	for i := range all {
+		var path CategoryPath
+		seen := make(map[int64]bool)
+		for c := &all[i]; c != nil && !seen[c.ID]; {
+			seen[c.ID] = true
+			path = append(path, CategoryRef{ID: c.ID, Name: c.Name})
+			if c.ParentID == nil {
+				break
+			}
+			c = byID[*c.ParentID]
+		}
+		for l, r := 0, len(path)-1; l < r; l, r = l+1, r-1 {
+			path[l], path[r] = path[r], path[l]
+		}
+		all[i].Path = path
+	}
+}
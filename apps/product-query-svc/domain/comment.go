@@ -10,7 +10,8 @@ const (
 	MaxCommentLength = 2048
 )
 
-// Comment represents a user-authored note attached to a product.
+// Comment represents a user-authored note attached to a product. Version starts at 1 and is
+// bumped by every update; repositories reject updates and deletes carrying a stale non-zero Version.
 type Comment struct {
 	ID        int64
 	ProductID int64
@@ -18,6 +19,7 @@ type Comment struct {
 	Content   string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	Version   int64
 }
 
 // NewComment validates and constructs a comment bound to a product and author.
@@ -75,3 +77,29 @@ func (c *Comment) updateContent(content string) error {
 	c.Content = content
 	return nil
 }
+
+// CommentCursor marks the last comment of a page in newest-first (created_at, id) order.
+type CommentCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// Precedes reports whether c comes strictly after the cursor in newest-first order.
+func (cur CommentCursor) Precedes(c Comment) bool {
+	if c.CreatedAt.Equal(cur.CreatedAt) {
+		return c.ID < cur.ID
+	}
+	return c.CreatedAt.Before(cur.CreatedAt)
+}
+
+// CommentPage selects a window of a product's comments; a zero Limit returns every comment.
+type CommentPage struct {
+	Limit int
+	After *CommentCursor
+}
+
+// CommentList is one page of comments; Next is set when more comments follow.
+type CommentList struct {
+	Items []Comment
+	Next  *CommentCursor
+}
@@ -6,6 +6,9 @@ var (
 	ErrValidation = errors.New("validation error")
 	ErrNotFound   = errors.New("not found")
 	ErrForbidden  = errors.New("forbidden")
+	ErrConflict   = errors.New("conflict")
+	// ErrPreconditionFailed reports a write made against a stale version of a resource.
+	ErrPreconditionFailed = errors.New("precondition failed")
 )
 
 // ValidationError wraps ErrValidation with a more specific message.
@@ -17,3 +20,21 @@ func ValidationError(msg string) error {
 func ForbiddenError(msg string) error {
 	return errors.Join(ErrForbidden, errors.New(msg))
 }
+
+// ConflictError wraps ErrConflict for requests that clash with the current state of a resource.
+func ConflictError(msg string) error {
+	return errors.Join(ErrConflict, errors.New(msg))
+}
+
+// PreconditionFailedError wraps ErrPreconditionFailed when a caller's expected version is out of date.
+func PreconditionFailedError(msg string) error {
+	return errors.Join(ErrPreconditionFailed, errors.New(msg))
+}
+
+// CheckVersion compares the version a caller last saw with the stored one; 0 skips the check.
+func CheckVersion(expected, current int64) error {
+	if expected != 0 && expected != current {
+		return PreconditionFailedError("resource has been modified")
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxImageBytes 限制单张商品图片的大小。
+	MaxImageBytes = 5 << 20
+	// MaxProductImages 限制单个商品的图片数量。
+	MaxProductImages = 20
+	// MaxImageAltTextLength 限制图片替代文本的字符数。
+	MaxImageAltTextLength = 200
+)
+
+// imageTypes 是支持的图片类型、保存时使用的扩展名及其文件头，上传的内容必须与声明的类型一致。
+var imageTypes = []struct {
+	contentType string
+	extension   string
+	matches     func(data []byte) bool
+}{
+	{"image/jpeg", ".jpg", func(b []byte) bool { return bytes.HasPrefix(b, []byte{0xFF, 0xD8, 0xFF}) }},
+	{"image/png", ".png", func(b []byte) bool { return bytes.HasPrefix(b, []byte("\x89PNG\r\n\x1a\n")) }},
+	{"image/gif", ".gif", func(b []byte) bool {
+		return bytes.HasPrefix(b, []byte("GIF87a")) || bytes.HasPrefix(b, []byte("GIF89a"))
+	}},
+	{"image/webp", ".webp", func(b []byte) bool { return len(b) >= 12 && string(b[:4]) == "RIFF" && string(b[8:12]) == "WEBP" }},
+}
+
+// ProductImage 是商品图片的元数据，图片内容按 BlobKey 保存在 blob 存储中。
+// Position 是图片在商品内从 0 开始的连续序号，第 0 张为主图；Checksum 是内容的 SHA-256（十六进制），内容不可变，兼作 HTTP 缓存校验。
+type ProductImage struct {
+	ID          int64
+	ProductID   int64
+	Position    int
+	AltText     string
+	ContentType string
+	Size        int64
+	Checksum    string
+	BlobKey     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewProductImage 校验上传的图片内容并构建元数据；contentType 为空或 application/octet-stream 时按文件头识别。
+// 位置由仓储追加到末尾时分配，BlobKey 由调用方在保存内容前设置。
+func NewProductImage(productID int64, contentType, altText string, data []byte) (*ProductImage, error) {
+	if productID <= 0 {
+		return nil, ValidationError("product id must be a positive integer")
+	}
+	if len(data) == 0 {
+		return nil, ValidationError("image file is empty")
+	}
+	if len(data) > MaxImageBytes {
+		return nil, ValidationError(fmt.Sprintf("image exceeds %d bytes", MaxImageBytes))
+	}
+	detected := sniffImageType(data)
+	if detected == "" {
+		return nil, ValidationError("unsupported image type, expected JPEG, PNG, GIF or WebP")
+	}
+	declared, _, err := mime.ParseMediaType(contentType)
+	if err != nil && strings.TrimSpace(contentType) != "" {
+		return nil, ValidationError("invalid content type")
+	}
+	if declared != "" && declared != "application/octet-stream" && declared != detected {
+		return nil, ValidationError(fmt.Sprintf("content type %s does not match the %s file", declared, detected))
+	}
+	img := &ProductImage{ProductID: productID, ContentType: detected, Size: int64(len(data))}
+	if err := img.SetAltText(altText); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	img.Checksum = hex.EncodeToString(sum[:])
+	return img, nil
+}
+
+// SetAltText 去除首尾空白后设置替代文本，可为空。
+func (i *ProductImage) SetAltText(altText string) error {
+	altText = strings.TrimSpace(altText)
+	if utf8.RuneCountInString(altText) > MaxImageAltTextLength {
+		return ValidationError("alt text too long")
+	}
+	i.AltText = altText
+	return nil
+}
+
+// ImageExtension 返回图片类型对应的文件扩展名，用于生成 BlobKey。
+func ImageExtension(contentType string) string {
+	for _, t := range imageTypes {
+		if t.contentType == contentType {
+			return t.extension
+		}
+	}
+	return ""
+}
+
+// ReorderImages 把 images（按 Position 排序）中 ID 为 id 的图片移到 position（超出范围时移到末尾），并重新编号为 0..n-1。
+func ReorderImages(images []ProductImage, id int64, position int) ([]ProductImage, error) {
+	if position < 0 {
+		return nil, ValidationError("position must be >= 0")
+	}
+	from := -1
+	for idx := range images {
+		if images[idx].ID == id {
+			from = idx
+			break
+		}
+	}
+	if from < 0 {
+		return nil, ErrNotFound
+	}
+	moved := images[from]
+	out := make([]ProductImage, 0, len(images))
+	out = append(out, images[:from]...)
+	out = append(out, images[from+1:]...)
+	if position > len(out) {
+		position = len(out)
+	}
+	out = append(out[:position], append([]ProductImage{moved}, out[position:]...)...)
+	for idx := range out {
+		out[idx].Position = idx
+	}
+	return out, nil
+}
+
+// TooManyImagesError 表示商品的图片数已达上限。
+func TooManyImagesError() error {
+	return ConflictError(fmt.Sprintf("a product can have at most %d images", MaxProductImages))
+}
+
+func sniffImageType(data []byte) string {
+	for _, t := range imageTypes {
+		if t.matches(data) {
+			return t.contentType
+		}
+	}
+	return ""
+}
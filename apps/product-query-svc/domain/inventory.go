@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// StockLevel 是一个库存单位的库存：VariantID 为 nil 时是商品本身，否则是该商品的某个规格。
+// OnHand 是实际库存，Reserved 是已预留但尚未提交的数量，始终满足 0 <= Reserved <= OnHand。
+type StockLevel struct {
+	ProductID int64
+	VariantID *int64
+	OnHand    int64
+	Reserved  int64
+	UpdatedAt time.Time
+}
+
+// Available 返回可预留的数量。
+func (s *StockLevel) Available() int64 {
+	return s.OnHand - s.Reserved
+}
+
+// Adjust 按 delta 增减实际库存（入库为正、盘亏为负）；已预留的部分不能被扣减。
+func (s *StockLevel) Adjust(delta int64) error {
+	if delta == 0 {
+		return ValidationError("delta must not be zero")
+	}
+	if s.OnHand+delta < s.Reserved {
+		return InsufficientStockError(s.Available())
+	}
+	s.OnHand += delta
+	return nil
+}
+
+// Reserve 预留 quantity 件库存。
+func (s *StockLevel) Reserve(quantity int64) error {
+	if quantity > s.Available() {
+		return InsufficientStockError(s.Available())
+	}
+	s.Reserved += quantity
+	return nil
+}
+
+// Commit 把已预留的 quantity 件库存实际出库。
+func (s *StockLevel) Commit(quantity int64) {
+	s.OnHand -= quantity
+	s.Reserved -= quantity
+}
+
+// Release 释放已预留的 quantity 件库存，使其重新可用。
+func (s *StockLevel) Release(quantity int64) {
+	s.Reserved -= quantity
+}
+
+// ReservationStatus 是库存预留的状态。
+type ReservationStatus string
+
+const (
+	// ReservationPending 表示库存已预留，等待提交或释放。
+	ReservationPending ReservationStatus = "pending"
+	// ReservationCommitted 表示预留的库存已出库。
+	ReservationCommitted ReservationStatus = "committed"
+	// ReservationReleased 表示预留已取消，库存重新可用。
+	ReservationReleased ReservationStatus = "released"
+)
+
+// Reservation 是对一个库存单位的预留（例如下单到支付之间），只能从 pending 提交或释放一次。
+type Reservation struct {
+	ID        int64
+	ProductID int64
+	VariantID *int64
+	Quantity  int64
+	Status    ReservationStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewReservation 构建一个待处理的预留；库存是否足够由仓储在预留时原子地检查。
+func NewReservation(productID int64, variantID *int64, quantity int64) (*Reservation, error) {
+	if productID <= 0 {
+		return nil, ValidationError("product id must be a positive integer")
+	}
+	if variantID != nil {
+		if *variantID <= 0 {
+			return nil, ValidationError("variant id must be a positive integer")
+		}
+		id := *variantID
+		variantID = &id
+	}
+	if quantity <= 0 {
+		return nil, ValidationError("quantity must be a positive integer")
+	}
+	return &Reservation{ProductID: productID, VariantID: variantID, Quantity: quantity, Status: ReservationPending}, nil
+}
+
+// Commit 把预留标记为已出库。
+func (r *Reservation) Commit() error {
+	return r.settle(ReservationCommitted)
+}
+
+// Release 把预留标记为已释放。
+func (r *Reservation) Release() error {
+	return r.settle(ReservationReleased)
+}
+
+func (r *Reservation) settle(status ReservationStatus) error {
+	if r.Status != ReservationPending {
+		return ConflictError(fmt.Sprintf("reservation is already %s", r.Status))
+	}
+	r.Status = status
+	return nil
+}
+
+// InsufficientStockError 表示可用库存不足以完成预留或扣减。
+func InsufficientStockError(available int64) error {
+	return ConflictError(fmt.Sprintf("insufficient stock: %d available", available))
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+)
+
+// 多币种价格规则：
+//
+//   - 价格一律以整数最小货币单位（分、日元、fils 等）保存，最小单位位数见 CurrencyExponent；
+//   - 汇率 rate 表示 1 单位 from 主币可兑换多少单位 to 主币，使用精确有理数，不经过浮点；
+//   - 换算结果 = amount × rate × 10^(exp(to) - exp(from))，再四舍五入（半数远离零）
+//     到目标币种的最小单位，例如 0.5 分进位为 1 分，-0.5 分舍为 -1 分；
+//   - 覆盖价（PriceOverrides）优先于换算，按原值返回，不参与取整。
+
+// currencyExponents 列出最小单位不是 2 位小数的币种，其余币种按 2 位处理。
+var currencyExponents = map[string]int{
+	"BHD": 3, "CLP": 0, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0,
+	"KWD": 3, "OMR": 3, "TND": 3, "UGX": 0, "VND": 0,
+}
+
+// NormalizeCurrency 去除空白并转大写，要求是三位字母的 ISO-4217 代码。
+func NormalizeCurrency(code string) (string, error) {
+	c := strings.ToUpper(strings.TrimSpace(code))
+	if len(c) != 3 {
+		return "", ValidationError("currency must be a 3-letter ISO-4217 code")
+	}
+	for i := 0; i < len(c); i++ {
+		if c[i] < 'A' || c[i] > 'Z' {
+			return "", ValidationError("currency must be a 3-letter ISO-4217 code")
+		}
+	}
+	return c, nil
+}
+
+// UnsupportedCurrencyError 表示找不到该币种的价格或汇率。
+func UnsupportedCurrencyError(code string) error {
+	return ValidationError("unsupported currency: " + code)
+}
+
+// CurrencyExponent 返回币种最小单位相对主币的小数位数。
+func CurrencyExponent(code string) int {
+	if exp, ok := currencyExponents[code]; ok {
+		return exp
+	}
+	return 2
+}
+
+// ConvertMinorUnits 按本文件开头的多币种价格规则把 from 币种的最小单位金额换算为 to 币种的最小单位金额。
+func ConvertMinorUnits(amount int64, from, to string, rate *big.Rat) (int64, error) {
+	if rate == nil || rate.Sign() <= 0 {
+		return 0, ValidationError("exchange rate must be positive")
+	}
+	v := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rate)
+	diff := CurrencyExponent(to) - CurrencyExponent(from)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(diff))), nil))
+	if diff >= 0 {
+		v.Mul(v, scale)
+	} else {
+		v.Quo(v, scale)
+	}
+	q := roundHalfAwayFromZero(v)
+	if !q.IsInt64() {
+		return 0, ValidationError("converted price out of range")
+	}
+	return q.Int64(), nil
+}
+
+func roundHalfAwayFromZero(v *big.Rat) *big.Int {
+	q, r := new(big.Int).QuoRem(v.Num(), v.Denom(), new(big.Int))
+	// |r| * 2 >= denom 时向远离零的方向进一
+	if new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).Cmp(v.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(v.Sign())))
+	}
+	return q
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
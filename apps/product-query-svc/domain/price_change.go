@@ -0,0 +1,87 @@
+package domain
+
+import "time"
+
+// PriceChangeStatus 是价格变更记录的状态。
+type PriceChangeStatus string
+
+const (
+	// PriceChangePending 表示尚未生效的预约调价。
+	PriceChangePending PriceChangeStatus = "pending"
+	// PriceChangeApplied 表示已生效的价格（直接修改或预约到期后应用）。
+	PriceChangeApplied PriceChangeStatus = "applied"
+	// PriceChangeSkipped 表示预约到期时商品已改用其他币种，调价被跳过。
+	PriceChangeSkipped PriceChangeStatus = "skipped"
+)
+
+// PriceChange 是商品价格历史中的一条记录，价格以 Currency 的最小货币单位保存。
+// 直接修改价格时记录一条已生效的变更；预约调价先以 pending 保存，
+// 到 EffectiveFrom 后由调度器应用，AppliedAt 记录实际应用时间。
+type PriceChange struct {
+	ID            int64
+	ProductID     int64
+	Price         int64
+	Currency      string
+	EffectiveFrom time.Time
+	Status        PriceChangeStatus
+	AppliedAt     *time.Time
+	CreatedAt     time.Time
+}
+
+// AppliedPriceChange 记录商品在 at 时刻已生效的当前价格。
+func AppliedPriceChange(p *Product, at time.Time) PriceChange {
+	at = at.UTC()
+	return PriceChange{
+		ProductID:     p.ID,
+		Price:         p.Price,
+		Currency:      p.BaseCurrency(),
+		EffectiveFrom: at,
+		Status:        PriceChangeApplied,
+		AppliedAt:     &at,
+		CreatedAt:     at,
+	}
+}
+
+// SchedulePriceChange 为商品预约一次以其当前币种计价的调价，生效时间必须晚于 now。
+func SchedulePriceChange(p *Product, price int64, effectiveFrom, now time.Time) (*PriceChange, error) {
+	if price < 0 {
+		return nil, ValidationError("price must be >= 0")
+	}
+	if effectiveFrom.IsZero() {
+		return nil, ValidationError("effectiveFrom required")
+	}
+	if !effectiveFrom.After(now) {
+		return nil, ValidationError("effectiveFrom must be in the future")
+	}
+	return &PriceChange{
+		ProductID:     p.ID,
+		Price:         price,
+		Currency:      p.BaseCurrency(),
+		EffectiveFrom: effectiveFrom.UTC(),
+		Status:        PriceChangePending,
+		CreatedAt:     now.UTC(),
+	}, nil
+}
+
+// Due 报告预约调价在 now 时是否到期。
+func (c *PriceChange) Due(now time.Time) bool {
+	return c.Status == PriceChangePending && !c.EffectiveFrom.After(now)
+}
+
+// ApplyTo 把到期的预约调价应用到商品：商品币种与预约时一致则改价并标记 applied，
+// 否则保持商品不变并标记 skipped。调用方负责在同一事务中保存商品与记录。
+func (c *PriceChange) ApplyTo(p *Product, now time.Time) {
+	now = now.UTC()
+	c.AppliedAt = &now
+	if p.BaseCurrency() != c.Currency {
+		c.Status = PriceChangeSkipped
+		return
+	}
+	p.Price = c.Price
+	c.Status = PriceChangeApplied
+}
+
+// PriceChanged 报告两次保存之间商品的价格或币种是否发生变化，用于决定是否记录历史。
+func PriceChanged(before, after *Product) bool {
+	return before.Price != after.Price || before.BaseCurrency() != after.BaseCurrency()
+}
@@ -1,20 +1,67 @@
 package domain
 
-import "strings"
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
 
-// Product 是领域聚合根，Price 以分为单位避免浮点误差。
+// Product 是领域聚合根，Price 以 Currency 的最小货币单位保存避免浮点误差。
+// Slug 是由名称生成的唯一地址，由仓储在创建和改名时分配（规则见 slug.go），改名前用过的 slug 仍指向该商品。
+// Name 与 Description 是 DefaultLocale 下的原文，各语言的译文另行保存（见 translation.go）；
+// Locale 是 Localize 换用的译文语言，空表示原文，仅在读取时填充，不会被持久化。
+// PriceOverrides 按币种给出人工定价，优先于汇率换算，规则见 money.go。
+// Status 只能通过 Publish/Archive/Unarchive 变更，PublishedAt 为最近一次发布时间。
+// DeletedAt 非空表示商品在回收站中：常规读写都视其不存在，但评论与价格历史保留，清理前可恢复。
+// Version 是乐观并发版本号，创建时为 1，每次持久化写入加 1；写入时非 0 的 Version 必须与存储一致。
+// UpdatedAt 是最近一次持久化写入的时间，与 Version 一起由仓储维护，用作 HTTP 缓存校验。
+// CategoryID 是商品所属分类，nil 表示未分类；Category 是该分类的面包屑，仅由仓储读取时填充，不会被持久化。
+// InStock 表示商品本身或任一规格还有可用库存，同样仅由仓储读取时填充。
 type Product struct {
-	ID    int64
-	Name  string
-	Price int64
-	Tags  []string
+	ID             int64
+	Name           string
+	Slug           string
+	Description    string
+	Locale         string
+	Price          int64
+	Currency       string
+	PriceOverrides map[string]int64
+	Tags           []string
+	CategoryID     *int64
+	Category       CategoryPath
+	InStock        bool
+	Status         ProductStatus
+	PublishedAt    *time.Time
+	DeletedAt      *time.Time
+	Version        int64
+	UpdatedAt      time.Time
 }
 
+// ProductStatus 是商品生命周期状态：草稿 → 已发布 → 已归档，归档后可取消归档回到草稿。
+type ProductStatus string
+
+const (
+	ProductDraft     ProductStatus = "draft"
+	ProductPublished ProductStatus = "published"
+	ProductArchived  ProductStatus = "archived"
+)
+
 const maxTags = 5
 
+// MaxDescriptionLength 限制商品描述（含译文）的字符数。
+const MaxDescriptionLength = 2000
+
+// DefaultCurrency 是未指定币种时的计价币种，ISO-4217 代码。
+const DefaultCurrency = "USD"
+
+// maxPriceOverrides 限制单个商品的覆盖价数量。
+const maxPriceOverrides = 20
+
 // NewProduct 统一入口，构建并校验不变式。
 func NewProduct(name string, priceCents int64, tags []string) (*Product, error) {
-	p := &Product{Name: strings.TrimSpace(name), Price: priceCents}
+	p := &Product{Name: strings.TrimSpace(name), Price: priceCents, Currency: DefaultCurrency, Status: ProductDraft}
 	if err := p.replaceTags(tags); err != nil {
 		return nil, err
 	}
@@ -29,12 +76,192 @@ func (p *Product) Validate() error {
 	if p.Name == "" {
 		return ValidationError("name required")
 	}
+	if utf8.RuneCountInString(p.Description) > MaxDescriptionLength {
+		return ValidationError(fmt.Sprintf("description exceeds %d characters", MaxDescriptionLength))
+	}
 	if p.Price < 0 {
 		return ValidationError("price must be >= 0")
 	}
 	if len(p.Tags) > maxTags {
 		return ValidationError("tags exceed limit")
 	}
+	if p.Currency != "" {
+		if c, err := NormalizeCurrency(p.Currency); err != nil || c != p.Currency {
+			return ValidationError("currency must be a 3-letter ISO-4217 code")
+		}
+	}
+	switch p.LifecycleStatus() {
+	case ProductDraft, ProductArchived:
+	case ProductPublished:
+		if p.PublishedAt == nil {
+			return ValidationError("published product requires publishedAt")
+		}
+	default:
+		return ValidationError("unknown product status")
+	}
+	if len(p.PriceOverrides) > maxPriceOverrides {
+		return ValidationError("price overrides exceed limit")
+	}
+	for code, price := range p.PriceOverrides {
+		if c, err := NormalizeCurrency(code); err != nil || c != code {
+			return ValidationError("price override currency must be a 3-letter ISO-4217 code")
+		}
+		if code == p.BaseCurrency() {
+			return ValidationError("price override duplicates base currency")
+		}
+		if price < 0 {
+			return ValidationError("price override must be >= 0")
+		}
+	}
+	return nil
+}
+
+// LifecycleStatus 返回商品状态，未设置时视为草稿。
+func (p *Product) LifecycleStatus() ProductStatus {
+	if p.Status == "" {
+		return ProductDraft
+	}
+	return p.Status
+}
+
+// IsPublished 报告商品是否对外可见。
+func (p *Product) IsPublished() bool {
+	return p.LifecycleStatus() == ProductPublished
+}
+
+// Publish 发布草稿商品；已发布或已归档的商品返回冲突错误（归档商品需先 Unarchive）。
+func (p *Product) Publish(now time.Time) error {
+	if status := p.LifecycleStatus(); status != ProductDraft {
+		return ConflictError("cannot publish a " + string(status) + " product")
+	}
+	at := now.UTC()
+	p.Status = ProductPublished
+	p.PublishedAt = &at
+	return p.Validate()
+}
+
+// Archive 归档草稿或已发布商品，使其不再出现在默认搜索中。
+func (p *Product) Archive() error {
+	if p.LifecycleStatus() == ProductArchived {
+		return ConflictError("product is already archived")
+	}
+	p.Status = ProductArchived
+	return nil
+}
+
+// Unarchive 把已归档商品恢复为草稿，需再次 Publish 才会对外可见。
+func (p *Product) Unarchive() error {
+	if status := p.LifecycleStatus(); status != ProductArchived {
+		return ConflictError("cannot unarchive a " + string(status) + " product")
+	}
+	p.Status = ProductDraft
+	return nil
+}
+
+// KeepLifecycle 从已保存的商品沿用状态与发布时间，用于整资源更新时不改变生命周期。
+func (p *Product) KeepLifecycle(stored *Product) {
+	p.Status = stored.LifecycleStatus()
+	p.PublishedAt = stored.PublishedAt
+}
+
+// SetCategory 把商品归入 categoryID 分类，nil 表示移出分类；分类是否存在由应用层检查。
+// 原有的面包屑随之失效并被清空。
+func (p *Product) SetCategory(categoryID *int64) error {
+	if categoryID != nil {
+		if *categoryID <= 0 {
+			return ValidationError("category id must be a positive integer")
+		}
+		id := *categoryID
+		categoryID = &id
+	}
+	p.CategoryID = categoryID
+	p.Category = nil
+	return nil
+}
+
+// IsDeleted 报告商品是否在回收站中。
+func (p *Product) IsDeleted() bool {
+	return p.DeletedAt != nil
+}
+
+// BaseCurrency 返回 Price 的计价币种，未设置时为 DefaultCurrency。
+func (p *Product) BaseCurrency() string {
+	if p.Currency == "" {
+		return DefaultCurrency
+	}
+	return p.Currency
+}
+
+// SetPricing 设置计价币种与覆盖价（币种代码不区分大小写），currency 为空时使用 DefaultCurrency。
+func (p *Product) SetPricing(currency string, overrides map[string]int64) error {
+	p.Currency = DefaultCurrency
+	if strings.TrimSpace(currency) != "" {
+		c, err := NormalizeCurrency(currency)
+		if err != nil {
+			return err
+		}
+		p.Currency = c
+	}
+	p.PriceOverrides = nil
+	for code, price := range overrides {
+		c, err := NormalizeCurrency(code)
+		if err != nil {
+			return ValidationError("price override currency must be a 3-letter ISO-4217 code")
+		}
+		if p.PriceOverrides == nil {
+			p.PriceOverrides = make(map[string]int64, len(overrides))
+		}
+		p.PriceOverrides[c] = price
+	}
+	return p.Validate()
+}
+
+// NeedsRate 报告以 currency 展示价格时是否需要汇率：基础币种与有覆盖价的币种不需要。
+func (p *Product) NeedsRate(currency string) bool {
+	if currency == p.BaseCurrency() {
+		return false
+	}
+	_, ok := p.PriceOverrides[currency]
+	return !ok
+}
+
+// ConvertTo 把 Price/Currency 改写为以 currency 计价的展示价格：优先使用覆盖价，
+// 否则按 rate（1 单位基础币种可兑换的 currency 数量）换算；NeedsRate 为假时 rate 可为 nil。
+// 改写后的商品仅用于展示，不应再持久化。
+func (p *Product) ConvertTo(currency string, rate *big.Rat) error {
+	base := p.BaseCurrency()
+	switch override, ok := p.PriceOverrides[currency]; {
+	case currency == base:
+	case ok:
+		p.Price = override
+	default:
+		converted, err := ConvertMinorUnits(p.Price, base, currency, rate)
+		if err != nil {
+			return err
+		}
+		p.Price = converted
+	}
+	p.Currency = currency
+	return nil
+}
+
+// Rename 修改商品名称（去除首尾空白后不能为空）。
+func (p *Product) Rename(name string) error {
+	cleaned := strings.TrimSpace(name)
+	if cleaned == "" {
+		return ValidationError("name required")
+	}
+	p.Name = cleaned
+	return nil
+}
+
+// Describe 修改商品描述（去除首尾空白，可为空）。
+func (p *Product) Describe(description string) error {
+	cleaned := strings.TrimSpace(description)
+	if utf8.RuneCountInString(cleaned) > MaxDescriptionLength {
+		return ValidationError(fmt.Sprintf("description exceeds %d characters", MaxDescriptionLength))
+	}
+	p.Description = cleaned
 	return nil
 }
 
@@ -80,6 +307,11 @@ func (p *Product) RemoveTag(tag string) {
 	p.Tags = out
 }
 
+// ReplaceTags 用新的标签列表整体替换，自动去重并限制数量。
+func (p *Product) ReplaceTags(tags []string) error {
+	return p.replaceTags(tags)
+}
+
 // replaceTags 重建标签列表（调用方负责去重复构建）。
 func (p *Product) replaceTags(tags []string) error {
 	sanitized, err := sanitizeTags(tags)
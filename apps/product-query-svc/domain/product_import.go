@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// ImportFormat 是批量导入文件的格式。
+type ImportFormat string
+
+const (
+	ImportCSV    ImportFormat = "csv"
+	ImportNDJSON ImportFormat = "ndjson"
+)
+
+// DefaultImportBatchSize 是一次导入中每个事务最多写入的行数。
+const DefaultImportBatchSize = 500
+
+// ParseImportFormat 解析格式名（大小写不敏感）。
+func ParseImportFormat(s string) (ImportFormat, error) {
+	switch f := ImportFormat(strings.ToLower(strings.TrimSpace(s))); f {
+	case ImportCSV, ImportNDJSON:
+		return f, nil
+	}
+	return "", ValidationError("import format must be csv or ndjson")
+}
+
+// ImportOptions 控制一次批量导入：DryRun 只校验不写入，BatchSize <= 0 时使用 DefaultImportBatchSize。
+type ImportOptions struct {
+	Format    ImportFormat
+	DryRun    bool
+	BatchSize int
+}
+
+// NormalizedBatchSize 返回实际使用的批大小。
+func (o ImportOptions) NormalizedBatchSize() int {
+	if o.BatchSize <= 0 {
+		return DefaultImportBatchSize
+	}
+	return o.BatchSize
+}
+
+// ImportRow 是导入文件中的一条记录，Line 为它在文件中的行号（从 1 开始）。
+// ID 为 0 表示新建商品，否则更新该商品；Version 非 0 时与已存储的版本比对，语义同 If-Match。
+type ImportRow struct {
+	Line     int
+	ID       int64
+	Version  int64
+	Name     string
+	Price    int64
+	Currency string
+	Tags     []string
+}
+
+// Product 经由 NewProduct 校验该行并构造一个草稿商品；Currency 为空时使用默认币种。
+func (row ImportRow) Product() (*Product, error) {
+	p, err := NewProduct(row.Name, row.Price, row.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if row.Currency != "" {
+		if err := p.SetPricing(row.Currency, nil); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// ApplyTo 用该行覆盖已有商品的名称、价格、标签与（非空时的）币种；覆盖价与生命周期保持不变。
+func (row ImportRow) ApplyTo(p *Product) error {
+	if err := CheckVersion(row.Version, p.Version); err != nil {
+		return err
+	}
+	candidate, err := row.Product()
+	if err != nil {
+		return err
+	}
+	patch := ProductPatch{Name: &candidate.Name, Price: &candidate.Price, Tags: &candidate.Tags}
+	if row.Currency != "" {
+		patch.Currency = &candidate.Currency
+	}
+	return p.ApplyPatch(patch)
+}
+
+// ImportRowError 记录未通过校验或写入失败的一行。
+type ImportRowError struct {
+	Line    int
+	ID      int64
+	Message string
+}
+
+// ImportResult 汇总一次导入；DryRun 时 Created/Updated 是将会创建/更新的行数。
+type ImportResult struct {
+	DryRun  bool
+	Created int
+	Updated int
+	Failed  int
+	Errors  []ImportRowError
+}
+
+// Reject 把一行记为失败；err 的哨兵前缀（如 "validation error"）不会出现在 Message 中。
+func (r *ImportResult) Reject(line int, id int64, err error) {
+	msg := err.Error()
+	if _, detail, ok := strings.Cut(msg, "\n"); ok {
+		msg = detail
+	}
+	r.Errors = append(r.Errors, ImportRowError{Line: line, ID: id, Message: msg})
+	r.Failed++
+}
+
+// SortErrors 按行号排列失败记录。
+func (r *ImportResult) SortErrors() {
+	sort.SliceStable(r.Errors, func(i, j int) bool { return r.Errors[i].Line < r.Errors[j].Line })
+}
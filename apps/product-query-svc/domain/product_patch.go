@@ -0,0 +1,82 @@
+package domain
+
+// ProductPatch 是对商品的部分修改（对应 JSON Merge Patch），nil 字段保持原值。
+// PriceOverrides 与已有覆盖价按币种合并，值为 nil 表示删除该币种的覆盖价；Tags 非 nil 时整体替换。
+// CategoryID 非 nil 时修改分类，指向 nil 表示移出分类。
+type ProductPatch struct {
+	Name           *string
+	Description    *string
+	Price          *int64
+	Currency       *string
+	PriceOverrides map[string]*int64
+	Tags           *[]string
+	CategoryID     **int64
+}
+
+// IsEmpty 报告补丁是否没有任何修改。
+func (patch ProductPatch) IsEmpty() bool {
+	return patch.Name == nil && patch.Description == nil && patch.Price == nil && patch.Currency == nil && patch.PriceOverrides == nil && patch.Tags == nil && patch.CategoryID == nil
+}
+
+// ApplyPatch 通过领域方法逐项应用补丁，最后整体校验不变式；生命周期状态不受影响。
+func (p *Product) ApplyPatch(patch ProductPatch) error {
+	if patch.Name != nil {
+		if err := p.Rename(*patch.Name); err != nil {
+			return err
+		}
+	}
+	if patch.Description != nil {
+		if err := p.Describe(*patch.Description); err != nil {
+			return err
+		}
+	}
+	if patch.Currency != nil || patch.PriceOverrides != nil {
+		currency := p.BaseCurrency()
+		if patch.Currency != nil {
+			currency = *patch.Currency
+		}
+		overrides, err := mergePriceOverrides(p.PriceOverrides, patch.PriceOverrides)
+		if err != nil {
+			return err
+		}
+		if err := p.SetPricing(currency, overrides); err != nil {
+			return err
+		}
+	}
+	if patch.Price != nil {
+		if err := p.ChangePrice(*patch.Price); err != nil {
+			return err
+		}
+	}
+	if patch.Tags != nil {
+		if err := p.ReplaceTags(*patch.Tags); err != nil {
+			return err
+		}
+	}
+	if patch.CategoryID != nil {
+		if err := p.SetCategory(*patch.CategoryID); err != nil {
+			return err
+		}
+	}
+	return p.Validate()
+}
+
+// mergePriceOverrides 把补丁中的覆盖价合并到现有覆盖价的副本上。
+func mergePriceOverrides(current map[string]int64, patch map[string]*int64) (map[string]int64, error) {
+	merged := make(map[string]int64, len(current)+len(patch))
+	for code, price := range current {
+		merged[code] = price
+	}
+	for code, price := range patch {
+		c, err := NormalizeCurrency(code)
+		if err != nil {
+			return nil, ValidationError("price override currency must be a 3-letter ISO-4217 code")
+		}
+		if price == nil {
+			delete(merged, c)
+			continue
+		}
+		merged[c] = *price
+	}
+	return merged, nil
+}
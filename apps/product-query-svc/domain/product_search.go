@@ -0,0 +1,265 @@
+package domain
+
+import "strings"
+
+// TagMatch 决定多个标签过滤条件之间的组合方式。
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
+// ProductSortField 是搜索结果可用的排序字段。
+type ProductSortField string
+
+const (
+	SortByID    ProductSortField = "id"
+	SortByName  ProductSortField = "name"
+	SortByPrice ProductSortField = "price"
+	// SortByRelevance 按与 Query 的相关度排序，同时把匹配方式从子串放宽为全文/模糊匹配。
+	SortByRelevance ProductSortField = "relevance"
+)
+
+// SortOrder 是排序方向。
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// ProductSearch 是商品搜索的结构化条件，入站与出站端口共用。
+// MinPrice/MaxPrice 以分为单位且包含边界，nil 表示不限。
+// After 非空时使用 keyset 分页并忽略 Page；SkipTotal 为 true 时不统计 Total 与 Facets。
+// Currency 非空时结果价格换算为该币种展示，价格区间与排序仍作用于商品自身币种的存储价格。
+// 默认只返回已发布商品，IncludeUnpublished 为 true 时（管理端）包含草稿与已归档商品。
+// CategoryID 非空时只返回该分类及其所有子孙分类下的商品。
+// Locales 是按优先级排列的译文语言（见 ParseAcceptLanguage），非空时每个商品换用第一个存在的译文，
+// Query 匹配、按名称排序与游标都基于译文。
+type ProductSearch struct {
+	Query              string
+	Tags               []string
+	TagMatch           TagMatch
+	CategoryID         *int64
+	MinPrice           *int64
+	MaxPrice           *int64
+	SortBy             ProductSortField
+	Order              SortOrder
+	After              *ProductCursor
+	SkipTotal          bool
+	Currency           string
+	Locales            []string
+	Page               int
+	PageSize           int
+	IncludeUnpublished bool
+}
+
+// ProductCursor 是商品在某种排序下的排序键，也用作 keyset 分页游标；
+// SortBy/Order 用于校验游标与请求的排序一致。
+type ProductCursor struct {
+	SortBy ProductSortField
+	Order  SortOrder
+	ID     int64
+	Name   string
+	Price  int64
+	Score  float64
+}
+
+// TagCount 表示结果集中携带某个标签的商品数量。
+type TagCount struct {
+	Tag   string
+	Count int
+}
+
+// ProductSearchResult 是一页搜索结果；Total 与 Facets 基于完整结果集而非当前页。
+// Next 在还有后续结果时指向本页最后一个商品；Scores 仅在按相关度排序时填充，与 Items 一一对应。
+type ProductSearchResult struct {
+	Items  []Product
+	Scores []float64
+	Total  int
+	Facets []TagCount
+	Next   *ProductCursor
+}
+
+// Normalize 清洗标签条件并补齐分页默认值，适配器可直接使用结果。
+func (s ProductSearch) Normalize() ProductSearch {
+	s.Query = strings.TrimSpace(s.Query)
+	s.Tags = normalizeTagFilter(s.Tags)
+	s.Currency = strings.ToUpper(strings.TrimSpace(s.Currency))
+	if s.TagMatch != TagMatchAll {
+		s.TagMatch = TagMatchAny
+	}
+	switch s.SortBy {
+	case SortByName, SortByPrice, SortByRelevance:
+	default:
+		s.SortBy = SortByID
+	}
+	switch {
+	case s.Order == SortAsc || s.Order == SortDesc:
+	case s.SortBy == SortByRelevance:
+		// 相关度默认最匹配的在前
+		s.Order = SortDesc
+	default:
+		s.Order = SortAsc
+	}
+	if s.Page < 1 {
+		s.Page = 1
+	}
+	if s.PageSize < 1 {
+		s.PageSize = 20
+	}
+	return s
+}
+
+// Validate 校验分类、价格区间、展示币种、相关度排序与游标。
+func (s ProductSearch) Validate() error {
+	if s.SortBy == SortByRelevance && s.Query == "" {
+		return ValidationError("sort=relevance requires q")
+	}
+	if s.CategoryID != nil && *s.CategoryID <= 0 {
+		return ValidationError("category must be a positive integer")
+	}
+	if s.MinPrice != nil && *s.MinPrice < 0 {
+		return ValidationError("minPrice must be >= 0")
+	}
+	if s.MaxPrice != nil && *s.MaxPrice < 0 {
+		return ValidationError("maxPrice must be >= 0")
+	}
+	if s.MinPrice != nil && s.MaxPrice != nil && *s.MinPrice > *s.MaxPrice {
+		return ValidationError("minPrice must be <= maxPrice")
+	}
+	if s.Currency != "" {
+		if _, err := NormalizeCurrency(s.Currency); err != nil {
+			return err
+		}
+	}
+	if s.After != nil && (s.After.SortBy != s.SortBy || s.After.Order != s.Order) {
+		return ValidationError("cursor does not match the requested sort")
+	}
+	return nil
+}
+
+// MatchesStatus 判断商品状态是否满足可见性条件。
+func (s ProductSearch) MatchesStatus(p *Product) bool {
+	return s.IncludeUnpublished || p.IsPublished()
+}
+
+// MatchesPrice 判断价格（分）是否落在区间内。
+func (s ProductSearch) MatchesPrice(price int64) bool {
+	if s.MinPrice != nil && price < *s.MinPrice {
+		return false
+	}
+	if s.MaxPrice != nil && price > *s.MaxPrice {
+		return false
+	}
+	return true
+}
+
+// SortKey 取商品在当前排序下的排序键；score 只在按相关度排序时有意义。
+func (s ProductSearch) SortKey(p Product, score float64) ProductCursor {
+	return ProductCursor{SortBy: s.SortBy, Order: s.Order, ID: p.ID, Name: p.Name, Price: p.Price, Score: score}
+}
+
+// Less 按排序条件比较两个排序键；排序值相同时按同方向的 ID 兜底，保证分页稳定。
+func (s ProductSearch) Less(a, b ProductCursor) bool {
+	var cmp int
+	switch s.SortBy {
+	case SortByName:
+		cmp = strings.Compare(a.Name, b.Name)
+	case SortByPrice:
+		switch {
+		case a.Price < b.Price:
+			cmp = -1
+		case a.Price > b.Price:
+			cmp = 1
+		}
+	case SortByRelevance:
+		switch {
+		case a.Score < b.Score:
+			cmp = -1
+		case a.Score > b.Score:
+			cmp = 1
+		}
+	default:
+		switch {
+		case a.ID < b.ID:
+			cmp = -1
+		case a.ID > b.ID:
+			cmp = 1
+		}
+	}
+	if cmp == 0 {
+		switch {
+		case a.ID < b.ID:
+			cmp = -1
+		case a.ID > b.ID:
+			cmp = 1
+		}
+	}
+	if s.Order == SortDesc {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// IsAfterCursor 判断排序键是否排在游标之后；未设置游标时总是 true。
+func (s ProductSearch) IsAfterCursor(key ProductCursor) bool {
+	if s.After == nil {
+		return true
+	}
+	return s.Less(*s.After, key)
+}
+
+// Offset 返回当前页在结果集中的起始位置；keyset 分页时恒为 0。
+func (s ProductSearch) Offset() int {
+	if s.After != nil || s.Page < 1 {
+		return 0
+	}
+	return (s.Page - 1) * s.PageSize
+}
+
+// MatchesTags 判断商品标签是否满足过滤条件（不区分大小写）。
+func (s ProductSearch) MatchesTags(tags []string) bool {
+	if len(s.Tags) == 0 {
+		return true
+	}
+	owned := make(map[string]struct{}, len(tags))
+	for _, t := range tags {
+		owned[strings.ToLower(strings.TrimSpace(t))] = struct{}{}
+	}
+	matched := 0
+	for _, want := range s.Tags {
+		if _, ok := owned[want]; ok {
+			matched++
+		}
+	}
+	if s.TagMatch == TagMatchAll {
+		return matched == len(s.Tags)
+	}
+	return matched > 0
+}
+
+// normalizeTagFilter 转小写并去重，空白标签被忽略。
+func normalizeTagFilter(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(tags))
+	out := make([]string, 0, len(tags))
+	for _, raw := range tags {
+		key := strings.ToLower(strings.TrimSpace(raw))
+		if key == "" {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, key)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
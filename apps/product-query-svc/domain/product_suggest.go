@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	DefaultSuggestLimit = 10
+	MaxSuggestLimit     = 20
+	MaxSuggestPrefixLen = 50
+)
+
+// SuggestionKind 区分联想结果来自商品名还是标签。
+type SuggestionKind string
+
+const (
+	SuggestionName SuggestionKind = "name"
+	SuggestionTag  SuggestionKind = "tag"
+)
+
+// Suggestion 是一条输入联想结果；Weight 为选中后可命中的商品数，商品名恒为 1。
+// ProductID 仅对商品名有效，标签文本统一为小写。
+type Suggestion struct {
+	Text      string
+	Kind      SuggestionKind
+	ProductID int64
+	Weight    int
+}
+
+// NormalizeSuggestPrefix 去除首尾空白并转小写，前缀为空或过长时返回校验错误。
+func NormalizeSuggestPrefix(prefix string) (string, error) {
+	p := strings.ToLower(strings.TrimSpace(prefix))
+	if p == "" {
+		return "", ValidationError("prefix required")
+	}
+	if utf8.RuneCountInString(p) > MaxSuggestPrefixLen {
+		return "", ValidationError("prefix too long")
+	}
+	return p, nil
+}
+
+// NormalizeSuggestLimit 把数量限制收敛到 [1, MaxSuggestLimit]，非正数使用默认值。
+func NormalizeSuggestLimit(limit int) int {
+	switch {
+	case limit <= 0:
+		return DefaultSuggestLimit
+	case limit > MaxSuggestLimit:
+		return MaxSuggestLimit
+	default:
+		return limit
+	}
+}
+
+// RankSuggestions 合并商品名与标签候选并截断：与前缀完全相同者优先，
+// 其次 Weight 高者、文本短者，最后按文本与 ProductID 排序保证结果稳定。
+func RankSuggestions(prefix string, items []Suggestion, limit int) []Suggestion {
+	exact := func(s Suggestion) bool { return strings.EqualFold(s.Text, prefix) }
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if ea, eb := exact(a), exact(b); ea != eb {
+			return ea
+		}
+		if a.Weight != b.Weight {
+			return a.Weight > b.Weight
+		}
+		if la, lb := utf8.RuneCountInString(a.Text), utf8.RuneCountInString(b.Text); la != lb {
+			return la < lb
+		}
+		if a.Text != b.Text {
+			return a.Text < b.Text
+		}
+		if a.Kind != b.Kind {
+			return a.Kind == SuggestionTag
+		}
+		return a.ProductID < b.ProductID
+	})
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return items
+}
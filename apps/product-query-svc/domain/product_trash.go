@@ -0,0 +1,32 @@
+package domain
+
+// TrashPage 选择回收站列表的一页，按删除时间倒序排列，删除时间相同时按 ID 倒序。
+type TrashPage struct {
+	Page     int
+	PageSize int
+}
+
+// TrashList 是一页回收站商品；Total 为回收站中的商品总数。
+type TrashList struct {
+	Items []Product
+	Total int
+}
+
+// Normalize 补齐分页默认值。
+func (p TrashPage) Normalize() TrashPage {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize < 1 {
+		p.PageSize = 20
+	}
+	return p
+}
+
+// Offset 返回当前页在回收站中的起始位置。
+func (p TrashPage) Offset() int {
+	if p.Page < 1 {
+		return 0
+	}
+	return (p.Page - 1) * p.PageSize
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "sort"
+
+const (
+	DefaultRelatedLimit = 10
+	MaxRelatedLimit     = 50
+)
+
+// 相关商品的打分权重：每个共同标签、同一分类、每位同时购买过两件商品的用户各计的分数。
+const (
+	RelatedTagWeight        = 2
+	RelatedCategoryWeight   = 3
+	RelatedCoPurchaseWeight = 1
+)
+
+// RelatedQuery 描述一次相关商品查询；IncludeOrders 为 true 时把订单中的共同购买也计入得分。
+type RelatedQuery struct {
+	ProductID     int64
+	Limit         int
+	IncludeOrders bool
+}
+
+// Normalize 把 Limit 收敛到 [1, MaxRelatedLimit]，非正数使用默认值。
+func (q RelatedQuery) Normalize() RelatedQuery {
+	switch {
+	case q.Limit <= 0:
+		q.Limit = DefaultRelatedLimit
+	case q.Limit > MaxRelatedLimit:
+		q.Limit = MaxRelatedLimit
+	}
+	return q
+}
+
+// RelatedProduct 是一个推荐的商品及其得分依据。
+// SharedTags 是与源商品共有的标签（小写、升序）；CoPurchases 是在订单中同时买过两件商品的用户数，
+// 订单按商品名（不区分大小写）关联商品，未计入订单时为 0。
+type RelatedProduct struct {
+	Product      Product
+	SharedTags   []string
+	SameCategory bool
+	CoPurchases  int
+	Score        int
+}
+
+// RelatedScore 按权重计算得分。
+func RelatedScore(sharedTags int, sameCategory bool, coPurchases int) int {
+	score := sharedTags*RelatedTagWeight + coPurchases*RelatedCoPurchaseWeight
+	if sameCategory {
+		score += RelatedCategoryWeight
+	}
+	return score
+}
+
+// RankRelated 去掉零分的商品，按得分从高到低、同分按商品 ID 升序排列并截断到 limit，保证结果稳定。
+func RankRelated(items []RelatedProduct, limit int) []RelatedProduct {
+	out := make([]RelatedProduct, 0, len(items))
+	for _, item := range items {
+		if item.Score > 0 {
+			out = append(out, item)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		return out[i].Product.ID < out[j].Product.ID
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
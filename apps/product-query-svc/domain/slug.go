@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MaxSlugLength 限制 slug 的长度（包含冲突后缀）。
+const MaxSlugLength = 80
+
+// fallbackSlug 用于名称里没有任何 ASCII 字母或数字的商品。
+const fallbackSlug = "product"
+
+// Slugify 由商品名生成 URL 安全的 slug：ASCII 字母转小写、数字保留，其余字符连续出现时折叠为一个 "-"，
+// 去掉首尾的 "-" 并截断到 MaxSlugLength；结果为空时使用 "product"。
+func Slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			dash = false
+			b.WriteRune(r)
+			continue
+		}
+		dash = true
+	}
+	slug := trimSlug(b.String(), MaxSlugLength)
+	if slug == "" {
+		return fallbackSlug
+	}
+	return slug
+}
+
+// SlugCandidate 返回 base 的第 n 个候选：n <= 1 时为 base 本身，否则为 "base-n"，必要时截短 base 保证不超长。
+func SlugCandidate(base string, n int) string {
+	if n <= 1 {
+		return base
+	}
+	suffix := "-" + strconv.Itoa(n)
+	return trimSlug(base, MaxSlugLength-len(suffix)) + suffix
+}
+
+// FreeSlug 返回 base 第一个未被占用的候选，taken 报告候选是否已被其他商品占用。
+func FreeSlug(base string, taken func(slug string) bool) string {
+	for n := 1; ; n++ {
+		if candidate := SlugCandidate(base, n); !taken(candidate) {
+			return candidate
+		}
+	}
+}
+
+// SlugFits 报告 slug 是否仍由 name 生成，即 Slugify(name) 本身或它带冲突后缀的候选。
+// 改名后 slug 不再相符时才需要分配新 slug，因此只改大小写或标点的改名不会改变商品地址。
+func SlugFits(slug, name string) bool {
+	base := Slugify(name)
+	if slug == base {
+		return true
+	}
+	i := strings.LastIndexByte(slug, '-')
+	if i < 0 {
+		return false
+	}
+	n, err := strconv.Atoi(slug[i+1:])
+	return err == nil && n > 1 && slug == SlugCandidate(base, n)
+}
+
+// NormalizeSlug 把查询用的 slug 转成小写并校验格式：由 "-" 分隔的字母数字段，长度不超过 MaxSlugLength。
+func NormalizeSlug(slug string) (string, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" || len(slug) > MaxSlugLength {
+		return "", ValidationError("slug must be 1-80 characters")
+	}
+	for _, part := range strings.Split(slug, "-") {
+		if part == "" || strings.Trim(part, "abcdefghijklmnopqrstuvwxyz0123456789") != "" {
+			return "", ValidationError("slug may only contain letters and digits separated by single hyphens")
+		}
+	}
+	return slug, nil
+}
+
+// trimSlug 把 slug 截断到 max 字节，并去掉截断后末尾的 "-"。
+func trimSlug(slug string, max int) string {
+	if len(slug) > max {
+		slug = slug[:max]
+	}
+	return strings.TrimRight(slug, "-")
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultLocale 是商品原文（Product.Name 与 Description）所用的语言，BCP 47 标签。
+const DefaultLocale = "en"
+
+// maxAcceptedLocales 限制从 Accept-Language 解析出的语言数量（含回退），避免过长的查找链。
+const maxAcceptedLocales = 10
+
+// ProductTranslation 是商品名称与描述在某个语言下的译文，每个商品每种语言最多一条。
+// Locale 是规范化的 BCP 47 标签（见 NormalizeLocale），不能是 DefaultLocale：原文直接修改商品即可。
+type ProductTranslation struct {
+	ProductID   int64
+	Locale      string
+	Name        string
+	Description string
+	UpdatedAt   time.Time
+}
+
+// NewProductTranslation 规范化语言标签并校验译文：名称去除首尾空白后不能为空，描述可为空。
+func NewProductTranslation(productID int64, locale, name, description string) (*ProductTranslation, error) {
+	if productID <= 0 {
+		return nil, ValidationError("product id must be a positive integer")
+	}
+	locale, err := NormalizeLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if locale == DefaultLocale {
+		return nil, ValidationError("locale " + DefaultLocale + " is the product's own text, update the product instead")
+	}
+	t := &ProductTranslation{ProductID: productID, Locale: locale, Name: strings.TrimSpace(name), Description: strings.TrimSpace(description)}
+	if t.Name == "" {
+		return nil, ValidationError("name required")
+	}
+	if utf8.RuneCountInString(t.Description) > MaxDescriptionLength {
+		return nil, ValidationError(fmt.Sprintf("description exceeds %d characters", MaxDescriptionLength))
+	}
+	return t, nil
+}
+
+// NormalizeLocale 校验并规范化 BCP 47 语言标签：语言子标签小写，4 位文字子标签首字母大写，
+// 地区子标签大写，"_" 视同 "-"，例如 "zh_hant_tw" → "zh-Hant-TW"。只接受 语言[-文字][-地区] 形式。
+func NormalizeLocale(tag string) (string, error) {
+	invalid := ValidationError("locale must be a language tag such as de or pt-BR")
+	parts := strings.FieldsFunc(strings.TrimSpace(tag), func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) == 0 || len(parts) > 3 || len(tag) > 16 {
+		return "", invalid
+	}
+	if !isAlpha(parts[0]) || len(parts[0]) < 2 || len(parts[0]) > 3 {
+		return "", invalid
+	}
+	out := []string{strings.ToLower(parts[0])}
+	rest := parts[1:]
+	if len(rest) > 0 && len(rest[0]) == 4 && isAlpha(rest[0]) {
+		out = append(out, strings.ToUpper(rest[0][:1])+strings.ToLower(rest[0][1:]))
+		rest = rest[1:]
+	}
+	if len(rest) > 0 {
+		region := rest[0]
+		switch {
+		case len(region) == 2 && isAlpha(region):
+			out = append(out, strings.ToUpper(region))
+		case len(region) == 3 && strings.Trim(region, "0123456789") == "":
+			out = append(out, region)
+		default:
+			return "", invalid
+		}
+		rest = rest[1:]
+	}
+	if len(rest) > 0 {
+		return "", invalid
+	}
+	return strings.Join(out, "-"), nil
+}
+
+// ParseAcceptLanguage 把 Accept-Language 请求头解析为译文查找链：按 q 值从高到低（相同时保持原顺序）
+// 排列语言，每个语言之后依次补上去掉末尾子标签的回退（"de-CH" → "de-CH", "de"），重复的只保留第一次。
+// 链在遇到 DefaultLocale 时截止，因为原文总是存在，之后的语言不会被用到；"*"、q=0 与无法识别的标签被忽略。
+// 返回空表示直接使用原文。
+func ParseAcceptLanguage(header string) []string {
+	type ranged struct {
+		locale string
+		q      float64
+	}
+	var ranges []ranged
+	for _, item := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(item, ";")
+		q := 1.0
+		if name, value, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(name) == "q" {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || parsed < 0 || parsed > 1 {
+				continue
+			}
+			q = parsed
+		}
+		locale, err := NormalizeLocale(tag)
+		if err != nil || q == 0 {
+			continue
+		}
+		ranges = append(ranges, ranged{locale, q})
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	var chain []string
+	seen := make(map[string]bool)
+	for _, r := range ranges {
+		for locale := r.locale; locale != ""; locale = parentLocale(locale) {
+			if locale == DefaultLocale {
+				return chain
+			}
+			if seen[locale] {
+				continue
+			}
+			if len(chain) == maxAcceptedLocales {
+				return chain
+			}
+			seen[locale] = true
+			chain = append(chain, locale)
+		}
+	}
+	return chain
+}
+
+// Localize 把名称与描述换成 chain 中第一个存在的译文并记录其语言；没有可用译文时保持原文。
+func (p *Product) Localize(translations []ProductTranslation, chain []string) {
+	for _, locale := range chain {
+		for _, t := range translations {
+			if t.Locale == locale {
+				p.Name, p.Description, p.Locale = t.Name, t.Description, t.Locale
+				return
+			}
+		}
+	}
+}
+
+// ContentLocale 返回商品名称与描述所用的语言：译文的语言，或原文的 DefaultLocale。
+func (p *Product) ContentLocale() string {
+	if p.Locale == "" {
+		return DefaultLocale
+	}
+	return p.Locale
+}
+
+// parentLocale 去掉最后一个子标签，"zh-Hant-TW" → "zh-Hant" → "zh" → ""。
+func parentLocale(locale string) string {
+	i := strings.LastIndexByte(locale, '-')
+	if i < 0 {
+		return ""
+	}
+	return locale[:i]
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
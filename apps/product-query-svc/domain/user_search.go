@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// UserSortField 是用户列表可用的排序字段。
+type UserSortField string
+
+const (
+	UserSortByID        UserSortField = "id"
+	UserSortByName      UserSortField = "name"
+	UserSortByEmail     UserSortField = "email"
+	UserSortByCreatedAt UserSortField = "createdAt"
+)
+
+// UserSearch 是用户列表的结构化条件，入站与出站端口共用。
+// Query 不区分大小写地匹配名称或邮箱的子串，与 users.email 的 citext 语义一致；
+// 按名称或邮箱排序时同样不区分大小写。CreatedFrom 包含边界、CreatedTo 不包含，nil 表示不限。
+// After 非 nil 时按 keyset 分页，忽略 Page；SkipTotal 为 true 时不统计 Total。
+type UserSearch struct {
+	Query       string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	SortBy      UserSortField
+	Order       SortOrder
+	After       *UserCursor
+	Page        int
+	PageSize    int
+	SkipTotal   bool
+}
+
+// UserCursor 是用户在某种排序下的排序键，也用作 keyset 分页游标；
+// SortBy/Order 用于校验游标与请求的排序一致。
+type UserCursor struct {
+	SortBy    UserSortField
+	Order     SortOrder
+	ID        int64
+	Name      string
+	Email     string
+	CreatedAt time.Time
+}
+
+// UserSearchResult 是一页用户；Total 基于完整结果集而非当前页，Next 在还有后续结果时指向本页最后一个用户。
+type UserSearchResult struct {
+	Items []User
+	Total int
+	Next  *UserCursor
+}
+
+// Normalize 清洗查询词并补齐排序与分页默认值，适配器可直接使用结果。
+func (s UserSearch) Normalize() UserSearch {
+	s.Query = strings.TrimSpace(s.Query)
+	switch s.SortBy {
+	case UserSortByName, UserSortByEmail, UserSortByCreatedAt:
+	default:
+		s.SortBy = UserSortByID
+	}
+	if s.Order != SortDesc {
+		s.Order = SortAsc
+	}
+	if s.Page < 1 {
+		s.Page = 1
+	}
+	if s.PageSize < 1 {
+		s.PageSize = 20
+	}
+	return s
+}
+
+// Validate 校验创建时间区间与游标。
+func (s UserSearch) Validate() error {
+	if s.CreatedFrom != nil && s.CreatedTo != nil && !s.CreatedFrom.Before(*s.CreatedTo) {
+		return ValidationError("createdFrom must be before createdTo")
+	}
+	if s.After != nil && (s.After.SortBy != s.SortBy || s.After.Order != s.Order) {
+		return ValidationError("cursor does not match the requested sort")
+	}
+	return nil
+}
+
+// Matches 判断用户是否满足查询词与创建时间条件。
+func (s UserSearch) Matches(u *User) bool {
+	if q := strings.ToLower(s.Query); q != "" &&
+		!strings.Contains(strings.ToLower(u.Name), q) && !strings.Contains(strings.ToLower(u.Email), q) {
+		return false
+	}
+	if s.CreatedFrom != nil && u.CreatedAt.Before(*s.CreatedFrom) {
+		return false
+	}
+	if s.CreatedTo != nil && !u.CreatedAt.Before(*s.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+// SortKey 取用户在当前排序下的排序键。
+func (s UserSearch) SortKey(u *User) UserCursor {
+	return UserCursor{SortBy: s.SortBy, Order: s.Order, ID: u.ID, Name: u.Name, Email: u.Email, CreatedAt: u.CreatedAt}
+}
+
+// Less 按排序条件比较两个排序键；排序值相同时按同方向的 ID 兜底，保证分页稳定。
+func (s UserSearch) Less(a, b UserCursor) bool {
+	var cmp int
+	switch s.SortBy {
+	case UserSortByName:
+		cmp = strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	case UserSortByEmail:
+		cmp = strings.Compare(strings.ToLower(a.Email), strings.ToLower(b.Email))
+	case UserSortByCreatedAt:
+		cmp = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if cmp == 0 {
+		switch {
+		case a.ID < b.ID:
+			cmp = -1
+		case a.ID > b.ID:
+			cmp = 1
+		}
+	}
+	if s.Order == SortDesc {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+// IsAfterCursor 判断排序键是否排在游标之后；未设置游标时总是 true。
+func (s UserSearch) IsAfterCursor(key UserCursor) bool {
+	if s.After == nil {
+		return true
+	}
+	return s.Less(*s.After, key)
+}
+
+// Offset 返回当前页在结果集中的起始位置；keyset 分页时恒为 0。
+func (s UserSearch) Offset() int {
+	if s.After != nil || s.Page < 1 {
+		return 0
+	}
+	return (s.Page - 1) * s.PageSize
+}
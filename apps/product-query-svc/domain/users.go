@@ -43,7 +43,15 @@ func (u *User) Validate() error {
 	return nil
 }
 
-//TODO how to avoid a same email could create several account
+// DuplicateEmailError 表示邮箱已被其他用户使用。邮箱唯一性由仓储保证，比较时不区分大小写。
+func DuplicateEmailError() error {
+	return ConflictError("a user with this email already exists")
+}
+
+// UserHasOrdersError 表示用户仍有订单，不能删除：订单是交易记录，不随用户一起删除。
+func UserHasOrdersError() error {
+	return ConflictError("user has orders and cannot be deleted")
+}
 
 func (u *User) ChangeName(newName string) error {
 	cleaned := strings.TrimSpace(newName)
@@ -53,3 +61,13 @@ func (u *User) ChangeName(newName string) error {
 	u.Name = cleaned
 	return nil
 }
+
+// ChangeEmail 修改邮箱，格式校验与 NewUser 相同。
+func (u *User) ChangeEmail(newEmail string) error {
+	cleaned := strings.TrimSpace(newEmail)
+	if !IsValidEmail(cleaned) {
+		return ValidationError("invalid email format")
+	}
+	u.Email = cleaned
+	return nil
+}
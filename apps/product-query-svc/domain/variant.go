@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSKULength 限制 SKU 的字符数。
+	MaxSKULength = 64
+	// MaxVariantAttributes 限制单个规格的属性个数。
+	MaxVariantAttributes = 10
+	// MaxVariantAttributeLength 限制属性名与属性值的字符数。
+	MaxVariantAttributeLength = 64
+)
+
+// Variant 是商品的一个可售规格（如某个尺码与颜色的组合），归属于 ProductID 对应的商品。
+// SKU 在所有商品间唯一，保存为大写，比较时不区分大小写；Attributes 描述规格，同一商品下不能有两个属性完全相同的规格。
+// PriceCents 为 nil 时按商品价格出售，否则以商品币种的最小货币单位覆盖商品价格。
+// Version 与 UpdatedAt 的语义同 Product。
+type Variant struct {
+	ID         int64
+	ProductID  int64
+	SKU        string
+	Attributes map[string]string
+	PriceCents *int64
+	Version    int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewVariant 构建并校验归属于 productID 的规格。
+func NewVariant(productID int64, sku string, attributes map[string]string, priceCents *int64) (*Variant, error) {
+	v := &Variant{ProductID: productID}
+	if err := v.Update(sku, attributes, priceCents); err != nil {
+		return nil, err
+	}
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Update 整体替换规格的 SKU、属性与覆盖价。
+func (v *Variant) Update(sku string, attributes map[string]string, priceCents *int64) error {
+	normalized, err := NormalizeSKU(sku)
+	if err != nil {
+		return err
+	}
+	attrs, err := normalizeVariantAttributes(attributes)
+	if err != nil {
+		return err
+	}
+	if priceCents != nil {
+		if *priceCents < 0 {
+			return ValidationError("variant price must be >= 0")
+		}
+		price := *priceCents
+		priceCents = &price
+	}
+	v.SKU = normalized
+	v.Attributes = attrs
+	v.PriceCents = priceCents
+	return nil
+}
+
+// Validate 检查规格自身的不变式；SKU 的全局唯一由仓储保证，同一商品内的唯一由 CheckVariantUnique 检查。
+func (v *Variant) Validate() error {
+	if v.ProductID <= 0 {
+		return ValidationError("product id must be a positive integer")
+	}
+	if sku, err := NormalizeSKU(v.SKU); err != nil {
+		return err
+	} else if sku != v.SKU {
+		return ValidationError("sku must be normalized")
+	}
+	if _, err := normalizeVariantAttributes(v.Attributes); err != nil {
+		return err
+	}
+	if v.PriceCents != nil && *v.PriceCents < 0 {
+		return ValidationError("variant price must be >= 0")
+	}
+	return nil
+}
+
+// NormalizeSKU 去除首尾空白并转为大写；SKU 只能包含字母、数字以及 - _ .，且以字母或数字开头。
+func NormalizeSKU(sku string) (string, error) {
+	cleaned := strings.ToUpper(strings.TrimSpace(sku))
+	if cleaned == "" {
+		return "", ValidationError("sku required")
+	}
+	if len(cleaned) > MaxSKULength {
+		return "", ValidationError("sku too long")
+	}
+	for i, r := range cleaned {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case i > 0 && (r == '-' || r == '_' || r == '.'):
+		default:
+			return "", ValidationError("sku may only contain letters, digits, '-', '_' and '.', and must start with a letter or digit")
+		}
+	}
+	return cleaned, nil
+}
+
+// normalizeVariantAttributes 去除属性名与属性值的首尾空白，属性名转为小写；去空白后重名的属性视为非法。
+func normalizeVariantAttributes(attributes map[string]string) (map[string]string, error) {
+	if len(attributes) > MaxVariantAttributes {
+		return nil, ValidationError("variant attributes exceed limit")
+	}
+	out := make(map[string]string, len(attributes))
+	for name, value := range attributes {
+		key := strings.ToLower(strings.TrimSpace(name))
+		val := strings.TrimSpace(value)
+		if key == "" || val == "" {
+			return nil, ValidationError("variant attribute names and values must not be empty")
+		}
+		if utf8.RuneCountInString(key) > MaxVariantAttributeLength || utf8.RuneCountInString(val) > MaxVariantAttributeLength {
+			return nil, ValidationError("variant attribute too long")
+		}
+		if _, dup := out[key]; dup {
+			return nil, ValidationError(fmt.Sprintf("duplicate variant attribute %q", key))
+		}
+		out[key] = val
+	}
+	return out, nil
+}
+
+// CheckVariantUnique 检查 v 与同一商品的其他规格（siblings，可包含 v 自身的旧值）没有相同的 SKU 或相同的属性组合。
+func CheckVariantUnique(siblings []Variant, v *Variant) error {
+	for _, other := range siblings {
+		if other.ID == v.ID && v.ID != 0 {
+			continue
+		}
+		if strings.EqualFold(other.SKU, v.SKU) {
+			return DuplicateSKUError(v.SKU)
+		}
+		if maps.Equal(other.Attributes, v.Attributes) {
+			return DuplicateVariantError()
+		}
+	}
+	return nil
+}
+
+// DuplicateSKUError 表示 SKU 已被某个规格（可能属于其他商品）占用。
+func DuplicateSKUError(sku string) error {
+	return ConflictError(fmt.Sprintf("sku %s is already in use", sku))
+}
+
+// DuplicateVariantError 表示同一商品下已有属性完全相同的规格。
+func DuplicateVariantError() error {
+	return ConflictError("the product already has a variant with the same attributes")
+}
@@ -0,0 +1,17 @@
+package inbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// CategoryUseCases exposes the category tree to inbound adapters. Update and Delete take the
+// version the caller last saw; 0 skips the check, otherwise a stale version yields domain.ErrPreconditionFailed.
+type CategoryUseCases interface {
+	List(ctx context.Context) ([]domain.Category, error)
+	FetchByID(ctx context.Context, id int64) (*domain.Category, error)
+	Create(ctx context.Context, name string, parentID *int64) (*domain.Category, error)
+	Update(ctx context.Context, id int64, name string, parentID *int64, version int64) (*domain.Category, error)
+	Delete(ctx context.Context, id int64, version int64) error
+}
@@ -6,10 +6,11 @@ import (
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
 )
 
-// CommentUseCases exposes comment workflows to inbound adapters.
+// CommentUseCases exposes comment workflows to inbound adapters. Update and Delete take the
+// version the caller last saw; 0 skips the check, otherwise a stale version yields domain.ErrPreconditionFailed.
 type CommentUseCases interface {
-	ListByProduct(ctx context.Context, productID int64) ([]domain.Comment, error)
+	ListByProduct(ctx context.Context, productID int64, page domain.CommentPage) (*domain.CommentList, error)
 	Create(ctx context.Context, productID, userID int64, content string) (*domain.Comment, error)
-	Update(ctx context.Context, productID, commentID, userID int64, content string) (*domain.Comment, error)
-	Delete(ctx context.Context, productID, commentID, userID int64) error
+	Update(ctx context.Context, productID, commentID, userID int64, content string, version int64) (*domain.Comment, error)
+	Delete(ctx context.Context, productID, commentID, userID int64, version int64) error
 }
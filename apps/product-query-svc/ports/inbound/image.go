@@ -0,0 +1,22 @@
+package inbound
+
+import (
+	"context"
+	"io"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// ImageUseCases exposes product images to inbound adapters.
+type ImageUseCases interface {
+	List(ctx context.Context, productID int64) ([]domain.ProductImage, error)
+	FetchByID(ctx context.Context, productID, imageID int64) (*domain.ProductImage, error)
+	// Upload validates and stores an image after the product's existing ones; contentType is
+	// the type the client declared, checked against the content.
+	Upload(ctx context.Context, productID int64, contentType, altText string, data []byte) (*domain.ProductImage, error)
+	// Update replaces the alt text and, when position is set, moves the image there.
+	Update(ctx context.Context, productID, imageID int64, altText string, position *int) (*domain.ProductImage, error)
+	Delete(ctx context.Context, productID, imageID int64) error
+	// Open returns the image with its content, which the caller closes.
+	Open(ctx context.Context, productID, imageID int64) (*domain.ProductImage, io.ReadSeekCloser, error)
+}
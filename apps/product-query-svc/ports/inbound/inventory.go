@@ -0,0 +1,18 @@
+package inbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// InventoryUseCases exposes stock levels and reservations to inbound adapters. A nil variantID
+// addresses the product's own stock.
+type InventoryUseCases interface {
+	Stock(ctx context.Context, productID int64) ([]domain.StockLevel, error)
+	AdjustStock(ctx context.Context, productID int64, variantID *int64, delta int64) (*domain.StockLevel, error)
+	Reserve(ctx context.Context, productID int64, variantID *int64, quantity int64) (*domain.Reservation, error)
+	FetchReservation(ctx context.Context, id int64) (*domain.Reservation, error)
+	CommitReservation(ctx context.Context, id int64) (*domain.Reservation, error)
+	ReleaseReservation(ctx context.Context, id int64) (*domain.Reservation, error)
+}
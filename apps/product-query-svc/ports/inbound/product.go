@@ -2,15 +2,39 @@ package inbound
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
 )
 
 // ProductUseCases describes the application-facing entrypoints for product interactions.
+// Update honours a non-zero product.Version, and Patch and Remove a non-zero version, as the
+// version the caller last saw; a stale one yields domain.ErrPreconditionFailed.
+// Import reports invalid rows in its result and only fails for unreadable files or storage errors.
 type ProductUseCases interface {
-	FetchByID(ctx context.Context, id int64) (*domain.Product, error)
-	Search(ctx context.Context, query string, page, pageSize int) ([]domain.Product, int, error)
+	// FetchByID and FetchBySlug show the product's first translation along locales, a lookup
+	// chain from domain.ParseAcceptLanguage; an empty chain shows its own text.
+	FetchByID(ctx context.Context, id int64, currency string, locales []string) (*domain.Product, error)
+	// FetchBySlug also accepts a slug the product held before a rename; the product's Slug is the current one.
+	FetchBySlug(ctx context.Context, slug string, currency string, locales []string) (*domain.Product, error)
+	Search(ctx context.Context, criteria domain.ProductSearch) (*domain.ProductSearchResult, error)
+	Export(ctx context.Context, criteria domain.ProductSearch, fn func(*domain.Product) error) error
+	Suggest(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error)
 	Create(ctx context.Context, product *domain.Product) (int64, error)
 	Update(ctx context.Context, product *domain.Product) (*domain.Product, error)
-	Remove(ctx context.Context, id int64) error
+	Patch(ctx context.Context, id int64, patch domain.ProductPatch, version int64) (*domain.Product, error)
+	Remove(ctx context.Context, id int64, version int64) error
+	Import(ctx context.Context, src io.Reader, opts domain.ImportOptions) (*domain.ImportResult, error)
+	AddTag(ctx context.Context, id int64, tag string, version int64) (*domain.Product, error)
+	RemoveTag(ctx context.Context, id int64, tag string, version int64) (*domain.Product, error)
+	Publish(ctx context.Context, id int64, version int64) (*domain.Product, error)
+	Archive(ctx context.Context, id int64, version int64) (*domain.Product, error)
+	Unarchive(ctx context.Context, id int64, version int64) (*domain.Product, error)
+	Restore(ctx context.Context, id int64) (*domain.Product, error)
+	Trash(ctx context.Context, page domain.TrashPage) (*domain.TrashList, error)
+	PurgeTrash(ctx context.Context, retention time.Duration) (int, error)
+	SchedulePrice(ctx context.Context, productID int64, priceCents int64, effectiveFrom time.Time) (*domain.PriceChange, error)
+	PriceHistory(ctx context.Context, productID int64) ([]domain.PriceChange, error)
+	ApplyDuePriceChanges(ctx context.Context) ([]domain.PriceChange, error)
 }
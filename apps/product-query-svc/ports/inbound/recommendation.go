@@ -0,0 +1,12 @@
+package inbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// RecommendationQueries exposes product recommendations to inbound adapters.
+type RecommendationQueries interface {
+	Related(ctx context.Context, query domain.RelatedQuery) ([]domain.RelatedProduct, error)
+}
@@ -0,0 +1,15 @@
+package inbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// TranslationUseCases lets inbound adapters manage the per-locale names and descriptions of a product.
+type TranslationUseCases interface {
+	List(ctx context.Context, productID int64) ([]domain.ProductTranslation, error)
+	// Put creates or replaces the translation for locale and reports whether it was created.
+	Put(ctx context.Context, productID int64, locale, name, description string) (*domain.ProductTranslation, bool, error)
+	Delete(ctx context.Context, productID int64, locale string) error
+}
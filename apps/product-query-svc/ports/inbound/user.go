@@ -6,7 +6,12 @@ import (
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
 )
 
-// UserQueries exposes read-oriented user use cases for driving adapters.
-type UserQueries interface {
+// UserUseCases exposes user account use cases for driving adapters. Create and Update fail with
+// domain.ErrConflict when the email belongs to another user, compared case-insensitively.
+type UserUseCases interface {
 	FetchByID(ctx context.Context, id int64) (*domain.User, error)
+	Search(ctx context.Context, criteria domain.UserSearch) (*domain.UserSearchResult, error)
+	Create(ctx context.Context, name, email string) (*domain.User, error)
+	Update(ctx context.Context, id int64, name, email string) (*domain.User, error)
+	Delete(ctx context.Context, id int64) error
 }
@@ -0,0 +1,17 @@
+package inbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// VariantUseCases exposes the variants of a product to inbound adapters. Update and Delete take
+// the version the caller last saw; 0 skips the check.
+type VariantUseCases interface {
+	List(ctx context.Context, productID int64) ([]domain.Variant, error)
+	FetchByID(ctx context.Context, productID, variantID int64) (*domain.Variant, error)
+	Create(ctx context.Context, productID int64, sku string, attributes map[string]string, priceCents *int64) (*domain.Variant, error)
+	Update(ctx context.Context, productID, variantID int64, sku string, attributes map[string]string, priceCents *int64, version int64) (*domain.Variant, error)
+	Delete(ctx context.Context, productID, variantID int64, version int64) error
+}
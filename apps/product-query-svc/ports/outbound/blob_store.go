@@ -0,0 +1,18 @@
+package outbound
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStore keeps opaque binary content, such as product images, under caller-chosen keys.
+// Keys are slash-separated relative paths; metadata about the content lives elsewhere.
+type BlobStore interface {
+	// Put stores the content read from r under key, replacing any previous content.
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open returns the content stored under key, or domain.ErrNotFound. The caller closes it;
+	// it is seekable so adapters can serve byte ranges.
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	// Delete removes the content under key; deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
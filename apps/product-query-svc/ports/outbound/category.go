@@ -0,0 +1,25 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// CategoryRepository abstracts persistence for the category tree. Reads fill Category.Path.
+type CategoryRepository interface {
+	// ListCategories returns every category ordered by id.
+	ListCategories(ctx context.Context) ([]domain.Category, error)
+	GetCategory(ctx context.Context, id int64) (*domain.Category, error)
+	// CreateCategory and UpdateCategory fail with domain.ErrConflict when the parent already has a
+	// child of the same name (case-insensitively) and set Version, UpdatedAt and Path to the stored
+	// values. UpdateCategory fails with domain.ErrPreconditionFailed when a non-zero
+	// category.Version is stale, and with a validation error when the new parent is the category
+	// itself or one of its descendants. A rename or move changes the categoryPath of every product
+	// in the subtree, so it also bumps their Version and UpdatedAt.
+	CreateCategory(ctx context.Context, category *domain.Category) (int64, error)
+	UpdateCategory(ctx context.Context, category *domain.Category) error
+	// DeleteCategory fails with domain.ErrConflict while the category has subcategories or
+	// products, trashed ones included, and with domain.ErrPreconditionFailed on a stale version.
+	DeleteCategory(ctx context.Context, id int64, version int64) error
+}
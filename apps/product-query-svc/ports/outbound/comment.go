@@ -10,7 +10,9 @@ import (
 type CommentRepository interface {
 	CreateComment(ctx context.Context, comment *domain.Comment) (int64, error)
 	GetCommentByID(ctx context.Context, id int64) (*domain.Comment, error)
-	ListCommentsByProduct(ctx context.Context, productID int64) ([]domain.Comment, error)
+	ListCommentsByProduct(ctx context.Context, productID int64, page domain.CommentPage) (*domain.CommentList, error)
+	// UpdateComment and DeleteComment fail with domain.ErrPreconditionFailed when a non-zero
+	// version differs from the stored one; UpdateComment sets comment.Version to the new value.
 	UpdateComment(ctx context.Context, comment *domain.Comment) error
-	DeleteComment(ctx context.Context, id int64) error
+	DeleteComment(ctx context.Context, id int64, version int64) error
 }
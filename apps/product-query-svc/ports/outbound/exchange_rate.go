@@ -0,0 +1,13 @@
+package outbound
+
+import (
+	"context"
+	"math/big"
+)
+
+// ExchangeRateProvider supplies conversion rates between ISO-4217 currencies.
+type ExchangeRateProvider interface {
+	// Rate returns how many units of `to` one unit of `from` buys, in major units.
+	// Pairs the provider cannot price yield domain.UnsupportedCurrencyError.
+	Rate(ctx context.Context, from, to string) (*big.Rat, error)
+}
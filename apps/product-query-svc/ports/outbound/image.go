@@ -0,0 +1,22 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// ImageRepository abstracts persistence for product image metadata; the content itself is kept
+// in a BlobStore under each image's BlobKey. Positions are kept contiguous from 0.
+type ImageRepository interface {
+	// ListImages returns the images of a product ordered by position.
+	ListImages(ctx context.Context, productID int64) ([]domain.ProductImage, error)
+	GetImage(ctx context.Context, productID, imageID int64) (*domain.ProductImage, error)
+	// CreateImage appends the image after the product's last one, setting its ID, Position and
+	// timestamps. It fails with domain.ErrConflict once the product has domain.MaxProductImages.
+	CreateImage(ctx context.Context, image *domain.ProductImage) error
+	// UpdateImage stores the alt text and moves the image to image.Position, shifting the others.
+	UpdateImage(ctx context.Context, image *domain.ProductImage) error
+	// DeleteImage removes the image, closes the gap in positions and returns what was removed.
+	DeleteImage(ctx context.Context, productID, imageID int64) (*domain.ProductImage, error)
+}
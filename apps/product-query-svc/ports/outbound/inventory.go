@@ -0,0 +1,27 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// InventoryRepository abstracts persistence for stock levels and reservations. Every write is
+// atomic with respect to concurrent writers of the same stock level, so stock is never reserved
+// or adjusted twice over.
+type InventoryRepository interface {
+	// ListStock returns the stock levels of a product, the product's own (if any) first and then
+	// its variants' by variant id; stock that was never adjusted has no level.
+	ListStock(ctx context.Context, productID int64) ([]domain.StockLevel, error)
+	// AdjustStock adds delta to the on-hand stock, creating the level at zero first when needed.
+	// It fails with domain.ErrConflict when the result would drop below the reserved quantity.
+	AdjustStock(ctx context.Context, productID int64, variantID *int64, delta int64) (*domain.StockLevel, error)
+	// Reserve stores a pending reservation and holds its quantity, failing with domain.ErrConflict
+	// when less is available. It sets the reservation's ID and timestamps.
+	Reserve(ctx context.Context, reservation *domain.Reservation) error
+	GetReservation(ctx context.Context, id int64) (*domain.Reservation, error)
+	// CommitReservation takes the reserved quantity out of stock; ReleaseReservation makes it
+	// available again. Both fail with domain.ErrConflict unless the reservation is pending.
+	CommitReservation(ctx context.Context, id int64) (*domain.Reservation, error)
+	ReleaseReservation(ctx context.Context, id int64) (*domain.Reservation, error)
+}
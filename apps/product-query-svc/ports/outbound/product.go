@@ -2,15 +2,56 @@ package outbound
 
 import (
 	"context"
+	"time"
 
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
 )
 
-// ProductRepository abstracts persistence concerns for product aggregates.
+// ProductRepository abstracts persistence concerns for product aggregates. Every method that
+// returns products fills Product.Category with the breadcrumb of Product.CategoryID.
 type ProductRepository interface {
 	GetByID(ctx context.Context, id int64) (*domain.Product, error)
-	Search(ctx context.Context, query string, page, pageSize int) ([]domain.Product, int, error)
+	// GetBySlug finds the product that holds slug now or held it before a rename; callers compare
+	// product.Slug with slug to tell a current slug from an old one.
+	GetBySlug(ctx context.Context, slug string) (*domain.Product, error)
+	Search(ctx context.Context, criteria domain.ProductSearch) (*domain.ProductSearchResult, error)
+	// ExportProducts hands every product matching criteria's filters to fn in id order, one at a
+	// time and from a single consistent snapshot; sorting, paging and locale fields are ignored, so
+	// products are exported in their own text. An error from fn stops the export and is returned.
+	ExportProducts(ctx context.Context, criteria domain.ProductSearch, fn func(*domain.Product) error) error
+	// Suggest returns name and tag candidates starting with the lower-cased prefix. Adapters may cap
+	// each kind at limit as long as they keep the candidates domain.RankSuggestions ranks highest.
+	Suggest(ctx context.Context, prefix string, limit int) ([]domain.Suggestion, error)
+	// Create and Update append an applied domain.PriceChange in the same write whenever the
+	// price or currency is new, so the history never misses a change.
+	// Create stores the product at version 1. Update fails with domain.ErrPreconditionFailed when a
+	// non-zero product.Version differs from the stored one; both set product.Version and
+	// product.UpdatedAt to the stored values. Every write to a product stamps UpdatedAt.
+	// Create assigns product.Slug from the name, suffixed -2, -3, ... when taken, and Update assigns a
+	// new one only when the stored slug no longer fits the name (domain.SlugFits). Every slug a
+	// product has held stays reserved for it until the product is purged.
 	Create(ctx context.Context, product *domain.Product) (int64, error)
 	Update(ctx context.Context, product *domain.Product) error
-	Delete(ctx context.Context, id int64) error
+	// ImportProducts writes one import batch atomically: creates are stored as by Create and
+	// updates as by Update, and any failure rolls back the whole batch.
+	ImportProducts(ctx context.Context, creates, updates []*domain.Product) error
+	// Delete moves the product to the trash by setting DeletedAt. Trashed products behave as
+	// missing for every other method except Restore, ListDeleted and PurgeDeleted. A non-zero
+	// version must match the stored one, as in Update.
+	Delete(ctx context.Context, id int64, version int64) error
+	// Restore takes a product out of the trash; it fails with domain.ErrConflict when the
+	// product exists but is not trashed.
+	Restore(ctx context.Context, id int64) error
+	ListDeleted(ctx context.Context, page domain.TrashPage) (*domain.TrashList, error)
+	// PurgeDeleted permanently removes up to limit products trashed before cutoff, together with
+	// their comments, price history and image metadata. It returns how many were removed and the
+	// blob keys of their images, whose content the caller still has to delete.
+	PurgeDeleted(ctx context.Context, cutoff time.Time, limit int) (int, []string, error)
+	SchedulePriceChange(ctx context.Context, change *domain.PriceChange) (int64, error)
+	// ListPriceHistory returns applied, skipped and pending changes ordered by EffectiveFrom then ID.
+	ListPriceHistory(ctx context.Context, productID int64) ([]domain.PriceChange, error)
+	// ApplyDuePriceChanges applies up to limit changes due at now, oldest first, via
+	// domain.PriceChange.ApplyTo and returns them with their final status. Concurrent callers
+	// must never apply the same change twice. Applying a change bumps the product's version.
+	ApplyDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]domain.PriceChange, error)
 }
@@ -0,0 +1,15 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// RecommendationSource finds products related to a given one. Implementations score published,
+// untrashed products other than query.ProductID and return at most query.Limit of them, ranked
+// like domain.RankRelated: highest score first, ties by ascending product id, zero scores left out.
+// An unknown source product yields no results rather than an error.
+type RecommendationSource interface {
+	Related(ctx context.Context, query domain.RelatedQuery) ([]domain.RelatedProduct, error)
+}
@@ -0,0 +1,19 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// TranslationRepository abstracts persistence for per-locale product texts. Writes count as writes
+// to the product: they bump its Version and UpdatedAt, so cached localized reads revalidate.
+type TranslationRepository interface {
+	// ListTranslations returns the translations of a product ordered by locale.
+	ListTranslations(ctx context.Context, productID int64) ([]domain.ProductTranslation, error)
+	// PutTranslation creates or replaces the translation for its locale, sets UpdatedAt and reports
+	// whether it was created. A missing or trashed product yields domain.ErrNotFound.
+	PutTranslation(ctx context.Context, translation *domain.ProductTranslation) (created bool, err error)
+	// DeleteTranslation fails with domain.ErrNotFound when the product has no such translation.
+	DeleteTranslation(ctx context.Context, productID int64, locale string) error
+}
@@ -9,4 +9,12 @@ import (
 // UserRepository abstracts access to persistent user data.
 type UserRepository interface {
 	FindByID(ctx context.Context, id int64) (*domain.User, error)
+	// SearchUsers receives normalized criteria and orders the page like domain.UserSearch.Less.
+	SearchUsers(ctx context.Context, criteria domain.UserSearch) (*domain.UserSearchResult, error)
+	// CreateUser and UpdateUser fail with domain.ErrConflict when another user already has the
+	// email, compared case-insensitively. CreateUser sets ID and CreatedAt to the stored values.
+	CreateUser(ctx context.Context, user *domain.User) (int64, error)
+	UpdateUser(ctx context.Context, user *domain.User) error
+	// DeleteUser removes the user along with their orders and comments.
+	DeleteUser(ctx context.Context, id int64) error
 }
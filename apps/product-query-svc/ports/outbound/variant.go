@@ -0,0 +1,21 @@
+package outbound
+
+import (
+	"context"
+
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+)
+
+// VariantRepository abstracts persistence for product variants. Variants are addressed through
+// their product; a variant of another product is reported as domain.ErrNotFound.
+type VariantRepository interface {
+	ListVariants(ctx context.Context, productID int64) ([]domain.Variant, error)
+	GetVariant(ctx context.Context, productID, variantID int64) (*domain.Variant, error)
+	// CreateVariant and UpdateVariant fail with domain.ErrConflict when the SKU is taken by any
+	// variant or the product already has a variant with the same attributes.
+	CreateVariant(ctx context.Context, variant *domain.Variant) (int64, error)
+	// UpdateVariant and DeleteVariant fail with domain.ErrPreconditionFailed when a non-zero
+	// version differs from the stored one; UpdateVariant sets variant.Version to the new value.
+	UpdateVariant(ctx context.Context, variant *domain.Variant) error
+	DeleteVariant(ctx context.Context, productID, variantID int64, version int64) error
+}
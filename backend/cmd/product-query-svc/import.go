@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	appspg "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/postgres"
+	productapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/product"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// runImport implements `product-query-svc import [flags] FILE`: it loads a CSV or NDJSON file
+// into Postgres through the same use case as POST /products:import and returns the exit code.
+func runImport(args []string) int {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	dsnFlag := fs.String("db-dsn", "", "Postgres DSN (defaults to DATABASE_URL)")
+	formatFlag := fs.String("format", "", "csv or ndjson (defaults to the file extension; required for stdin)")
+	dryRun := fs.Bool("dry-run", false, "validate every row and report without writing")
+	batchSize := fs.Int("batch-size", domain.DefaultImportBatchSize, "rows written per transaction")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: product-query-svc import [flags] FILE|-")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+	path := fs.Arg(0)
+
+	rawFormat := *formatFlag
+	if rawFormat == "" && path != "-" {
+		rawFormat = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+	format, err := domain.ParseImportFormat(rawFormat)
+	if err != nil {
+		log.Printf("import: cannot tell the format of %q, pass -format csv or -format ndjson", path)
+		return 2
+	}
+	dsn := *dsnFlag
+	if dsn == "" {
+		dsn = os.Getenv("DATABASE_URL")
+	}
+	if dsn == "" {
+		log.Print("import: -db-dsn or DATABASE_URL is required")
+		return 2
+	}
+
+	var src io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			log.Printf("import: %v", err)
+			return 1
+		}
+		defer f.Close()
+		src = f
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		log.Printf("connect pg: %v", err)
+		return 1
+	}
+	defer pool.Close()
+
+	// importing never purges the trash, so no blob store is needed
+	svc := productapp.NewService(appspg.NewProductRepository(pool), appspg.NewCategoryRepository(pool), appspg.NewTranslationRepository(pool), nil, nil)
+	result, err := svc.Import(ctx, src, domain.ImportOptions{Format: format, DryRun: *dryRun, BatchSize: *batchSize})
+	if err != nil {
+		log.Printf("import: %s", strings.ReplaceAll(err.Error(), "\n", ": "))
+		return 1
+	}
+
+	mode := ""
+	if result.DryRun {
+		mode = " (dry run, nothing written)"
+	}
+	fmt.Printf("created %d, updated %d, failed %d%s\n", result.Created, result.Updated, result.Failed, mode)
+	for _, e := range result.Errors {
+		fmt.Printf("line %d: %s\n", e.Line, e.Message)
+	}
+	if result.Failed > 0 {
+		return 1
+	}
+	return 0
+}
@@ -12,18 +12,61 @@ import (
 
 	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
 	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/localblob"
 	appspg "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/postgres"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/staticrates"
+	categoryapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/category"
 	commentapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/comment"
+	imageapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/image"
+	inventoryapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/inventory"
 	productapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/product"
+	recommendationapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/recommendation"
+	translationapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/translation"
 	userapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/user"
+	variantapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/variant"
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
 	"github.com/go-chi/chi/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// defaultTrashRetention keeps deleted products restorable for 30 days.
+const defaultTrashRetention = 30 * 24 * time.Hour
+
+// durationEnvFallback lets env override a duration flag that was left at its default.
+func durationEnvFallback(value, def time.Duration, env string) time.Duration {
+	raw := os.Getenv(env)
+	if raw == "" || value != def {
+		return value
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		log.Fatalf("parse %s: %v", env, err)
+	}
+	return d
+}
+
+// stringEnvFallback lets env override a string flag that was left at its default.
+func stringEnvFallback(value, def, env string) string {
+	if raw := os.Getenv(env); raw != "" && value == def {
+		return raw
+	}
+	return value
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(runImport(os.Args[2:]))
+	}
+
 	addr := flag.String("addr", ":8080", "listen address")
 	dsnFlag := flag.String("db-dsn", "", "Postgres DSN (if empty, use in-memory repo)")
+	schedulerFlag := flag.Duration("price-scheduler-interval", time.Minute, "how often scheduled prices are applied (0 disables)")
+	trashRetentionFlag := flag.Duration("trash-retention", defaultTrashRetention, "how long deleted products stay restorable before they are purged")
+	trashPurgeFlag := flag.Duration("trash-purge-interval", time.Hour, "how often expired trash is purged (0 disables)")
+	ratesFlag := flag.String("fx-rates", "", "exchange rate JSON file (if empty, only stored currencies and overrides are served)")
+	blobDirFlag := flag.String("blob-dir", "", "directory product images are stored in (required with a DSN; if empty, keep them in memory)")
+	cacheProductFlag := flag.String("cache-control-product", appshttp.DefaultCachePolicy.Product, "Cache-Control sent with GET /products/{id}")
+	cacheSearchFlag := flag.String("cache-control-search", appshttp.DefaultCachePolicy.Search, "Cache-Control sent with GET /products/search")
 	flag.Parse()
 
 	// 支持 env 回退
@@ -31,6 +74,28 @@ func main() {
 	if dsn == "" {
 		dsn = os.Getenv("DATABASE_URL")
 	}
+	ratesPath := *ratesFlag
+	if ratesPath == "" {
+		ratesPath = os.Getenv("EXCHANGE_RATES_FILE")
+	}
+	blobDir := *blobDirFlag
+	if blobDir == "" {
+		blobDir = os.Getenv("BLOB_DIR")
+	}
+	schedulerInterval := durationEnvFallback(*schedulerFlag, time.Minute, "PRICE_SCHEDULER_INTERVAL")
+	trashRetention := durationEnvFallback(*trashRetentionFlag, defaultTrashRetention, "TRASH_RETENTION")
+	trashPurgeInterval := durationEnvFallback(*trashPurgeFlag, time.Hour, "TRASH_PURGE_INTERVAL")
+	cachePolicy := appshttp.CachePolicy{
+		Product: stringEnvFallback(*cacheProductFlag, appshttp.DefaultCachePolicy.Product, "CACHE_CONTROL_PRODUCT"),
+		Search:  stringEnvFallback(*cacheSearchFlag, appshttp.DefaultCachePolicy.Search, "CACHE_CONTROL_SEARCH"),
+	}
+	if trashRetention < 0 {
+		log.Fatalf("trash retention must be >= 0, got %s", trashRetention)
+	}
+	// 图片元数据存 Postgres 时，内容放在内存里会在重启后丢失，只剩读不出内容的元数据
+	if dsn != "" && blobDir == "" {
+		log.Fatal("-blob-dir or BLOB_DIR is required when a Postgres DSN is configured")
+	}
 	// address env fallback
 	if *addr == ":8080" { // only override default
 		if envAddr := os.Getenv("HTTP_ADDRESS"); envAddr != "" {
@@ -41,10 +106,17 @@ func main() {
 	log.Println("starting product-query-svc")
 
 	var (
-		repo        outbound.ProductRepository
-		userRepo    outbound.UserRepository
-		commentRepo outbound.CommentRepository
-		pool        *pgxpool.Pool
+		repo          outbound.ProductRepository
+		userRepo      outbound.UserRepository
+		commentRepo   outbound.CommentRepository
+		categoryRepo  outbound.CategoryRepository
+		variantRepo   outbound.VariantRepository
+		inventoryRepo outbound.InventoryRepository
+		imageRepo     outbound.ImageRepository
+		related       outbound.RecommendationSource
+		translations  outbound.TranslationRepository
+		blobs         outbound.BlobStore
+		pool          *pgxpool.Pool
 	)
 
 	// If DSN provided, use Postgres wiring
@@ -61,19 +133,67 @@ func main() {
 		repo = appspg.NewProductRepository(pool)
 		userRepo = appspg.NewUserRepository(pool)
 		commentRepo = appspg.NewCommentRepository(pool)
+		categoryRepo = appspg.NewCategoryRepository(pool)
+		variantRepo = appspg.NewVariantRepository(pool)
+		inventoryRepo = appspg.NewInventoryRepository(pool)
+		imageRepo = appspg.NewImageRepository(pool)
+		related = appspg.NewRecommendationSource(pool)
+		translations = appspg.NewTranslationRepository(pool)
 	} else {
 		store := appsinmem.NewInMemRepo()
 		repo = store
 		userRepo = store
 		commentRepo = store
+		categoryRepo = store
+		variantRepo = store
+		inventoryRepo = store
+		imageRepo = store
+		related = store
+		translations = store
+	}
+
+	if blobDir != "" {
+		store, err := localblob.New(blobDir)
+		if err != nil {
+			log.Fatalf("open blob dir: %v", err)
+		}
+		blobs = store
+	} else {
+		blobs = appsinmem.NewBlobStore()
+	}
+
+	var rates outbound.ExchangeRateProvider
+	if ratesPath != "" {
+		provider, err := staticrates.LoadFile(ratesPath)
+		if err != nil {
+			log.Fatalf("load exchange rates: %v", err)
+		}
+		rates = provider
 	}
 
 	// build service
-	productSvc := productapp.NewService(repo)
+	productSvc := productapp.NewService(repo, categoryRepo, translations, blobs, rates)
 	userSvc := userapp.NewService(userRepo)
 	commentSvc := commentapp.NewService(commentRepo, repo, userRepo)
-
-	server := appshttp.NewServer(productSvc, userSvc, commentSvc)
+	categorySvc := categoryapp.NewService(categoryRepo)
+	variantSvc := variantapp.NewService(variantRepo, repo)
+	inventorySvc := inventoryapp.NewService(inventoryRepo, repo, variantRepo)
+	imageSvc := imageapp.NewService(imageRepo, blobs, repo)
+	recommendationSvc := recommendationapp.NewService(related, repo)
+	translationSvc := translationapp.NewService(translations, repo)
+
+	server := appshttp.NewServer(productSvc, userSvc, commentSvc, categorySvc, variantSvc, inventorySvc, imageSvc, recommendationSvc, translationSvc, appshttp.WithCachePolicy(cachePolicy))
+
+	// 后台定时应用到期的预约调价
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	if schedulerInterval > 0 {
+		go productapp.NewPriceScheduler(productSvc, schedulerInterval).Run(schedulerCtx)
+	}
+	// 后台定时清理超过保留期的回收站商品
+	if trashPurgeInterval > 0 {
+		go productapp.NewTrashPurger(productSvc, trashRetention, trashPurgeInterval).Run(schedulerCtx)
+	}
 
 	apiHandler, err := appshttp.NewAPIHandler(server, nil)
 	if err != nil {
@@ -86,9 +206,13 @@ func main() {
 	// 注册 OpenAPI 严格处理器到 chi Router，并前置请求校验
 	r.Mount("/", apiHandler)
 
+	// 请求体上限由 API handler 控制，这里限制读取耗时，避免慢连接长期占用
 	srv := &http.Server{
-		Addr:    *addr,
-		Handler: r,
+		Addr:              *addr,
+		Handler:           r,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       time.Minute,
+		IdleTimeout:       2 * time.Minute,
 	}
 
 	// 启动服务器
@@ -104,6 +228,7 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("shutting down server...")
+	stopScheduler()
 
 	ctxShut, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
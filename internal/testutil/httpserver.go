@@ -5,18 +5,73 @@ import (
 	"net/http/httptest"
 
 	httpadapter "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/staticrates"
+	categoryapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/category"
 	commentapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/comment"
+	imageapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/image"
+	inventoryapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/inventory"
 	productapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/product"
+	recommendationapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/recommendation"
+	translationapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/translation"
 	userapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/user"
+	variantapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/variant"
 	"github.com/fightingBald/GoTuto/apps/product-query-svc/ports/outbound"
 )
 
-// NewHTTPHandler wires repos -> services -> HTTP handler.
-func NewHTTPHandler(productRepo outbound.ProductRepository, userRepo outbound.UserRepository, commentRepo outbound.CommentRepository) http.Handler {
-	productSvc := productapp.NewService(productRepo)
-	userSvc := userapp.NewService(userRepo)
-	commentSvc := commentapp.NewService(commentRepo, productRepo, userRepo)
-	server := httpadapter.NewServer(productSvc, userSvc, commentSvc)
+// ExchangeRates are the fixed rates, in units per USD, that test servers convert prices with.
+var ExchangeRates = map[string]string{"EUR": "0.9", "GBP": "0.8", "JPY": "150", "KWD": "0.3075"}
+
+// NewExchangeRates builds the static provider backing ExchangeRates.
+func NewExchangeRates() outbound.ExchangeRateProvider {
+	rates, err := staticrates.New("USD", ExchangeRates)
+	if err != nil {
+		panic(err)
+	}
+	return rates
+}
+
+// Ports are the outbound adapters a test server is wired to.
+type Ports struct {
+	Products     outbound.ProductRepository
+	Users        outbound.UserRepository
+	Comments     outbound.CommentRepository
+	Categories   outbound.CategoryRepository
+	Variants     outbound.VariantRepository
+	Inventory    outbound.InventoryRepository
+	Images       outbound.ImageRepository
+	Related      outbound.RecommendationSource
+	Translations outbound.TranslationRepository
+}
+
+// InMemPorts backs every port with the same in-memory store; override single fields to inject fakes.
+func InMemPorts(store *inmem.InMemRepo) Ports {
+	return Ports{
+		Products:     store,
+		Users:        store,
+		Comments:     store,
+		Categories:   store,
+		Variants:     store,
+		Inventory:    store,
+		Images:       store,
+		Related:      store,
+		Translations: store,
+	}
+}
+
+// NewHTTPHandler wires ports -> services -> HTTP handler; image content is kept in an in-memory blob store.
+func NewHTTPHandler(p Ports) http.Handler {
+	blobs := inmem.NewBlobStore()
+	productSvc := productapp.NewService(p.Products, p.Categories, p.Translations, blobs, NewExchangeRates())
+	userSvc := userapp.NewService(p.Users)
+	commentSvc := commentapp.NewService(p.Comments, p.Products, p.Users)
+	categorySvc := categoryapp.NewService(p.Categories)
+	variantSvc := variantapp.NewService(p.Variants, p.Products)
+	inventorySvc := inventoryapp.NewService(p.Inventory, p.Products, p.Variants)
+	imageSvc := imageapp.NewService(p.Images, blobs, p.Products)
+	recommendationSvc := recommendationapp.NewService(p.Related, p.Products)
+	translationSvc := translationapp.NewService(p.Translations, p.Products)
+	server := httpadapter.NewServer(productSvc, userSvc, commentSvc, categorySvc, variantSvc, inventorySvc, imageSvc, recommendationSvc, translationSvc)
 	h, err := httpadapter.NewAPIHandler(server, nil)
 	if err != nil {
 		panic(err)
@@ -25,7 +80,11 @@ func NewHTTPHandler(productRepo outbound.ProductRepository, userRepo outbound.Us
 }
 
 // NewHTTPServer starts an httptest.Server for convenience.
-func NewHTTPServer(productRepo outbound.ProductRepository, userRepo outbound.UserRepository, commentRepo outbound.CommentRepository) *httptest.Server {
-	h := NewHTTPHandler(productRepo, userRepo, commentRepo)
-	return httptest.NewServer(h)
+func NewHTTPServer(p Ports) *httptest.Server {
+	return httptest.NewServer(NewHTTPHandler(p))
+}
+
+// NewInMemHTTPServer starts an httptest.Server backed entirely by store.
+func NewInMemHTTPServer(store *inmem.InMemRepo) *httptest.Server {
+	return NewHTTPServer(InMemPorts(store))
 }
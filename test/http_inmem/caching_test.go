@@ -0,0 +1,135 @@
+package http_inmem_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestConditionalGet_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	send := func(t *testing.T, method, path string, headers map[string]string, body string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http %s: %v", method, err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	t.Run("product read revalidates with If-None-Match", func(t *testing.T) {
+		resp := send(t, http.MethodGet, "/products/2", nil, "")
+		etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+		if resp.StatusCode != http.StatusOK || etag != `"1"` || lastModified == "" {
+			t.Fatalf("expected 200 with ETag and Last-Modified, got %d etag=%q lm=%q", resp.StatusCode, etag, lastModified)
+		}
+		if cc := resp.Header.Get("Cache-Control"); cc != "no-cache" {
+			t.Fatalf("expected default Cache-Control no-cache, got %q", cc)
+		}
+
+		for _, inm := range []string{etag, `W/"1"`, `"7", "1"`, "*"} {
+			resp = send(t, http.MethodGet, "/products/2", map[string]string{"If-None-Match": inm}, "")
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != http.StatusNotModified || len(body) != 0 {
+				t.Fatalf("If-None-Match %s: expected empty 304, got %d %q", inm, resp.StatusCode, body)
+			}
+			if resp.Header.Get("ETag") != etag || resp.Header.Get("Cache-Control") != "no-cache" {
+				t.Fatalf("304 must repeat validators, got etag=%q cc=%q", resp.Header.Get("ETag"), resp.Header.Get("Cache-Control"))
+			}
+		}
+
+		// If-None-Match wins over a matching If-Modified-Since
+		resp = send(t, http.MethodGet, "/products/2", map[string]string{"If-None-Match": `"7"`, "If-Modified-Since": lastModified}, "")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200 for a mismatched If-None-Match, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("product read revalidates with If-Modified-Since", func(t *testing.T) {
+		resp := send(t, http.MethodGet, "/products/2", nil, "")
+		lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified"))
+		if err != nil {
+			t.Fatalf("parse Last-Modified: %v", err)
+		}
+
+		for since, want := range map[time.Time]int{
+			lastModified:                   http.StatusNotModified,
+			lastModified.Add(time.Hour):    http.StatusNotModified,
+			lastModified.Add(-time.Second): http.StatusOK,
+		} {
+			resp = send(t, http.MethodGet, "/products/2", map[string]string{"If-Modified-Since": since.Format(http.TimeFormat)}, "")
+			if resp.StatusCode != want {
+				t.Fatalf("If-Modified-Since %s: expected %d, got %d", since, want, resp.StatusCode)
+			}
+		}
+		if resp = send(t, http.MethodGet, "/products/2", map[string]string{"If-Modified-Since": "yesterday"}, ""); resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected an unparseable date to be ignored, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("converted reads use a content ETag", func(t *testing.T) {
+		resp := send(t, http.MethodGet, "/products/2?currency=GBP", nil, "")
+		etag := resp.Header.Get("ETag")
+		if resp.StatusCode != http.StatusOK || etag == "" || etag == `"1"` {
+			t.Fatalf("expected a content ETag, got %d %q", resp.StatusCode, etag)
+		}
+		resp = send(t, http.MethodGet, "/products/2?currency=GBP", map[string]string{"If-None-Match": etag}, "")
+		if resp.StatusCode != http.StatusNotModified {
+			t.Fatalf("expected 304, got %d", resp.StatusCode)
+		}
+		if other := send(t, http.MethodGet, "/products/2?currency=JPY", nil, "").Header.Get("ETag"); other == etag {
+			t.Fatalf("expected a different ETag per currency, got %q twice", etag)
+		}
+	})
+
+	t.Run("writes invalidate product and search validators", func(t *testing.T) {
+		resp := send(t, http.MethodGet, "/products/search?q=widget", nil, "")
+		searchETag := resp.Header.Get("ETag")
+		if resp.StatusCode != http.StatusOK || searchETag == "" || resp.Header.Get("Cache-Control") != "no-cache" {
+			t.Fatalf("expected search ETag and Cache-Control, got %d etag=%q cc=%q", resp.StatusCode, searchETag, resp.Header.Get("Cache-Control"))
+		}
+		if resp.Header.Get("Last-Modified") != "" {
+			t.Fatalf("search must not send Last-Modified, got %q", resp.Header.Get("Last-Modified"))
+		}
+		resp = send(t, http.MethodGet, "/products/search?q=widget", map[string]string{"If-None-Match": searchETag}, "")
+		if resp.StatusCode != http.StatusNotModified {
+			t.Fatalf("expected search 304, got %d", resp.StatusCode)
+		}
+
+		resp = send(t, http.MethodGet, "/products/1", nil, "")
+		etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+		resp = send(t, http.MethodPatch, "/products/1", map[string]string{"Content-Type": "application/merge-patch+json"}, `{"priceCents":1799}`)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("patch: expected 200, got %d", resp.StatusCode)
+		}
+
+		resp = send(t, http.MethodGet, "/products/1", map[string]string{"If-None-Match": etag}, "")
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") == etag {
+			t.Fatalf("expected a fresh product after the write, got %d etag=%q", resp.StatusCode, resp.Header.Get("ETag"))
+		}
+		resp = send(t, http.MethodGet, "/products/1", map[string]string{"If-Modified-Since": lastModified}, "")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected Last-Modified to advance, got %d", resp.StatusCode)
+		}
+		resp = send(t, http.MethodGet, "/products/search?q=widget", map[string]string{"If-None-Match": searchETag}, "")
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") == searchETag {
+			t.Fatalf("expected a fresh search page after the write, got %d etag=%q", resp.StatusCode, resp.Header.Get("ETag"))
+		}
+	})
+}
@@ -0,0 +1,208 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestCategories_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	do := func(t *testing.T, method, path, ifMatch, body string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(resp.Body)
+		return resp, raw
+	}
+	create := func(t *testing.T, body string) appshttp.Category {
+		t.Helper()
+		resp, raw := do(t, http.MethodPost, "/categories", "", body)
+		var c appshttp.Category
+		if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &c) != nil {
+			t.Fatalf("create %s: %d %s", body, resp.StatusCode, raw)
+		}
+		return c
+	}
+	patchProduct := func(t *testing.T, body string) (*http.Response, appshttp.Product) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodPatch, ts.URL+"/products/1", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("patch: %v", err)
+		}
+		defer resp.Body.Close()
+		var p appshttp.Product
+		_ = json.NewDecoder(resp.Body).Decode(&p)
+		return resp, p
+	}
+	breadcrumb := func(path []appshttp.Breadcrumb) string {
+		names := make([]string, 0, len(path))
+		for _, b := range path {
+			names = append(names, b.Name)
+		}
+		return strings.Join(names, " > ")
+	}
+
+	electronics := create(t, `{"name":"Electronics"}`)
+	audio := create(t, fmt.Sprintf(`{"name":"Audio","parentId":%d}`, electronics.Id))
+	headphones := create(t, fmt.Sprintf(`{"name":"Headphones","parentId":%d}`, audio.Id))
+	garden := create(t, `{"name":"Garden"}`)
+
+	t.Run("categories carry their breadcrumb", func(t *testing.T) {
+		if breadcrumb(headphones.Path) != "Electronics > Audio > Headphones" || headphones.Version != 1 {
+			t.Fatalf("unexpected category: %+v", headphones)
+		}
+		resp, raw := do(t, http.MethodGet, "/categories", "", "")
+		var list appshttp.CategoryList
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &list) != nil || len(list.Items) != 4 {
+			t.Fatalf("unexpected list: %d %s", resp.StatusCode, raw)
+		}
+		if breadcrumb(list.Items[2].Path) != "Electronics > Audio > Headphones" || list.Items[3].ParentId != nil {
+			t.Fatalf("unexpected list: %+v", list.Items)
+		}
+	})
+
+	t.Run("invalid trees are rejected", func(t *testing.T) {
+		cases := []struct {
+			method, path, body string
+			status             int
+		}{
+			{http.MethodPost, "/categories", `{"name":"audio","parentId":1}`, http.StatusConflict},
+			{http.MethodPost, "/categories", `{"name":"Audio","parentId":99}`, http.StatusBadRequest},
+			{http.MethodPut, fmt.Sprintf("/categories/%d", electronics.Id), fmt.Sprintf(`{"name":"Electronics","parentId":%d}`, headphones.Id), http.StatusBadRequest},
+			{http.MethodPut, fmt.Sprintf("/categories/%d", audio.Id), fmt.Sprintf(`{"name":"Audio","parentId":%d}`, audio.Id), http.StatusBadRequest},
+			{http.MethodGet, "/categories/99", "", http.StatusNotFound},
+		}
+		for _, c := range cases {
+			if resp, raw := do(t, c.method, c.path, "", c.body); resp.StatusCode != c.status {
+				t.Fatalf("%s %s %s: expected %d, got %d %s", c.method, c.path, c.body, c.status, resp.StatusCode, raw)
+			}
+		}
+	})
+
+	t.Run("products are filed under a category and found through its ancestors", func(t *testing.T) {
+		patched, p := patchProduct(t, fmt.Sprintf(`{"categoryId":%d}`, headphones.Id))
+		if patched.StatusCode != http.StatusOK || p.CategoryId == nil || *p.CategoryId != headphones.Id || breadcrumb(p.CategoryPath) != "Electronics > Audio > Headphones" {
+			t.Fatalf("unexpected patched product: %d %+v", patched.StatusCode, p)
+		}
+
+		for query, want := range map[string]int{
+			fmt.Sprintf("?category=%d", electronics.Id): 1,
+			fmt.Sprintf("?category=%d", audio.Id):       1,
+			fmt.Sprintf("?category=%d", garden.Id):      0,
+			"":                                          2,
+		} {
+			resp, raw := do(t, http.MethodGet, "/products/search"+query, "", "")
+			var list appshttp.ProductList
+			if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &list) != nil || len(list.Items) != want {
+				t.Fatalf("search %q: expected %d items, got %d %s", query, want, resp.StatusCode, raw)
+			}
+			if want == 1 && breadcrumb(list.Items[0].CategoryPath) != "Electronics > Audio > Headphones" {
+				t.Fatalf("search %q: missing breadcrumb: %+v", query, list.Items[0])
+			}
+		}
+
+		resp, raw := do(t, http.MethodGet, "/products/2", "", "")
+		var uncategorised appshttp.Product
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &uncategorised) != nil || uncategorised.CategoryId != nil || uncategorised.CategoryPath == nil || len(uncategorised.CategoryPath) != 0 {
+			t.Fatalf("expected an empty breadcrumb for product 2, got %s", raw)
+		}
+
+		if resp, raw := do(t, http.MethodPost, "/products", "", `{"name":"Lost Widget","priceCents":100,"categoryId":99}`); resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400 for an unknown category, got %d %s", resp.StatusCode, raw)
+		}
+	})
+
+	t.Run("moving a category moves its subtree", func(t *testing.T) {
+		resp, raw := do(t, http.MethodPut, fmt.Sprintf("/categories/%d", audio.Id), `"1"`, fmt.Sprintf(`{"name":"Sound","parentId":%d}`, garden.Id))
+		var moved appshttp.Category
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &moved) != nil || breadcrumb(moved.Path) != "Garden > Sound" || resp.Header.Get("ETag") != `"2"` {
+			t.Fatalf("unexpected move: %d %s", resp.StatusCode, raw)
+		}
+		resp, raw = do(t, http.MethodGet, "/products/1", "", "")
+		var p appshttp.Product
+		if json.Unmarshal(raw, &p) != nil || breadcrumb(p.CategoryPath) != "Garden > Sound > Headphones" {
+			t.Fatalf("expected the product breadcrumb to follow the move, got %s", raw)
+		}
+		if resp, _ := do(t, http.MethodPut, fmt.Sprintf("/categories/%d", audio.Id), `"1"`, `{"name":"Audio"}`); resp.StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("expected 412 for a stale version, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("renaming a category invalidates product validators", func(t *testing.T) {
+		revalidate := func(t *testing.T, etag string) int {
+			t.Helper()
+			req, _ := http.NewRequest(http.MethodGet, ts.URL+"/products/1", nil)
+			req.Header.Set("If-None-Match", etag)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("get: %v", err)
+			}
+			resp.Body.Close()
+			return resp.StatusCode
+		}
+		resp, _ := do(t, http.MethodGet, "/products/1", "", "")
+		etag := resp.Header.Get("ETag")
+		path := fmt.Sprintf("/categories/%d", headphones.Id)
+
+		// an unchanged name and parent leave the breadcrumb, and so the product, as it was
+		if resp, raw := do(t, http.MethodPut, path, "", fmt.Sprintf(`{"name":"Headphones","parentId":%d}`, audio.Id)); resp.StatusCode != http.StatusOK {
+			t.Fatalf("update: %d %s", resp.StatusCode, raw)
+		}
+		if status := revalidate(t, etag); status != http.StatusNotModified {
+			t.Fatalf("expected 304 before the rename, got %d", status)
+		}
+
+		if resp, raw := do(t, http.MethodPut, path, "", fmt.Sprintf(`{"name":"Earphones","parentId":%d}`, audio.Id)); resp.StatusCode != http.StatusOK {
+			t.Fatalf("rename: %d %s", resp.StatusCode, raw)
+		}
+		if status := revalidate(t, etag); status != http.StatusOK {
+			t.Fatalf("expected 200 for the old ETag after the rename, got %d", status)
+		}
+		resp, raw := do(t, http.MethodGet, "/products/1", "", "")
+		var p appshttp.Product
+		if json.Unmarshal(raw, &p) != nil || breadcrumb(p.CategoryPath) != "Garden > Sound > Earphones" || resp.Header.Get("ETag") == etag {
+			t.Fatalf("expected the new breadcrumb under a new ETag, got %s %s", resp.Header.Get("ETag"), raw)
+		}
+	})
+
+	t.Run("only empty categories can be deleted", func(t *testing.T) {
+		path := fmt.Sprintf("/categories/%d", headphones.Id)
+		if resp, raw := do(t, http.MethodDelete, path, "", ""); resp.StatusCode != http.StatusConflict {
+			t.Fatalf("expected 409 while a product is filed there, got %d %s", resp.StatusCode, raw)
+		}
+		patched, p := patchProduct(t, `{"name":"Blue Widget","categoryId":null}`)
+		if patched.StatusCode != http.StatusOK || p.CategoryId != nil || len(p.CategoryPath) != 0 || p.Name != "Blue Widget" {
+			t.Fatalf("expected null to uncategorise the product, got %d %+v", patched.StatusCode, p)
+		}
+
+		if resp, _ := do(t, http.MethodDelete, path, "", ""); resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("expected 204, got %d", resp.StatusCode)
+		}
+		if resp, _ := do(t, http.MethodDelete, fmt.Sprintf("/categories/%d", garden.Id), "", ""); resp.StatusCode != http.StatusConflict {
+			t.Fatalf("expected 409 for a category with subcategories, got %d", resp.StatusCode)
+		}
+	})
+}
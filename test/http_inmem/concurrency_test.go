@@ -0,0 +1,267 @@
+package http_inmem_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestOptimisticConcurrency_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	send := func(t *testing.T, method, path, ifMatch, body string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http %s: %v", method, err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	t.Run("product writes require the current ETag", func(t *testing.T) {
+		resp := send(t, http.MethodGet, "/products/1", "", "")
+		var p appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		etag := resp.Header.Get("ETag")
+		if p.Version != 1 || etag != `"1"` {
+			t.Fatalf("expected version 1 and ETag \"1\", got %d %q", p.Version, etag)
+		}
+
+		update := `{"name":"Blue Widget v2","priceCents":2099}`
+		resp = send(t, http.MethodPut, "/products/1", etag, update)
+		if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK || p.Version != 2 || resp.Header.Get("ETag") != `"2"` {
+			t.Fatalf("expected update to version 2, got %d %+v etag=%q", resp.StatusCode, p, resp.Header.Get("ETag"))
+		}
+
+		// the first ETag is now stale
+		for _, tc := range []struct{ method, body string }{{http.MethodPut, update}, {http.MethodDelete, ""}} {
+			resp = send(t, tc.method, "/products/1", etag, tc.body)
+			var e struct{ Code string }
+			_ = json.NewDecoder(resp.Body).Decode(&e)
+			if resp.StatusCode != http.StatusPreconditionFailed || e.Code != "PRECONDITION_FAILED" {
+				t.Fatalf("%s with stale ETag: expected 412, got %d %+v", tc.method, resp.StatusCode, e)
+			}
+		}
+		for _, bad := range []string{`W/"2"`, `"2", "3"`, `2`} {
+			if resp = send(t, http.MethodPut, "/products/1", bad, update); resp.StatusCode != http.StatusPreconditionFailed {
+				t.Fatalf("If-Match %s: expected 412, got %d", bad, resp.StatusCode)
+			}
+		}
+
+		// `*` and a missing header write unconditionally
+		if resp = send(t, http.MethodPut, "/products/1", "*", update); resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") != `"3"` {
+			t.Fatalf("expected unconditional update, got %d etag=%q", resp.StatusCode, resp.Header.Get("ETag"))
+		}
+		if resp = send(t, http.MethodDelete, "/products/1", `"3"`, ""); resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("expected delete with current ETag, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("tag edits bump the version", func(t *testing.T) {
+		resp := send(t, http.MethodPost, "/products/2/tags", "", `{"tag":"sale"}`)
+		var p appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK || p.Version != 2 {
+			t.Fatalf("expected version 2 after adding a tag, got %d %+v", resp.StatusCode, p)
+		}
+		if resp = send(t, http.MethodPut, "/products/2", `"1"`, `{"name":"Red Gizmo","priceCents":2999}`); resp.StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("expected 412 after a concurrent tag edit, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("comment writes require the current ETag", func(t *testing.T) {
+		resp := send(t, http.MethodPost, "/products/2/comments", "", `{"userId":1,"content":"first"}`)
+		var c appshttp.Comment
+		if err := json.NewDecoder(resp.Body).Decode(&c); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if resp.StatusCode != http.StatusCreated || c.Version != 1 || resp.Header.Get("ETag") != `"1"` {
+			t.Fatalf("expected a new comment at version 1, got %d %+v etag=%q", resp.StatusCode, c, resp.Header.Get("ETag"))
+		}
+		path := fmt.Sprintf("/products/2/comments/%d?userId=1", c.Id)
+
+		resp = send(t, http.MethodPut, path, `"1"`, `{"userId":1,"content":"edited"}`)
+		if err := json.NewDecoder(resp.Body).Decode(&c); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK || c.Version != 2 || resp.Header.Get("ETag") != `"2"` {
+			t.Fatalf("expected comment version 2, got %d %+v", resp.StatusCode, c)
+		}
+		if resp = send(t, http.MethodPut, path, `"1"`, `{"userId":1,"content":"lost"}`); resp.StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("expected 412 for a stale comment update, got %d", resp.StatusCode)
+		}
+		if resp = send(t, http.MethodDelete, path, `"1"`, ""); resp.StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("expected 412 for a stale comment delete, got %d", resp.StatusCode)
+		}
+		if resp = send(t, http.MethodDelete, path, `"2"`, ""); resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("expected comment delete with current ETag, got %d", resp.StatusCode)
+		}
+	})
+}
+
+// racingRepo lets another writer edit the description right after each of the next races reads,
+// so the write that follows the read is made against a stale version.
+type racingRepo struct {
+	*appsinmem.InMemRepo
+	races atomic.Int32
+}
+
+func (r *racingRepo) GetByID(ctx context.Context, id int64) (*domain.Product, error) {
+	p, err := r.InMemRepo.GetByID(ctx, id)
+	if err != nil || r.races.Add(-1) < 0 {
+		return p, err
+	}
+	other, err := r.InMemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	other.Description = fmt.Sprintf("edited at version %d", other.Version)
+	return p, r.InMemRepo.Update(ctx, other)
+}
+
+func TestConcurrentProductWrites_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ports := testutil.InMemPorts(store)
+	repo := &racingRepo{InMemRepo: store}
+	ports.Products = repo
+	ts := testutil.NewHTTPServer(ports)
+	t.Cleanup(ts.Close)
+
+	send := func(t *testing.T, method, path, ifMatch, body string) (int, appshttp.Product) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if method == http.MethodPatch {
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+		}
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http %s: %v", method, err)
+		}
+		defer resp.Body.Close()
+		var p appshttp.Product
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return resp.StatusCode, p
+	}
+
+	etag := func(p appshttp.Product) string { return fmt.Sprintf("%q", fmt.Sprint(p.Version)) }
+
+	t.Run("unconditional edits are retried after a lost race", func(t *testing.T) {
+		repo.races.Store(1)
+		status, p := send(t, http.MethodPost, "/products/1/tags", "", `{"tag":"mine"}`)
+		if status != http.StatusOK || strings.Join(p.Tags, ",") != "gadget,blue,mine" || p.Description != "edited at version 1" || p.Version != 3 {
+			t.Fatalf("expected both edits at version 3, got %d %+v", status, p)
+		}
+	})
+
+	t.Run("conditional edits report the lost race", func(t *testing.T) {
+		_, current := send(t, http.MethodGet, "/products/1", "", "")
+		repo.races.Store(1)
+		if status, _ := send(t, http.MethodPost, "/products/1/archive", etag(current), ""); status != http.StatusPreconditionFailed {
+			t.Fatalf("expected 412, got %d", status)
+		}
+		_, after := send(t, http.MethodGet, "/products/1", "", "")
+		if after.Status != appshttp.ProductStatusPublished || after.Version != current.Version+1 {
+			t.Fatalf("expected only the concurrent write to land, got %+v", after)
+		}
+	})
+
+	t.Run("unconditional replacements and patches are retried after a lost race", func(t *testing.T) {
+		_, before := send(t, http.MethodGet, "/products/2", "", "")
+		repo.races.Store(1)
+		status, p := send(t, http.MethodPut, "/products/2", "", `{"name":"Red Gizmo","priceCents":2999}`)
+		if status != http.StatusOK || p.Name != "Red Gizmo" || p.Version != before.Version+2 {
+			t.Fatalf("expected the replacement after the concurrent edit, got %d %+v", status, p)
+		}
+		repo.races.Store(1)
+		status, p = send(t, http.MethodPatch, "/products/2", "", `{"priceCents":3199}`)
+		if status != http.StatusOK || p.PriceCents != 3199 || p.Description != fmt.Sprintf("edited at version %d", before.Version+2) || p.Version != before.Version+4 {
+			t.Fatalf("expected both edits after the patch, got %d %+v", status, p)
+		}
+	})
+
+	t.Run("conditional replacements and patches report the lost race", func(t *testing.T) {
+		_, current := send(t, http.MethodGet, "/products/2", "", "")
+		repo.races.Store(1)
+		if status, _ := send(t, http.MethodPut, "/products/2", etag(current), `{"name":"Lost","priceCents":1}`); status != http.StatusPreconditionFailed {
+			t.Fatalf("expected 412 for PUT, got %d", status)
+		}
+		_, current = send(t, http.MethodGet, "/products/2", "", "")
+		repo.races.Store(1)
+		if status, _ := send(t, http.MethodPatch, "/products/2", etag(current), `{"name":"Lost"}`); status != http.StatusPreconditionFailed {
+			t.Fatalf("expected 412 for PATCH, got %d", status)
+		}
+		if _, after := send(t, http.MethodGet, "/products/2", "", ""); after.Name == "Lost" {
+			t.Fatalf("expected neither conditional write to land, got %+v", after)
+		}
+	})
+
+	t.Run("stale ETags are rejected", func(t *testing.T) {
+		for _, tc := range []struct{ method, path, body string }{
+			{http.MethodPost, "/products/1/publish", ""},
+			{http.MethodPost, "/products/1/archive", ""},
+			{http.MethodPost, "/products/1/unarchive", ""},
+			{http.MethodPost, "/products/1/tags", `{"tag":"late"}`},
+			{http.MethodDelete, "/products/1/tags/mine", ""},
+		} {
+			if status, _ := send(t, tc.method, tc.path, `"1"`, tc.body); status != http.StatusPreconditionFailed {
+				t.Fatalf("%s %s: expected 412, got %d", tc.method, tc.path, status)
+			}
+		}
+	})
+
+	t.Run("retries give up under sustained contention", func(t *testing.T) {
+		// without If-Match the caller set no precondition, so running out of retries is a conflict, not a 412
+		for _, tc := range []struct{ method, path, body string }{
+			{http.MethodDelete, "/products/1/tags/mine", ""},
+			{http.MethodPut, "/products/2", `{"name":"Red Gizmo","priceCents":2999}`},
+			{http.MethodPatch, "/products/2", `{"name":"Red Gizmo"}`},
+		} {
+			repo.races.Store(3)
+			if status, _ := send(t, tc.method, tc.path, "", tc.body); status != http.StatusConflict {
+				t.Fatalf("%s %s: expected 409, got %d", tc.method, tc.path, status)
+			}
+		}
+		repo.races.Store(0)
+		_, current := send(t, http.MethodGet, "/products/1", "", "")
+		status, p := send(t, http.MethodPost, "/products/1/archive", etag(current), "")
+		if status != http.StatusOK || p.Status != appshttp.ProductStatusArchived || p.Version != current.Version+1 {
+			t.Fatalf("expected archive with the current ETag, got %d %+v", status, p)
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+// Rates come from testutil.ExchangeRates: 1 USD = 0.9 EUR = 150 JPY = 0.3075 KWD.
+func TestProductCurrency_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	get := func(t *testing.T, path string) (appshttp.Product, int) {
+		t.Helper()
+		resp, err := http.Get(ts.URL + path)
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		var p appshttp.Product
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return p, resp.StatusCode
+	}
+
+	for _, tc := range []struct {
+		path     string
+		cents    int64
+		currency string
+		price    float64
+	}{
+		{"/products/1", 1999, "USD", 19.99},
+		{"/products/1?currency=usd", 1999, "USD", 19.99},
+		// 1999 * 0.9 = 1799.1 cents
+		{"/products/1?currency=EUR", 1799, "EUR", 17.99},
+		// 19.99 USD * 150 = 2998.5 yen, half rounds away from zero
+		{"/products/1?currency=JPY", 2999, "JPY", 2999},
+		// 19.99 USD * 0.3075 = 6.146925 KWD = 6146.925 fils
+		{"/products/1?currency=KWD", 6147, "KWD", 6.147},
+		// the EUR override wins over conversion
+		{"/products/2?currency=EUR", 2499, "EUR", 24.99},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			p, status := get(t, tc.path)
+			if status != http.StatusOK {
+				t.Fatalf("expected 200, got %d", status)
+			}
+			if p.PriceCents != tc.cents || p.Currency != tc.currency || p.Price != tc.price {
+				t.Fatalf("expected %d %s (%v), got %d %s (%v)", tc.cents, tc.currency, tc.price, p.PriceCents, p.Currency, p.Price)
+			}
+		})
+	}
+
+	t.Run("unknown or malformed currency returns 400", func(t *testing.T) {
+		for _, path := range []string{"/products/1?currency=CHF", "/products/1?currency=EURO", "/products/search?currency=12A"} {
+			if _, status := get(t, path); status != http.StatusBadRequest {
+				t.Fatalf("%s: expected 400, got %d", path, status)
+			}
+		}
+	})
+
+	t.Run("search converts every item", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "/products/search?currency=EUR")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		var list appshttp.ProductList
+		if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if len(list.Items) != 2 || list.Items[0].PriceCents != 1799 || list.Items[1].PriceCents != 2499 {
+			t.Fatalf("unexpected items: %+v", list.Items)
+		}
+		for _, p := range list.Items {
+			if p.Currency != "EUR" {
+				t.Fatalf("expected EUR, got %+v", p)
+			}
+		}
+	})
+
+	t.Run("create in another base currency with overrides", func(t *testing.T) {
+		body := `{"name":"Yen Lamp","currency":"jpy","priceCents":1500,"priceOverrides":{"usd":999}}`
+		resp, err := http.Post(ts.URL+"/products", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", resp.StatusCode)
+		}
+		var created appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if created.Currency != "JPY" || created.Price != 1500 || created.PriceOverrides["USD"] != 999 {
+			t.Fatalf("unexpected product: %+v", created)
+		}
+
+		base := "/products/" + strconv.FormatInt(created.Id, 10)
+		if p, _ := get(t, base+"?currency=USD"); p.PriceCents != 999 || p.Currency != "USD" {
+			t.Fatalf("expected USD override, got %+v", p)
+		}
+		// 1500 JPY / 150 * 0.9 = 9.00 EUR
+		if p, _ := get(t, base+"?currency=EUR"); p.PriceCents != 900 || p.Currency != "EUR" {
+			t.Fatalf("expected cross-rate conversion, got %+v", p)
+		}
+	})
+
+	t.Run("deprecated price uses the currency's minor unit", func(t *testing.T) {
+		resp, err := http.Post(ts.URL+"/products", "application/json", strings.NewReader(`{"name":"Dinar Lamp","currency":"KWD","price":1.234}`))
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		defer resp.Body.Close()
+		var created appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if resp.StatusCode != http.StatusCreated || created.PriceCents != 1234 {
+			t.Fatalf("expected 1234 fils, got %d %+v", resp.StatusCode, created)
+		}
+	})
+
+	t.Run("override in the base currency returns 400", func(t *testing.T) {
+		resp, err := http.Post(ts.URL+"/products", "application/json", strings.NewReader(`{"name":"Dup","priceCents":100,"priceOverrides":{"USD":90}}`))
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestCursorPagination_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	t.Run("products can be scrolled without counting", func(t *testing.T) {
+		var names []string
+		cursor := ""
+		for i := 0; i < 3; i++ {
+			u := ts.URL + "/products/search?sort=name&order=desc&pageSize=1&includeTotal=false"
+			if cursor != "" {
+				u += "&cursor=" + url.QueryEscape(cursor)
+			}
+			resp, err := http.Get(u)
+			if err != nil {
+				t.Fatalf("http get: %v", err)
+			}
+			var pl appshttp.ProductList
+			err = json.NewDecoder(resp.Body).Decode(&pl)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("unexpected status: %d", resp.StatusCode)
+			}
+			if pl.Total != nil || pl.Facets != nil {
+				t.Fatalf("expected total and facets to be omitted, got %v %v", pl.Total, pl.Facets)
+			}
+			for _, p := range pl.Items {
+				names = append(names, p.Name)
+			}
+			if pl.NextCursor == nil {
+				break
+			}
+			cursor = *pl.NextCursor
+		}
+		if strings.Join(names, ",") != "Red Gizmo,Blue Widget" {
+			t.Fatalf("unexpected scroll order: %v", names)
+		}
+	})
+
+	t.Run("cursor from another sort returns 400", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "/products/search?sort=price&pageSize=1")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		var pl appshttp.ProductList
+		err = json.NewDecoder(resp.Body).Decode(&pl)
+		resp.Body.Close()
+		if err != nil || pl.NextCursor == nil {
+			t.Fatalf("expected a next cursor, got %+v (err=%v)", pl, err)
+		}
+
+		resp, err = http.Get(ts.URL + "/products/search?sort=name&cursor=" + url.QueryEscape(*pl.NextCursor))
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400 for mismatched cursor, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("garbage cursor returns 400", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "/products/search?cursor=not-a-cursor")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("comments page newest first", func(t *testing.T) {
+		for i := 1; i <= 3; i++ {
+			body := fmt.Sprintf(`{"userId":1,"content":"comment %d"}`, i)
+			resp, err := http.Post(ts.URL+"/products/1/comments", "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("http post: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusCreated {
+				t.Fatalf("expected 201, got %d", resp.StatusCode)
+			}
+		}
+
+		var contents []string
+		cursor := ""
+		for i := 0; i < 4; i++ {
+			u := ts.URL + "/products/1/comments?limit=2"
+			if cursor != "" {
+				u += "&cursor=" + url.QueryEscape(cursor)
+			}
+			resp, err := http.Get(u)
+			if err != nil {
+				t.Fatalf("http get: %v", err)
+			}
+			var cl appshttp.CommentList
+			err = json.NewDecoder(resp.Body).Decode(&cl)
+			resp.Body.Close()
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			for _, c := range cl.Items {
+				contents = append(contents, c.Content)
+			}
+			if cl.NextCursor == nil {
+				break
+			}
+			cursor = *cl.NextCursor
+		}
+		if strings.Join(contents, ",") != "comment 3,comment 2,comment 1" {
+			t.Fatalf("unexpected comment order: %v", contents)
+		}
+	})
+}
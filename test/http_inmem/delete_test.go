@@ -1,16 +1,23 @@
 package http_inmem_test
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
 	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	imageapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/image"
+	productapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/product"
 	"github.com/fightingBald/GoTuto/internal/testutil"
 )
 
 func TestDeleteProduct_InMem(t *testing.T) {
 	store := appsinmem.NewInMemRepo()
-	ts := testutil.NewHTTPServer(store, store, store)
+	ts := testutil.NewInMemHTTPServer(store)
 	defer ts.Close()
 
 	t.Run("delete id=1 returns 204", func(t *testing.T) {
@@ -36,3 +43,127 @@ func TestDeleteProduct_InMem(t *testing.T) {
 		}
 	})
 }
+
+func TestProductTrash_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+	// a second service over the same store stands in for the purge goroutine; it shares the blob
+	// store with an image service so purged image content can be checked
+	blobs := appsinmem.NewBlobStore()
+	purger := productapp.NewService(store, store, store, blobs, nil)
+	images := imageapp.NewService(store, blobs, store)
+
+	do := func(t *testing.T, method, path string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http %s: %v", method, err)
+		}
+		return resp
+	}
+	status := func(t *testing.T, method, path string) int {
+		t.Helper()
+		resp := do(t, method, path)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	trash := func(t *testing.T) appshttp.ProductList {
+		t.Helper()
+		resp := do(t, http.MethodGet, "/products/trash")
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		var list appshttp.ProductList
+		if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return list
+	}
+
+	resp, err := http.Post(ts.URL+"/products/1/comments", "application/json", strings.NewReader(`{"userId":1,"content":"keep me"}`))
+	if err != nil {
+		t.Fatalf("http post: %v", err)
+	}
+	resp.Body.Close()
+
+	t.Run("deleted products move to the trash", func(t *testing.T) {
+		if got := status(t, http.MethodDelete, "/products/1"); got != http.StatusNoContent {
+			t.Fatalf("expected 204, got %d", got)
+		}
+		for _, path := range []string{"/products/1", "/products/1/comments", "/products/1/price-history"} {
+			if got := status(t, http.MethodGet, path); got != http.StatusNotFound {
+				t.Fatalf("%s: expected 404, got %d", path, got)
+			}
+		}
+		if got := status(t, http.MethodDelete, "/products/1"); got != http.StatusNotFound {
+			t.Fatalf("expected 404 deleting twice, got %d", got)
+		}
+		list := trash(t)
+		if len(list.Items) != 1 || list.Items[0].Id != 1 || list.Items[0].DeletedAt == nil || list.Total == nil || *list.Total != 1 {
+			t.Fatalf("unexpected trash: %+v", list)
+		}
+	})
+
+	t.Run("restore brings back comments and history", func(t *testing.T) {
+		resp := do(t, http.MethodPost, "/products/1/restore")
+		var p appshttp.Product
+		_ = json.NewDecoder(resp.Body).Decode(&p)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || p.Id != 1 || p.DeletedAt != nil || p.Status != appshttp.ProductStatusPublished {
+			t.Fatalf("unexpected restore result: %d %+v", resp.StatusCode, p)
+		}
+		resp = do(t, http.MethodGet, "/products/1/comments")
+		var comments appshttp.CommentList
+		_ = json.NewDecoder(resp.Body).Decode(&comments)
+		resp.Body.Close()
+		if len(comments.Items) != 1 || comments.Items[0].Content != "keep me" {
+			t.Fatalf("expected the comment to survive, got %+v", comments.Items)
+		}
+		if list := trash(t); len(list.Items) != 0 {
+			t.Fatalf("expected an empty trash, got %+v", list.Items)
+		}
+	})
+
+	t.Run("restore conflicts", func(t *testing.T) {
+		if got := status(t, http.MethodPost, "/products/1/restore"); got != http.StatusConflict {
+			t.Fatalf("expected 409 restoring a live product, got %d", got)
+		}
+		if got := status(t, http.MethodPost, "/products/999/restore"); got != http.StatusNotFound {
+			t.Fatalf("expected 404, got %d", got)
+		}
+	})
+
+	t.Run("purge removes products past retention", func(t *testing.T) {
+		png := append([]byte("\x89PNG\r\n\x1a\n"), []byte("0123456789abcdef")...)
+		for _, alt := range []string{"front", "back"} {
+			if _, err := images.Upload(context.Background(), 2, "image/png", alt, png); err != nil {
+				t.Fatalf("upload: %v", err)
+			}
+		}
+		if blobs.Len() != 2 {
+			t.Fatalf("expected two stored images, got %d", blobs.Len())
+		}
+		if got := status(t, http.MethodDelete, "/products/2"); got != http.StatusNoContent {
+			t.Fatalf("expected 204, got %d", got)
+		}
+		if n, err := purger.PurgeTrash(context.Background(), time.Hour); err != nil || n != 0 {
+			t.Fatalf("expected nothing within retention, got %d (err=%v)", n, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+		if n, err := purger.PurgeTrash(context.Background(), time.Millisecond); err != nil || n != 1 {
+			t.Fatalf("expected one purged product, got %d (err=%v)", n, err)
+		}
+		if got := status(t, http.MethodPost, "/products/2/restore"); got != http.StatusNotFound {
+			t.Fatalf("expected 404 after purge, got %d", got)
+		}
+		if blobs.Len() != 0 {
+			t.Fatalf("expected the image content to be purged too, %d blobs left", blobs.Len())
+		}
+		if list := trash(t); len(list.Items) != 0 || *list.Total != 0 {
+			t.Fatalf("expected an empty trash, got %+v", list)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package http_inmem_test
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestExportProducts_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	// a draft only shows up for includeUnpublished
+	resp, err := http.Post(ts.URL+"/products", "application/json", strings.NewReader(`{"name":"Draft Widget","priceCents":500,"tags":["blue"]}`))
+	if err != nil || resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create draft: %v %v", resp, err)
+	}
+	resp.Body.Close()
+
+	export := func(t *testing.T, query string) *http.Response {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/products:export" + query)
+		if err != nil {
+			t.Fatalf("export: %v", err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	t.Run("ndjson is the default and streams one product per line", func(t *testing.T) {
+		resp := export(t, "")
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/x-ndjson" {
+			t.Fatalf("expected ndjson, got %d %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+		var ids []int64
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			var p appshttp.Product
+			if err := json.Unmarshal(scanner.Bytes(), &p); err != nil {
+				t.Fatalf("decode line %q: %v", scanner.Text(), err)
+			}
+			ids = append(ids, p.Id)
+		}
+		if len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+			t.Fatalf("expected published products 1 and 2 in id order, got %v", ids)
+		}
+	})
+
+	t.Run("json array honours search filters", func(t *testing.T) {
+		resp := export(t, "?format=json&includeUnpublished=true&tags=blue&currency=EUR")
+		var items []appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&items); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if len(items) != 2 || items[0].Id != 1 || items[1].Name != "Draft Widget" || items[1].Status != appshttp.ProductStatusDraft {
+			t.Fatalf("unexpected export: %+v", items)
+		}
+		if items[0].Currency != "EUR" || items[0].PriceCents != 1799 {
+			t.Fatalf("expected prices converted to EUR, got %+v", items[0])
+		}
+
+		resp = export(t, "?format=json&q=nothing-matches")
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK || strings.TrimSpace(string(body)) != "[]" {
+			t.Fatalf("expected an empty array, got %d %q", resp.StatusCode, body)
+		}
+	})
+
+	t.Run("csv has a header row", func(t *testing.T) {
+		resp := export(t, "?format=csv")
+		if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/csv") {
+			t.Fatalf("expected csv, got %q", resp.Header.Get("Content-Type"))
+		}
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		if err != nil {
+			t.Fatalf("read csv: %v", err)
+		}
+		if len(records) != 3 || records[0][0] != "id" || records[1][1] != "Blue Widget" || records[1][4] != "gadget|blue" || records[2][3] != "USD" {
+			t.Fatalf("unexpected csv: %v", records)
+		}
+	})
+
+	t.Run("invalid filters fail before streaming", func(t *testing.T) {
+		for _, query := range []string{"?minPrice=10&maxPrice=1", "?currency=CHF"} {
+			resp := export(t, query)
+			var e struct{ Code string }
+			_ = json.NewDecoder(resp.Body).Decode(&e)
+			if resp.StatusCode != http.StatusBadRequest || e.Code != "VALIDATION" {
+				t.Fatalf("%s: expected a 400 error body, got %d %+v", query, resp.StatusCode, e)
+			}
+		}
+		if resp := export(t, "?format=xml"); resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400 for an unknown format, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package http_inmem_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestProductImages_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), []byte("0123456789abcdef")...)
+	gif := append([]byte("GIF89a"), []byte("frames")...)
+
+	upload := func(t *testing.T, path, contentType, altText string, data []byte) (*http.Response, []byte) {
+		t.Helper()
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="file"; filename="image"`)
+		header.Set("Content-Type", contentType)
+		part, _ := form.CreatePart(header)
+		_, _ = part.Write(data)
+		if altText != "" {
+			_ = form.WriteField("altText", altText)
+		}
+		_ = form.Close()
+		resp, err := http.Post(ts.URL+path, form.FormDataContentType(), &body)
+		if err != nil {
+			t.Fatalf("upload: %v", err)
+		}
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(resp.Body)
+		return resp, raw
+	}
+	do := func(t *testing.T, method, path, body string, headers map[string]string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(resp.Body)
+		return resp, raw
+	}
+	list := func(t *testing.T) []appshttp.ProductImage {
+		t.Helper()
+		resp, raw := do(t, http.MethodGet, "/products/1/images", "", nil)
+		var images appshttp.ProductImageList
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &images) != nil {
+			t.Fatalf("list: %d %s", resp.StatusCode, raw)
+		}
+		return images.Items
+	}
+
+	resp, raw := upload(t, "/products/1/images", "image/png", " Front view ", png)
+	var front appshttp.ProductImage
+	if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &front) != nil {
+		t.Fatalf("upload: %d %s", resp.StatusCode, raw)
+	}
+	if front.Position != 0 || front.AltText != "Front view" || front.ContentType != "image/png" || front.Size != int64(len(png)) || front.Url != "/products/1/images/1/content" {
+		t.Fatalf("unexpected image: %+v", front)
+	}
+
+	t.Run("uploads are validated", func(t *testing.T) {
+		for _, c := range []struct {
+			name, path, contentType string
+			data                    []byte
+			status                  int
+		}{
+			{"declared type must match", "/products/1/images", "image/jpeg", png, http.StatusBadRequest},
+			{"unsupported content", "/products/1/images", "image/png", []byte("not an image"), http.StatusBadRequest},
+			{"empty file", "/products/1/images", "image/png", nil, http.StatusBadRequest},
+			{"too large", "/products/1/images", "image/png", append(png, make([]byte, domain.MaxImageBytes)...), http.StatusBadRequest},
+			{"unknown product", "/products/99/images", "image/png", png, http.StatusNotFound},
+			{"octet-stream is sniffed", "/products/1/images", "application/octet-stream", gif, http.StatusCreated},
+		} {
+			if resp, raw := upload(t, c.path, c.contentType, "", c.data); resp.StatusCode != c.status {
+				t.Fatalf("%s: expected %d, got %d %s", c.name, c.status, resp.StatusCode, raw)
+			}
+		}
+		// bodies well past the limit are refused before they are buffered, with or without a Content-Length
+		huge := append(png, make([]byte, domain.MaxImageBytes+1<<20)...)
+		if resp, raw := upload(t, "/products/1/images", "image/png", "", huge); resp.StatusCode != http.StatusRequestEntityTooLarge {
+			t.Fatalf("expected 413, got %d %s", resp.StatusCode, raw)
+		}
+		chunked, err := http.Post(ts.URL+"/products/1/images", "multipart/form-data; boundary=x", io.MultiReader(bytes.NewReader(huge)))
+		if err != nil {
+			t.Fatalf("chunked upload: %v", err)
+		}
+		chunked.Body.Close()
+		if chunked.StatusCode != http.StatusRequestEntityTooLarge {
+			t.Fatalf("expected 413 for a chunked upload, got %d", chunked.StatusCode)
+		}
+		if resp, raw := do(t, http.MethodPost, "/products/1/images", `{"altText":"x"}`, nil); resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400 for a JSON upload, got %d %s", resp.StatusCode, raw)
+		}
+		if images := list(t); len(images) != 2 || images[1].ContentType != "image/gif" || images[1].Position != 1 {
+			t.Fatalf("expected the GIF after the PNG, got %+v", images)
+		}
+	})
+
+	t.Run("content is served with ranges and revalidation", func(t *testing.T) {
+		resp, raw := do(t, http.MethodGet, front.Url, "", nil)
+		etag := resp.Header.Get("ETag")
+		if resp.StatusCode != http.StatusOK || !bytes.Equal(raw, png) || resp.Header.Get("Content-Type") != "image/png" || etag != `"`+front.Checksum+`"` {
+			t.Fatalf("unexpected content: %d %q %v", resp.StatusCode, raw, resp.Header)
+		}
+		resp, raw = do(t, http.MethodGet, front.Url, "", map[string]string{"Range": "bytes=0-7"})
+		if resp.StatusCode != http.StatusPartialContent || !bytes.Equal(raw, png[:8]) || resp.Header.Get("Content-Range") != "bytes 0-7/24" {
+			t.Fatalf("unexpected range: %d %q %v", resp.StatusCode, raw, resp.Header)
+		}
+		if resp, _ := do(t, http.MethodGet, front.Url, "", map[string]string{"Range": "bytes=0-7", "If-Range": `"stale"`}); resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected the whole content for a stale If-Range, got %d", resp.StatusCode)
+		}
+		if resp, _ := do(t, http.MethodGet, front.Url, "", map[string]string{"Range": "bytes=100-"}); resp.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+			t.Fatalf("expected 416, got %d", resp.StatusCode)
+		}
+		if resp, _ := do(t, http.MethodGet, front.Url, "", map[string]string{"If-None-Match": etag}); resp.StatusCode != http.StatusNotModified {
+			t.Fatalf("expected 304, got %d", resp.StatusCode)
+		}
+		if resp, _ := do(t, http.MethodGet, "/products/2/images/1/content", "", nil); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404 for another product's image, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("images are reordered and deleted", func(t *testing.T) {
+		if resp, raw := upload(t, "/products/1/images", "image/png", "Back view", png); resp.StatusCode != http.StatusCreated {
+			t.Fatalf("upload: %d %s", resp.StatusCode, raw)
+		}
+		resp, raw := do(t, http.MethodPut, "/products/1/images/3", `{"altText":"Back","position":0}`, nil)
+		var moved appshttp.ProductImage
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &moved) != nil || moved.Position != 0 || moved.AltText != "Back" {
+			t.Fatalf("unexpected update: %d %s", resp.StatusCode, raw)
+		}
+		if images := list(t); len(images) != 3 || images[0].Id != 3 || images[1].Id != 1 || images[2].Id != 2 {
+			t.Fatalf("unexpected order: %+v", images)
+		}
+		if resp, _ := do(t, http.MethodPut, "/products/1/images/9", `{"position":0}`, nil); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404, got %d", resp.StatusCode)
+		}
+
+		if resp, _ := do(t, http.MethodDelete, "/products/1/images/3", "", nil); resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("expected 204, got %d", resp.StatusCode)
+		}
+		if images := list(t); len(images) != 2 || images[0].Id != 1 || images[0].Position != 0 || images[1].Position != 1 {
+			t.Fatalf("expected the remaining images to move up, got %+v", images)
+		}
+		if resp, _ := do(t, http.MethodGet, "/products/1/images/3/content", "", nil); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404 for deleted content, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestImportProducts_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	importFile := func(t *testing.T, query, contentType, body string) (*http.Response, appshttp.ImportSummary) {
+		t.Helper()
+		resp, err := http.Post(ts.URL+"/products:import"+query, contentType, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("import: %v", err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		var summary appshttp.ImportSummary
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return resp, summary
+	}
+	getProduct := func(t *testing.T, id int) appshttp.Product {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/products/" + strconv.Itoa(id))
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		defer resp.Body.Close()
+		var p appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return p
+	}
+
+	csvFile := strings.Join([]string{
+		"name,priceCents,currency,tags,id",
+		"Green Widget,1500,,garden|green,",
+		",100,,,",                        // name required
+		"Yen Widget,300,jpy,,",           // created in JPY
+		"Blue Widget Pro,2199,,gadget,1", // update
+		"Ghost,100,,,99",                 // unknown product
+		"Too,many,columns,here,x,y",      // wrong field count
+		"Cheap,-5,,,",                    // negative price
+	}, "\n")
+
+	t.Run("dry run validates without writing", func(t *testing.T) {
+		resp, summary := importFile(t, "?dryRun=true", "text/csv", csvFile)
+		if resp.StatusCode != http.StatusOK || !summary.DryRun {
+			t.Fatalf("expected dry-run 200, got %d %+v", resp.StatusCode, summary)
+		}
+		if summary.Created != 2 || summary.Updated != 1 || summary.Failed != 4 || len(summary.Errors) != 4 {
+			t.Fatalf("unexpected summary: %+v", summary)
+		}
+		wantLines := []int{3, 6, 7, 8}
+		for i, e := range summary.Errors {
+			if e.Line != wantLines[i] || e.Message == "" {
+				t.Fatalf("error %d: expected line %d, got %+v", i, wantLines[i], e)
+			}
+		}
+		if e := summary.Errors[1]; e.Id == nil || *e.Id != 99 {
+			t.Fatalf("expected the unknown product's id in its error, got %+v", e)
+		}
+		if p := getProduct(t, 1); p.Name != "Blue Widget" || p.Version != 1 {
+			t.Fatalf("dry run must not write, got %+v", p)
+		}
+	})
+
+	t.Run("csv import creates drafts and updates products", func(t *testing.T) {
+		resp, summary := importFile(t, "", "text/csv; charset=utf-8", csvFile)
+		if resp.StatusCode != http.StatusOK || summary.DryRun || summary.Created != 2 || summary.Updated != 1 || summary.Failed != 4 {
+			t.Fatalf("unexpected import: %d %+v", resp.StatusCode, summary)
+		}
+		if p := getProduct(t, 1); p.Name != "Blue Widget Pro" || p.PriceCents != 2199 || p.Version != 2 || len(p.Tags) != 1 || p.Status != appshttp.ProductStatusPublished {
+			t.Fatalf("expected product 1 updated and still published, got %+v", p)
+		}
+		green := getProduct(t, 3)
+		if green.Name != "Green Widget" || green.Status != appshttp.ProductStatusDraft || len(green.Tags) != 2 {
+			t.Fatalf("expected a draft Green Widget, got %+v", green)
+		}
+		if yen := getProduct(t, 4); yen.Name != "Yen Widget" || yen.Currency != "JPY" {
+			t.Fatalf("expected Yen Widget priced in JPY, got %+v", yen)
+		}
+	})
+
+	t.Run("ndjson import honours versions", func(t *testing.T) {
+		body := strings.Join([]string{
+			`{"id":2,"version":1,"name":"Red Gizmo 2","priceCents":3099}`,
+			``,
+			`{"id":1,"version":1,"name":"Stale","priceCents":1}`,
+			`{"name":"Purple Gadget","priceCents":999,"tags":["purple"]}`,
+			`{"name":"Unknown field","priceCents":1,"colour":"red"}`,
+			`{"name":"No price"}`,
+			`not json`,
+		}, "\n")
+		resp, summary := importFile(t, "", "application/x-ndjson", body)
+		if resp.StatusCode != http.StatusOK || summary.Created != 1 || summary.Updated != 1 || summary.Failed != 4 {
+			t.Fatalf("unexpected import: %d %+v", resp.StatusCode, summary)
+		}
+		if e := summary.Errors[0]; e.Line != 3 || e.Id == nil || *e.Id != 1 || !strings.Contains(e.Message, "modified") {
+			t.Fatalf("expected a stale version on line 3, got %+v", e)
+		}
+		if p := getProduct(t, 2); p.Name != "Red Gizmo 2" || p.PriceCents != 3099 || p.PriceOverrides["EUR"] != 2499 {
+			t.Fatalf("expected product 2 updated with overrides kept, got %+v", p)
+		}
+	})
+
+	t.Run("unusable files are rejected", func(t *testing.T) {
+		for _, tc := range []struct{ contentType, body string }{
+			{"text/csv", "name,price\nWidget,100"},
+			{"text/csv", "priceCents\n100"},
+			{"application/json", `{"name":"Widget","priceCents":100}`},
+		} {
+			if resp, _ := importFile(t, "", tc.contentType, tc.body); resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("%s %q: expected 400, got %d", tc.contentType, tc.body, resp.StatusCode)
+			}
+		}
+		// 32 MiB is the most an import may send
+		huge := "name,priceCents\n" + strings.Repeat("Bulk Widget,100\n", 33<<20/16)
+		if resp, _ := importFile(t, "?dryRun=true", "text/csv", huge); resp.StatusCode != http.StatusRequestEntityTooLarge {
+			t.Fatalf("expected 413 for an oversized file, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestInventory_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	do := func(t *testing.T, method, path, body string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(resp.Body)
+		return resp, raw
+	}
+	reserve := func(t *testing.T, body string) (int, appshttp.Reservation) {
+		t.Helper()
+		resp, raw := do(t, http.MethodPost, "/reservations", body)
+		var r appshttp.Reservation
+		if resp.StatusCode == http.StatusCreated {
+			_ = json.Unmarshal(raw, &r)
+		}
+		return resp.StatusCode, r
+	}
+	stock := func(t *testing.T, productID int64) appshttp.StockList {
+		t.Helper()
+		resp, raw := do(t, http.MethodGet, fmt.Sprintf("/products/%d/stock", productID), "")
+		var list appshttp.StockList
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &list) != nil {
+			t.Fatalf("stock: %d %s", resp.StatusCode, raw)
+		}
+		return list
+	}
+	inStock := func(t *testing.T) map[int64]bool {
+		t.Helper()
+		resp, raw := do(t, http.MethodGet, "/products/search", "")
+		var list appshttp.ProductList
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &list) != nil {
+			t.Fatalf("search: %d %s", resp.StatusCode, raw)
+		}
+		out := map[int64]bool{}
+		for _, p := range list.Items {
+			if p.InStock == nil {
+				t.Fatalf("expected inStock on every search result, got %+v", p)
+			}
+			out[p.Id] = *p.InStock
+		}
+		return out
+	}
+
+	if flags := inStock(t); flags[1] || flags[2] {
+		t.Fatalf("expected nothing in stock before any adjustment, got %v", flags)
+	}
+	if list := stock(t, 1); len(list.Items) != 0 || list.Available != 0 {
+		t.Fatalf("expected no stock levels, got %+v", list)
+	}
+
+	resp, raw := do(t, http.MethodPost, "/products/1/stock/adjustments", `{"delta":5}`)
+	var level appshttp.StockLevel
+	if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &level) != nil || level.OnHand != 5 || level.Available != 5 || level.VariantId != nil {
+		t.Fatalf("unexpected adjustment: %d %s", resp.StatusCode, raw)
+	}
+	if flags := inStock(t); !flags[1] || flags[2] {
+		t.Fatalf("expected only product 1 in stock, got %v", flags)
+	}
+
+	t.Run("reservations hold stock until committed or released", func(t *testing.T) {
+		status, held := reserve(t, `{"productId":1,"quantity":3}`)
+		if status != http.StatusCreated || held.Status != appshttp.ReservationStatusPending || held.Quantity != 3 {
+			t.Fatalf("unexpected reservation: %d %+v", status, held)
+		}
+		if list := stock(t, 1); list.Available != 2 || list.Items[0].Reserved != 3 || list.Items[0].OnHand != 5 {
+			t.Fatalf("expected 3 of 5 reserved, got %+v", list)
+		}
+		if status, _ := reserve(t, `{"productId":1,"quantity":3}`); status != http.StatusConflict {
+			t.Fatalf("expected 409 when reserving more than is available, got %d", status)
+		}
+		if resp, raw := do(t, http.MethodPost, "/products/1/stock/adjustments", `{"delta":-3}`); resp.StatusCode != http.StatusConflict {
+			t.Fatalf("expected 409 when booking out reserved units, got %d %s", resp.StatusCode, raw)
+		}
+
+		path := fmt.Sprintf("/reservations/%d", held.Id)
+		resp, raw := do(t, http.MethodPost, path+"/commit", "")
+		var committed appshttp.Reservation
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &committed) != nil || committed.Status != appshttp.ReservationStatusCommitted {
+			t.Fatalf("unexpected commit: %d %s", resp.StatusCode, raw)
+		}
+		if list := stock(t, 1); list.Items[0].OnHand != 2 || list.Items[0].Reserved != 0 || list.Available != 2 {
+			t.Fatalf("expected the committed units to leave stock, got %+v", list)
+		}
+		for _, action := range []string{"/commit", "/release"} {
+			if resp, _ := do(t, http.MethodPost, path+action, ""); resp.StatusCode != http.StatusConflict {
+				t.Fatalf("expected 409 for %s on a committed reservation, got %d", action, resp.StatusCode)
+			}
+		}
+
+		status, held = reserve(t, `{"productId":1,"quantity":2}`)
+		if status != http.StatusCreated {
+			t.Fatalf("expected the remaining units to be reservable, got %d", status)
+		}
+		if flags := inStock(t); flags[1] {
+			t.Fatalf("expected product 1 to be out of stock while fully reserved, got %v", flags)
+		}
+		resp, raw = do(t, http.MethodPost, fmt.Sprintf("/reservations/%d/release", held.Id), "")
+		if resp.StatusCode != http.StatusOK || stock(t, 1).Available != 2 {
+			t.Fatalf("expected release to return the units: %d %s", resp.StatusCode, raw)
+		}
+		resp, raw = do(t, http.MethodGet, fmt.Sprintf("/reservations/%d", held.Id), "")
+		var released appshttp.Reservation
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &released) != nil || released.Status != appshttp.ReservationStatusReleased {
+			t.Fatalf("unexpected reservation: %d %s", resp.StatusCode, raw)
+		}
+	})
+
+	t.Run("variants have their own stock", func(t *testing.T) {
+		resp, raw := do(t, http.MethodPost, "/products/2/variants", `{"sku":"RG-S","attributes":{"size":"S"}}`)
+		var variant appshttp.Variant
+		if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &variant) != nil {
+			t.Fatalf("create variant: %d %s", resp.StatusCode, raw)
+		}
+		body := fmt.Sprintf(`{"variantId":%d,"delta":4}`, variant.Id)
+		if resp, raw := do(t, http.MethodPost, "/products/2/stock/adjustments", body); resp.StatusCode != http.StatusOK {
+			t.Fatalf("adjust variant: %d %s", resp.StatusCode, raw)
+		}
+		list := stock(t, 2)
+		if len(list.Items) != 1 || list.Items[0].VariantId == nil || *list.Items[0].VariantId != variant.Id || list.Available != 4 {
+			t.Fatalf("unexpected stock: %+v", list)
+		}
+		if flags := inStock(t); !flags[2] {
+			t.Fatalf("expected a stocked variant to put product 2 in stock, got %v", flags)
+		}
+		if status, _ := reserve(t, `{"productId":2,"quantity":1}`); status != http.StatusConflict {
+			t.Fatalf("expected 409 for the product's own (empty) stock, got %d", status)
+		}
+		if status, _ := reserve(t, fmt.Sprintf(`{"productId":1,"variantId":%d,"quantity":1}`, variant.Id)); status != http.StatusNotFound {
+			t.Fatalf("expected 404 for another product's variant, got %d", status)
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		cases := []struct {
+			method, path, body string
+			status             int
+		}{
+			{http.MethodPost, "/products/1/stock/adjustments", `{"delta":0}`, http.StatusBadRequest},
+			{http.MethodPost, "/products/99/stock/adjustments", `{"delta":1}`, http.StatusNotFound},
+			{http.MethodGet, "/products/99/stock", "", http.StatusNotFound},
+			{http.MethodPost, "/reservations", `{"productId":1,"quantity":0}`, http.StatusBadRequest},
+			{http.MethodPost, "/reservations", `{"productId":99,"quantity":1}`, http.StatusNotFound},
+			{http.MethodGet, "/reservations/99", "", http.StatusNotFound},
+			{http.MethodPost, "/reservations/99/commit", "", http.StatusNotFound},
+		}
+		for _, c := range cases {
+			if resp, raw := do(t, c.method, c.path, c.body); resp.StatusCode != c.status {
+				t.Fatalf("%s %s %s: expected %d, got %d %s", c.method, c.path, c.body, c.status, resp.StatusCode, raw)
+			}
+		}
+	})
+
+	t.Run("concurrent reservations never oversell", func(t *testing.T) {
+		if resp, raw := do(t, http.MethodPost, "/products/1/stock/adjustments", `{"delta":8}`); resp.StatusCode != http.StatusOK {
+			t.Fatalf("adjust: %d %s", resp.StatusCode, raw)
+		}
+		available := stock(t, 1).Available
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			created int64
+		)
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if status, _ := reserve(t, `{"productId":1,"quantity":1}`); status == http.StatusCreated {
+					mu.Lock()
+					created++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		if created != available {
+			t.Fatalf("expected exactly %d reservations to succeed, got %d", available, created)
+		}
+		if list := stock(t, 1); list.Available != 0 || list.Items[0].Reserved != available {
+			t.Fatalf("expected everything reserved, got %+v", list)
+		}
+	})
+}
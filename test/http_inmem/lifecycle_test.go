@@ -0,0 +1,136 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestProductLifecycle_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	transition := func(t *testing.T, id int64, action string) (appshttp.Product, int) {
+		t.Helper()
+		resp, err := http.Post(fmt.Sprintf("%s/products/%d/%s", ts.URL, id, action), "application/json", nil)
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		defer resp.Body.Close()
+		var p appshttp.Product
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return p, resp.StatusCode
+	}
+	searchNames := func(t *testing.T, query string) []string {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/products/search?" + query)
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		var list appshttp.ProductList
+		if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		names := make([]string, 0, len(list.Items))
+		for _, p := range list.Items {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+
+	resp, err := http.Post(ts.URL+"/products", "application/json", strings.NewReader(`{"name":"Green Gadget","priceCents":500}`))
+	if err != nil {
+		t.Fatalf("http post: %v", err)
+	}
+	var created appshttp.Product
+	_ = json.NewDecoder(resp.Body).Decode(&created)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || created.Status != appshttp.ProductStatusDraft || created.PublishedAt != nil {
+		t.Fatalf("expected a new draft, got %d %+v", resp.StatusCode, created)
+	}
+	id := created.Id
+
+	t.Run("drafts are hidden unless includeUnpublished", func(t *testing.T) {
+		if names := searchNames(t, "q=gadget"); len(names) != 0 {
+			t.Fatalf("expected no published match, got %v", names)
+		}
+		if names := searchNames(t, "q=gadget&includeUnpublished=true"); len(names) != 1 || names[0] != "Green Gadget" {
+			t.Fatalf("expected the draft with includeUnpublished, got %v", names)
+		}
+	})
+
+	t.Run("publish makes the product visible", func(t *testing.T) {
+		p, status := transition(t, id, "publish")
+		if status != http.StatusOK || p.Status != appshttp.ProductStatusPublished || p.PublishedAt == nil {
+			t.Fatalf("unexpected publish result: %d %+v", status, p)
+		}
+		if _, status := transition(t, id, "publish"); status != http.StatusConflict {
+			t.Fatalf("expected 409 on republish, got %d", status)
+		}
+		if names := searchNames(t, "q=gadget"); len(names) != 1 {
+			t.Fatalf("expected the published product, got %v", names)
+		}
+	})
+
+	t.Run("full update keeps the status", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/products/%d", ts.URL, id), strings.NewReader(`{"name":"Green Gadget v2","priceCents":600}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http put: %v", err)
+		}
+		defer resp.Body.Close()
+		var p appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK || p.Status != appshttp.ProductStatusPublished || p.PublishedAt == nil {
+			t.Fatalf("expected status to survive the update, got %d %+v", resp.StatusCode, p)
+		}
+	})
+
+	t.Run("archive and unarchive", func(t *testing.T) {
+		p, status := transition(t, id, "archive")
+		if status != http.StatusOK || p.Status != appshttp.ProductStatusArchived {
+			t.Fatalf("unexpected archive result: %d %+v", status, p)
+		}
+		if names := searchNames(t, "q=gadget"); len(names) != 0 {
+			t.Fatalf("expected archived product to be hidden, got %v", names)
+		}
+		for _, action := range []string{"archive", "publish"} {
+			if _, status := transition(t, id, action); status != http.StatusConflict {
+				t.Fatalf("%s archived: expected 409, got %d", action, status)
+			}
+		}
+		p, status = transition(t, id, "unarchive")
+		if status != http.StatusOK || p.Status != appshttp.ProductStatusDraft {
+			t.Fatalf("unexpected unarchive result: %d %+v", status, p)
+		}
+		if _, status := transition(t, id, "unarchive"); status != http.StatusConflict {
+			t.Fatalf("expected 409 when unarchiving a draft, got %d", status)
+		}
+	})
+
+	t.Run("unknown product returns 404", func(t *testing.T) {
+		for _, action := range []string{"publish", "archive", "unarchive"} {
+			if _, status := transition(t, 999, action); status != http.StatusNotFound {
+				t.Fatalf("%s: expected 404, got %d", action, status)
+			}
+		}
+	})
+}
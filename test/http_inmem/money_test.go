@@ -0,0 +1,117 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestProductMoney_RoundTrip_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	create := func(t *testing.T, body string) (appshttp.Product, int) {
+		t.Helper()
+		resp, err := http.Post(ts.URL+"/products", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		defer resp.Body.Close()
+		var p appshttp.Product
+		if resp.StatusCode == http.StatusCreated {
+			if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return p, resp.StatusCode
+	}
+	get := func(t *testing.T, id int64) appshttp.Product {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/products/" + strconv.FormatInt(id, 10))
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		var p appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return p
+	}
+
+	// 16777217 and above are not representable as float32; 2^53+1 is not representable as float64.
+	for _, cents := range []int64{0, 1, 29, 1999, 16777217, 100000000001, 9007199254740993} {
+		t.Run(fmt.Sprintf("priceCents %d", cents), func(t *testing.T) {
+			created, status := create(t, fmt.Sprintf(`{"name":"Money %d","priceCents":%d}`, cents, cents))
+			if status != http.StatusCreated {
+				t.Fatalf("expected 201, got %d", status)
+			}
+			got := get(t, created.Id)
+			if got.PriceCents != cents || got.Currency != "USD" {
+				t.Fatalf("round trip mismatch: sent %d, got %d %s", cents, got.PriceCents, got.Currency)
+			}
+		})
+	}
+
+	// The deprecated decimal field still works and no longer loses cents.
+	for _, tc := range []struct {
+		price string
+		cents int64
+	}{
+		{"0.29", 29},
+		{"19.99", 1999},
+		{"167772.17", 16777217},
+		{"1000000000.01", 100000000001},
+	} {
+		t.Run("deprecated price "+tc.price, func(t *testing.T) {
+			created, status := create(t, `{"name":"Legacy","price":`+tc.price+`}`)
+			if status != http.StatusCreated {
+				t.Fatalf("expected 201, got %d", status)
+			}
+			got := get(t, created.Id)
+			if got.PriceCents != tc.cents {
+				t.Fatalf("price %s: expected %d cents, got %d", tc.price, tc.cents, got.PriceCents)
+			}
+			if strconv.FormatFloat(got.Price, 'f', 2, 64) != tc.price {
+				t.Fatalf("deprecated price echo mismatch: %v", got.Price)
+			}
+		})
+	}
+
+	t.Run("priceCents takes precedence over price", func(t *testing.T) {
+		created, status := create(t, `{"name":"Both","price":1.00,"priceCents":250}`)
+		if status != http.StatusCreated || created.PriceCents != 250 {
+			t.Fatalf("expected 201 with 250 cents, got %d %+v", status, created)
+		}
+	})
+
+	t.Run("missing price returns 400", func(t *testing.T) {
+		if _, status := create(t, `{"name":"No Price"}`); status != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", status)
+		}
+	})
+
+	t.Run("update with priceCents", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, ts.URL+"/products/1", strings.NewReader(`{"name":"Blue Widget","priceCents":16777217}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http put: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		if got := get(t, 1); got.PriceCents != 16777217 {
+			t.Fatalf("expected 16777217 cents after update, got %d", got.PriceCents)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestPatchProduct_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	patch := func(t *testing.T, path, contentType, ifMatch, body string) (appshttp.Product, *http.Response) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodPatch, ts.URL+path, strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http patch: %v", err)
+		}
+		defer resp.Body.Close()
+		var p appshttp.Product
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return p, resp
+	}
+	const mergePatch = "application/merge-patch+json"
+
+	t.Run("price only keeps name and tags", func(t *testing.T) {
+		p, resp := patch(t, "/products/1", mergePatch, `"1"`, `{"priceCents":2499}`)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		if p.PriceCents != 2499 || p.Name != "Blue Widget" || strings.Join(p.Tags, ",") != "gadget,blue" || p.Status != appshttp.ProductStatusPublished {
+			t.Fatalf("unexpected patched product: %+v", p)
+		}
+		if p.Version != 2 || resp.Header.Get("ETag") != `"2"` {
+			t.Fatalf("expected version 2, got %d etag=%q", p.Version, resp.Header.Get("ETag"))
+		}
+	})
+
+	t.Run("rename and replace tags", func(t *testing.T) {
+		p, resp := patch(t, "/products/1", mergePatch, "", `{"name":"  Blue Widget Pro ","tags":["Pro","pro","sale"]}`)
+		if resp.StatusCode != http.StatusOK || p.Name != "Blue Widget Pro" || strings.Join(p.Tags, ",") != "Pro,sale" || p.PriceCents != 2499 {
+			t.Fatalf("unexpected patched product: %d %+v", resp.StatusCode, p)
+		}
+		p, resp = patch(t, "/products/1", mergePatch, "", `{"tags":[]}`)
+		if resp.StatusCode != http.StatusOK || len(p.Tags) != 0 {
+			t.Fatalf("expected tags to be cleared, got %d %+v", resp.StatusCode, p)
+		}
+	})
+
+	t.Run("price overrides merge by currency", func(t *testing.T) {
+		p, resp := patch(t, "/products/2", mergePatch, "", `{"priceOverrides":{"EUR":null,"gbp":1999}}`)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		if _, ok := p.PriceOverrides["EUR"]; ok || p.PriceOverrides["GBP"] != 1999 || len(p.PriceOverrides) != 1 {
+			t.Fatalf("unexpected overrides: %+v", p.PriceOverrides)
+		}
+		if _, resp = patch(t, "/products/2", mergePatch, "", `{"currency":"GBP"}`); resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400 when the base currency clashes with an override, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("rejects invalid patches", func(t *testing.T) {
+		cases := map[string]struct {
+			contentType, ifMatch, body string
+			status                     int
+		}{
+			"empty":          {mergePatch, "", `{}`, http.StatusBadRequest},
+			"unknown field":  {mergePatch, "", `{"status":"draft"}`, http.StatusBadRequest},
+			"null name":      {mergePatch, "", `{"name":null}`, http.StatusBadRequest},
+			"negative price": {mergePatch, "", `{"priceCents":-1}`, http.StatusBadRequest},
+			"plain json":     {"application/json", "", `{"priceCents":1}`, http.StatusBadRequest},
+			"stale version":  {mergePatch, `"1"`, `{"priceCents":1}`, http.StatusPreconditionFailed},
+		}
+		for name, tc := range cases {
+			if _, resp := patch(t, "/products/1", tc.contentType, tc.ifMatch, tc.body); resp.StatusCode != tc.status {
+				t.Fatalf("%s: expected %d, got %d", name, tc.status, resp.StatusCode)
+			}
+		}
+		if _, resp := patch(t, "/products/999", mergePatch, "", `{"priceCents":1}`); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404, got %d", resp.StatusCode)
+		}
+	})
+}
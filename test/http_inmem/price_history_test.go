@@ -0,0 +1,165 @@
+package http_inmem_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	productapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/product"
+	"github.com/fightingBald/GoTuto/apps/product-query-svc/domain"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestProductPriceHistory_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+	// a second service over the same store stands in for the scheduler goroutine
+	scheduler := productapp.NewService(store, store, store, nil, nil)
+
+	history := func(t *testing.T, id int64) []appshttp.PriceChange {
+		t.Helper()
+		resp, err := http.Get(fmt.Sprintf("%s/products/%d/price-history", ts.URL, id))
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		var h appshttp.PriceHistory
+		if err := json.NewDecoder(resp.Body).Decode(&h); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return h.Items
+	}
+	schedule := func(t *testing.T, id int64, body string) (appshttp.PriceChange, int) {
+		t.Helper()
+		resp, err := http.Post(fmt.Sprintf("%s/products/%d/price-history", ts.URL, id), "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		defer resp.Body.Close()
+		var c appshttp.PriceChange
+		if resp.StatusCode == http.StatusCreated {
+			if err := json.NewDecoder(resp.Body).Decode(&c); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+		}
+		return c, resp.StatusCode
+	}
+	put := func(t *testing.T, id int64, body string) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/products/%d", ts.URL, id), strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http put: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+	}
+	at := func(d time.Duration) string { return time.Now().Add(d).UTC().Format(time.RFC3339Nano) }
+
+	t.Run("direct edits are recorded once per price change", func(t *testing.T) {
+		if h := history(t, 1); len(h) != 1 || h[0].PriceCents != 1999 || h[0].Status != appshttp.PriceChangeStatusApplied {
+			t.Fatalf("unexpected seeded history: %+v", h)
+		}
+		put(t, 1, `{"name":"Blue Widget","priceCents":2199,"tags":["gadget","blue"]}`)
+		put(t, 1, `{"name":"Blue Widget v2","priceCents":2199,"tags":["gadget","blue"]}`)
+		h := history(t, 1)
+		if len(h) != 2 || h[1].PriceCents != 2199 || h[1].AppliedAt == nil {
+			t.Fatalf("expected one new applied entry, got %+v", h)
+		}
+	})
+
+	t.Run("invalid schedules", func(t *testing.T) {
+		for _, tc := range []struct {
+			id     int64
+			body   string
+			status int
+		}{
+			{1, `{"priceCents":100,"effectiveFrom":"` + at(-time.Minute) + `"}`, http.StatusBadRequest},
+			{1, `{"priceCents":-1,"effectiveFrom":"` + at(time.Hour) + `"}`, http.StatusBadRequest},
+			{1, `{"priceCents":100}`, http.StatusBadRequest},
+			{999, `{"priceCents":100,"effectiveFrom":"` + at(time.Hour) + `"}`, http.StatusNotFound},
+		} {
+			if _, status := schedule(t, tc.id, tc.body); status != tc.status {
+				t.Fatalf("%d %s: expected %d, got %d", tc.id, tc.body, tc.status, status)
+			}
+		}
+	})
+
+	t.Run("scheduler applies due prices and keeps future ones pending", func(t *testing.T) {
+		later, status := schedule(t, 1, `{"priceCents":2999,"effectiveFrom":"`+at(time.Hour)+`"}`)
+		if status != http.StatusCreated || later.Status != appshttp.PriceChangeStatusPending || later.Currency != "USD" || later.AppliedAt != nil {
+			t.Fatalf("unexpected scheduled change: %d %+v", status, later)
+		}
+		soon, status := schedule(t, 1, `{"priceCents":2499,"effectiveFrom":"`+at(50*time.Millisecond)+`"}`)
+		if status != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", status)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		applied, err := scheduler.ApplyDuePriceChanges(context.Background())
+		if err != nil || len(applied) != 1 || applied[0].ID != soon.Id {
+			t.Fatalf("expected only the due change to apply, got %+v (err=%v)", applied, err)
+		}
+
+		resp, err := http.Get(ts.URL + "/products/1")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		var p appshttp.Product
+		_ = json.NewDecoder(resp.Body).Decode(&p)
+		resp.Body.Close()
+		if p.PriceCents != 2499 {
+			t.Fatalf("expected scheduled price to apply, got %d", p.PriceCents)
+		}
+
+		h := history(t, 1)
+		if len(h) != 4 || h[2].Id != soon.Id || h[2].Status != appshttp.PriceChangeStatusApplied || h[3].Id != later.Id || h[3].Status != appshttp.PriceChangeStatusPending {
+			t.Fatalf("unexpected history order/status: %+v", h)
+		}
+		if again, _ := scheduler.ApplyDuePriceChanges(context.Background()); len(again) != 0 {
+			t.Fatalf("expected nothing left to apply, got %+v", again)
+		}
+	})
+
+	t.Run("currency switch skips the scheduled price", func(t *testing.T) {
+		c, status := schedule(t, 2, `{"priceCents":1000,"effectiveFrom":"`+at(50*time.Millisecond)+`"}`)
+		if status != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", status)
+		}
+		put(t, 2, `{"name":"Red Gizmo","currency":"EUR","priceCents":2700}`)
+
+		time.Sleep(100 * time.Millisecond)
+		applied, err := scheduler.ApplyDuePriceChanges(context.Background())
+		if err != nil || len(applied) != 1 || applied[0].ID != c.Id || applied[0].Status != domain.PriceChangeSkipped {
+			t.Fatalf("expected the change to be skipped, got %+v (err=%v)", applied, err)
+		}
+		h := history(t, 2)
+		if len(h) != 3 || h[1].Currency != "EUR" || h[1].PriceCents != 2700 || h[2].Id != c.Id || h[2].Status != appshttp.PriceChangeStatusSkipped {
+			t.Fatalf("expected the EUR edit followed by the skipped change, got %+v", h)
+		}
+	})
+
+	t.Run("unknown product returns 404", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "/products/999/price-history")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404, got %d", resp.StatusCode)
+		}
+	})
+}
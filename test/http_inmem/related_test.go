@@ -0,0 +1,130 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestRelatedProducts_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	do := func(t *testing.T, method, path, contentType, body string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(resp.Body)
+		return resp, raw
+	}
+	create := func(t *testing.T, body string, publish bool) int64 {
+		t.Helper()
+		resp, raw := do(t, http.MethodPost, "/products", "application/json", body)
+		var p appshttp.Product
+		if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &p) != nil {
+			t.Fatalf("create %s: %d %s", body, resp.StatusCode, raw)
+		}
+		if publish {
+			if resp, raw := do(t, http.MethodPost, fmt.Sprintf("/products/%d/publish", p.Id), "", ""); resp.StatusCode != http.StatusOK {
+				t.Fatalf("publish: %d %s", resp.StatusCode, raw)
+			}
+		}
+		return p.Id
+	}
+	related := func(t *testing.T, query string) []appshttp.RelatedProduct {
+		t.Helper()
+		resp, raw := do(t, http.MethodGet, "/products/1/related"+query, "", "")
+		var list appshttp.RelatedProductList
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &list) != nil {
+			t.Fatalf("related%s: %d %s", query, resp.StatusCode, raw)
+		}
+		return list.Items
+	}
+	ids := func(items []appshttp.RelatedProduct) []int64 {
+		out := make([]int64, 0, len(items))
+		for _, item := range items {
+			out = append(out, item.Product.Id)
+		}
+		return out
+	}
+
+	// product 1 is the seeded Blue Widget tagged gadget and blue; product 2 shares gadget
+	lamp := create(t, `{"name":"Blue Lamp","priceCents":500,"tags":["Blue","gadget"]}`, true)
+	gadget := create(t, `{"name":"Gadget Case","priceCents":300,"tags":["gadget"]}`, true)
+	gizmo := create(t, `{"name":"Green Gizmo","priceCents":700,"tags":["garden"]}`, true)
+	create(t, `{"name":"Draft Gadget","priceCents":100,"tags":["gadget","blue"]}`, false)
+
+	t.Run("shared tags rank products with ties broken by id", func(t *testing.T) {
+		items := related(t, "")
+		if fmt.Sprint(ids(items)) != fmt.Sprint([]int64{lamp, 2, gadget}) {
+			t.Fatalf("unexpected ranking: %v", ids(items))
+		}
+		if items[0].Score != 4 || strings.Join(items[0].SharedTags, ",") != "blue,gadget" || items[1].Score != 2 || items[1].CoPurchases != 0 {
+			t.Fatalf("unexpected scores: %+v", items)
+		}
+		if got := related(t, "?limit=1"); len(got) != 1 || got[0].Product.Id != lamp {
+			t.Fatalf("expected the limit to keep the best match, got %v", ids(got))
+		}
+	})
+
+	t.Run("a shared category outweighs a shared tag", func(t *testing.T) {
+		resp, raw := do(t, http.MethodPost, "/categories", "application/json", `{"name":"Widgets"}`)
+		var category appshttp.Category
+		if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &category) != nil {
+			t.Fatalf("create category: %d %s", resp.StatusCode, raw)
+		}
+		for _, id := range []int64{1, gadget} {
+			if resp, raw := do(t, http.MethodPatch, fmt.Sprintf("/products/%d", id), "application/merge-patch+json", fmt.Sprintf(`{"categoryId":%d}`, category.Id)); resp.StatusCode != http.StatusOK {
+				t.Fatalf("patch: %d %s", resp.StatusCode, raw)
+			}
+		}
+		items := related(t, "")
+		if fmt.Sprint(ids(items)) != fmt.Sprint([]int64{gadget, lamp, 2}) || !items[0].SameCategory || items[0].Score != 5 {
+			t.Fatalf("unexpected ranking: %+v", items)
+		}
+	})
+
+	t.Run("co-purchases count only when asked for", func(t *testing.T) {
+		store.RecordOrder(1, "Blue Widget")
+		store.RecordOrder(1, "green gizmo")
+		store.RecordOrder(2, "BLUE WIDGET")
+		store.RecordOrder(2, "Green Gizmo")
+		store.RecordOrder(2, "Green Gizmo")
+		store.RecordOrder(3, "Green Gizmo")
+
+		if got := ids(related(t, "")); fmt.Sprint(got) != fmt.Sprint([]int64{gadget, lamp, 2}) {
+			t.Fatalf("expected orders to be ignored by default, got %v", got)
+		}
+		items := related(t, "?includeOrders=true")
+		if fmt.Sprint(ids(items)) != fmt.Sprint([]int64{gadget, lamp, 2, gizmo}) || items[3].CoPurchases != 2 || items[3].Score != 2 || len(items[3].SharedTags) != 0 {
+			t.Fatalf("unexpected ranking: %+v", items)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for path, status := range map[string]int{
+			"/products/99/related":         http.StatusNotFound,
+			"/products/1/related?limit=0":  http.StatusBadRequest,
+			"/products/1/related?limit=51": http.StatusBadRequest,
+		} {
+			if resp, raw := do(t, http.MethodGet, path, "", ""); resp.StatusCode != status {
+				t.Fatalf("%s: expected %d, got %d %s", path, status, resp.StatusCode, raw)
+			}
+		}
+	})
+}
@@ -14,7 +14,7 @@ import (
 func TestHTTP_InMem_Product(t *testing.T) {
 	t.Run("search returns items", func(t *testing.T) {
 		store := appsinmem.NewInMemRepo()
-		ts := testutil.NewHTTPServer(store, store, store)
+		ts := testutil.NewInMemHTTPServer(store)
 		defer ts.Close()
 
 		resp, err := http.Get(ts.URL + "/products/search?q=wid&page=1&pageSize=10")
@@ -29,14 +29,14 @@ func TestHTTP_InMem_Product(t *testing.T) {
 		if err := json.NewDecoder(resp.Body).Decode(&pl); err != nil {
 			t.Fatalf("decode: %v", err)
 		}
-		if len(pl.Items) == 0 || pl.Total == 0 {
-			t.Fatalf("expected seeded items in in-memory repo; got items=%d total=%d", len(pl.Items), pl.Total)
+		if len(pl.Items) == 0 || pl.Total == nil || *pl.Total == 0 {
+			t.Fatalf("expected seeded items in in-memory repo; got items=%d total=%v", len(pl.Items), pl.Total)
 		}
 	})
 
 	t.Run("get id=1 returns product", func(t *testing.T) {
 		store := appsinmem.NewInMemRepo()
-		ts := testutil.NewHTTPServer(store, store, store)
+		ts := testutil.NewInMemHTTPServer(store)
 		defer ts.Close()
 
 		resp, err := http.Get(ts.URL + "/products/1")
@@ -58,7 +58,7 @@ func TestHTTP_InMem_Product(t *testing.T) {
 
 	t.Run("update id=1 returns updated product", func(t *testing.T) {
 		store := appsinmem.NewInMemRepo()
-		ts := testutil.NewHTTPServer(store, store, store)
+		ts := testutil.NewInMemHTTPServer(store)
 		defer ts.Close()
 
 		body := `{"name":"Updated Widget","price":15.25}`
@@ -81,9 +81,149 @@ func TestHTTP_InMem_Product(t *testing.T) {
 		}
 	})
 
+	t.Run("search filters by tags and returns facets", func(t *testing.T) {
+		store := appsinmem.NewInMemRepo()
+		ts := testutil.NewInMemHTTPServer(store)
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL + "/products/search?tags=BLUE&tags=red")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status: %d", resp.StatusCode)
+		}
+		var pl appshttp.ProductList
+		if err := json.NewDecoder(resp.Body).Decode(&pl); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if pl.Total == nil || *pl.Total != 2 {
+			t.Fatalf("expected any-of filter to match 2 products, got %v", pl.Total)
+		}
+		if pl.Facets == nil || len(pl.Facets.Tags) != 3 || pl.Facets.Tags[0].Tag != "gadget" || pl.Facets.Tags[0].Count != 2 {
+			t.Fatalf("unexpected facets: %+v", pl.Facets.Tags)
+		}
+
+		resp2, err := http.Get(ts.URL + "/products/search?tags=gadget&tags=blue&tagMatch=all")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp2.Body.Close()
+		var all appshttp.ProductList
+		if err := json.NewDecoder(resp2.Body).Decode(&all); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if all.Total == nil || *all.Total != 1 || len(all.Items) != 1 || all.Items[0].Name != "Blue Widget" {
+			t.Fatalf("unexpected all-of result: %+v", all)
+		}
+	})
+
+	t.Run("search with unknown tagMatch returns 400", func(t *testing.T) {
+		store := appsinmem.NewInMemRepo()
+		ts := testutil.NewInMemHTTPServer(store)
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL + "/products/search?tags=blue&tagMatch=some")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400 for invalid tagMatch; got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("search filters by price range and sorts", func(t *testing.T) {
+		store := appsinmem.NewInMemRepo()
+		ts := testutil.NewInMemHTTPServer(store)
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL + "/products/search?minPrice=1000&maxPrice=2999&sort=price&order=desc")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status: %d", resp.StatusCode)
+		}
+		var pl appshttp.ProductList
+		if err := json.NewDecoder(resp.Body).Decode(&pl); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if pl.Total == nil || *pl.Total != 2 || len(pl.Items) != 2 || pl.Items[0].Name != "Red Gizmo" || pl.Items[1].Name != "Blue Widget" {
+			t.Fatalf("unexpected result: %+v", pl.Items)
+		}
+
+		resp2, err := http.Get(ts.URL + "/products/search?maxPrice=1999")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp2.Body.Close()
+		var capped appshttp.ProductList
+		if err := json.NewDecoder(resp2.Body).Decode(&capped); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if capped.Total == nil || *capped.Total != 1 || capped.Items[0].Name != "Blue Widget" {
+			t.Fatalf("expected inclusive maxPrice to keep only Blue Widget, got %+v", capped.Items)
+		}
+	})
+
+	t.Run("search with minPrice above maxPrice returns 400", func(t *testing.T) {
+		store := appsinmem.NewInMemRepo()
+		ts := testutil.NewInMemHTTPServer(store)
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL + "/products/search?minPrice=5000&maxPrice=100")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400 for inverted price range; got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("relevance search tolerates typos and returns scores", func(t *testing.T) {
+		store := appsinmem.NewInMemRepo()
+		ts := testutil.NewInMemHTTPServer(store)
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL + "/products/search?q=widgt&sort=relevance")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status: %d", resp.StatusCode)
+		}
+		var pl appshttp.ProductList
+		if err := json.NewDecoder(resp.Body).Decode(&pl); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if len(pl.Items) != 1 || pl.Items[0].Name != "Blue Widget" || pl.Items[0].Score == nil || *pl.Items[0].Score <= 0 {
+			t.Fatalf("unexpected relevance result: %+v", pl.Items)
+		}
+	})
+
+	t.Run("relevance sort without q returns 400", func(t *testing.T) {
+		store := appsinmem.NewInMemRepo()
+		ts := testutil.NewInMemHTTPServer(store)
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL + "/products/search?sort=relevance")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400; got %d", resp.StatusCode)
+		}
+	})
+
 	t.Run("search with short q returns 400", func(t *testing.T) {
 		store := appsinmem.NewInMemRepo()
-		ts := testutil.NewHTTPServer(store, store, store)
+		ts := testutil.NewInMemHTTPServer(store)
 		defer ts.Close()
 
 		resp, err := http.Get(ts.URL + "/products/search?q=ab")
@@ -0,0 +1,128 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestProductSlugs_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	// redirects are asserted, not followed
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	do := func(t *testing.T, method, path, contentType, body string, headers map[string]string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(resp.Body)
+		return resp, raw
+	}
+	create := func(t *testing.T, name string) appshttp.Product {
+		t.Helper()
+		resp, raw := do(t, http.MethodPost, "/products", "application/json", fmt.Sprintf(`{"name":%q,"priceCents":100}`, name), nil)
+		var p appshttp.Product
+		if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &p) != nil {
+			t.Fatalf("create %s: %d %s", name, resp.StatusCode, raw)
+		}
+		return p
+	}
+	rename := func(t *testing.T, id int64, name string) appshttp.Product {
+		t.Helper()
+		resp, raw := do(t, http.MethodPatch, fmt.Sprintf("/products/%d", id), "application/merge-patch+json", fmt.Sprintf(`{"name":%q}`, name), nil)
+		var p appshttp.Product
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &p) != nil {
+			t.Fatalf("rename to %s: %d %s", name, resp.StatusCode, raw)
+		}
+		return p
+	}
+
+	widget := create(t, "  Blue Widget! ")
+	if widget.Slug != "blue-widget-2" {
+		t.Fatalf("expected a suffix after the seeded blue-widget, got %q", widget.Slug)
+	}
+	if p := create(t, "blue widget"); p.Slug != "blue-widget-3" {
+		t.Fatalf("expected blue-widget-3, got %q", p.Slug)
+	}
+	if p := create(t, "蓝色小部件"); p.Slug != "product" {
+		t.Fatalf("expected the fallback slug, got %q", p.Slug)
+	}
+
+	t.Run("lookup by the current slug", func(t *testing.T) {
+		resp, raw := do(t, http.MethodGet, "/products/by-slug/blue-widget-2", "", "", nil)
+		var p appshttp.Product
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &p) != nil || p.Id != widget.Id || p.Slug != "blue-widget-2" {
+			t.Fatalf("unexpected lookup: %d %s", resp.StatusCode, raw)
+		}
+		if resp, _ := do(t, http.MethodGet, "/products/by-slug/blue-widget-2", "", "", map[string]string{"If-None-Match": resp.Header.Get("ETag")}); resp.StatusCode != http.StatusNotModified {
+			t.Fatalf("expected 304, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("renames keep the old slug as a redirect", func(t *testing.T) {
+		if p := rename(t, widget.Id, "Green Widget"); p.Slug != "green-widget" {
+			t.Fatalf("expected green-widget, got %q", p.Slug)
+		}
+		resp, raw := do(t, http.MethodGet, "/products/by-slug/blue-widget-2?currency=EUR", "", "", nil)
+		var moved appshttp.SlugRedirect
+		if resp.StatusCode != http.StatusMovedPermanently || json.Unmarshal(raw, &moved) != nil {
+			t.Fatalf("expected 301, got %d %s", resp.StatusCode, raw)
+		}
+		if want := "/products/by-slug/green-widget?currency=EUR"; resp.Header.Get("Location") != want || moved.Location != want || moved.Id != widget.Id || moved.Slug != "green-widget" {
+			t.Fatalf("unexpected redirect: %v %+v", resp.Header, moved)
+		}
+		if resp, raw := do(t, http.MethodGet, "/products/by-slug/Green-Widget", "", "", nil); resp.StatusCode != http.StatusMovedPermanently || resp.Header.Get("Location") != "/products/by-slug/green-widget" {
+			t.Fatalf("expected a redirect to the lower-case slug, got %d %s", resp.StatusCode, raw)
+		}
+
+		// the old slug stays reserved for the renamed product
+		if p := create(t, "Blue Widget"); p.Slug != "blue-widget-4" {
+			t.Fatalf("expected blue-widget-4, got %q", p.Slug)
+		}
+		if p := rename(t, widget.Id, "GREEN widget!!"); p.Slug != "green-widget" {
+			t.Fatalf("expected a cosmetic rename to keep the slug, got %q", p.Slug)
+		}
+		if p := rename(t, widget.Id, "Blue Widget"); p.Slug != "blue-widget-2" {
+			t.Fatalf("expected the product to take its old slug back, got %q", p.Slug)
+		}
+		if resp, _ := do(t, http.MethodGet, "/products/by-slug/green-widget", "", "", nil); resp.StatusCode != http.StatusMovedPermanently || resp.Header.Get("Location") != "/products/by-slug/blue-widget-2" {
+			t.Fatalf("expected green-widget to redirect back, got %d %v", resp.StatusCode, resp.Header)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if resp, raw := do(t, http.MethodDelete, fmt.Sprintf("/products/%d", widget.Id), "", "", nil); resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete: %d %s", resp.StatusCode, raw)
+		}
+		for path, status := range map[string]int{
+			"/products/by-slug/blue-widget-2":              http.StatusNotFound,
+			"/products/by-slug/green-widget":               http.StatusNotFound,
+			"/products/by-slug/no-such-thing":              http.StatusNotFound,
+			"/products/by-slug/bad--slug":                  http.StatusBadRequest,
+			"/products/by-slug/" + strings.Repeat("a", 81): http.StatusBadRequest,
+		} {
+			if resp, raw := do(t, http.MethodGet, path, "", "", nil); resp.StatusCode != status {
+				t.Fatalf("%s: expected %d, got %d %s", path, status, resp.StatusCode, raw)
+			}
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestSuggestProducts_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	suggest := func(t *testing.T, query string) appshttp.SuggestionList {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/products/suggest?" + query)
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status: %d", resp.StatusCode)
+		}
+		var sl appshttp.SuggestionList
+		if err := json.NewDecoder(resp.Body).Decode(&sl); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		return sl
+	}
+
+	t.Run("ranks popular tags before names", func(t *testing.T) {
+		sl := suggest(t, "prefix=G")
+		if len(sl.Items) != 1 || sl.Items[0].Text != "gadget" || sl.Items[0].Kind != appshttp.SuggestionKindTag || sl.Items[0].Weight != 2 {
+			t.Fatalf("unexpected suggestions: %+v", sl.Items)
+		}
+
+		sl = suggest(t, "prefix=bl")
+		if len(sl.Items) != 2 || sl.Items[0].Text != "blue" || sl.Items[1].Text != "Blue Widget" {
+			t.Fatalf("unexpected suggestions: %+v", sl.Items)
+		}
+		if sl.Items[1].ProductId == nil || *sl.Items[1].ProductId != 1 {
+			t.Fatalf("expected name suggestion to carry product id, got %+v", sl.Items[1])
+		}
+	})
+
+	t.Run("limit caps the list", func(t *testing.T) {
+		sl := suggest(t, "prefix=bl&limit=1")
+		if len(sl.Items) != 1 || sl.Items[0].Text != "blue" {
+			t.Fatalf("unexpected suggestions: %+v", sl.Items)
+		}
+	})
+
+	t.Run("writes invalidate cached prefixes", func(t *testing.T) {
+		if sl := suggest(t, "prefix=blu"); len(sl.Items) != 2 {
+			t.Fatalf("unexpected suggestions before create: %+v", sl.Items)
+		}
+		resp, err := http.Post(ts.URL+"/products", "application/json", strings.NewReader(`{"name":"Bluebird","price":3.00}`))
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		var created appshttp.Product
+		_ = json.NewDecoder(resp.Body).Decode(&created)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", resp.StatusCode)
+		}
+		// drafts are not suggested until published
+		if sl := suggest(t, "prefix=blu"); len(sl.Items) != 2 {
+			t.Fatalf("expected draft to stay hidden, got %+v", sl.Items)
+		}
+		resp, err = http.Post(fmt.Sprintf("%s/products/%d/publish", ts.URL, created.Id), "application/json", nil)
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		sl := suggest(t, "prefix=blu")
+		if len(sl.Items) != 3 || sl.Items[1].Text != "Bluebird" {
+			t.Fatalf("expected new product in suggestions, got %+v", sl.Items)
+		}
+	})
+
+	t.Run("missing prefix returns 400", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "/products/suggest")
+		if err != nil {
+			t.Fatalf("http get: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestProductTags_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	var created appshttp.Product
+	t.Run("create with tags returns sanitized tags", func(t *testing.T) {
+		body := `{"name":"Tagged Item","price":5.00,"tags":["sale"," Sale ","eco"]}`
+		resp, err := http.Post(ts.URL+"/products", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", resp.StatusCode)
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if strings.Join(created.Tags, ",") != "sale,eco" {
+			t.Fatalf("unexpected tags: %v", created.Tags)
+		}
+	})
+
+	productURL := ts.URL + "/products/" + strconv.FormatInt(created.Id, 10)
+
+	t.Run("add tag returns updated product", func(t *testing.T) {
+		resp, err := http.Post(productURL+"/tags", "application/json", strings.NewReader(`{"tag":"new"}`))
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		var p appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if strings.Join(p.Tags, ",") != "sale,eco,new" {
+			t.Fatalf("unexpected tags: %v", p.Tags)
+		}
+	})
+
+	t.Run("remove tag is case-insensitive", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodDelete, productURL+"/tags/SALE", nil)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http delete: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		var p appshttp.Product
+		if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if strings.Join(p.Tags, ",") != "eco,new" {
+			t.Fatalf("unexpected tags: %v", p.Tags)
+		}
+	})
+
+	t.Run("adding beyond the limit returns 400", func(t *testing.T) {
+		for _, tag := range []string{"a", "b", "c", "d"} {
+			resp, err := http.Post(productURL+"/tags", "application/json", strings.NewReader(`{"tag":"`+tag+`"}`))
+			if err != nil {
+				t.Fatalf("http post: %v", err)
+			}
+			resp.Body.Close()
+			if tag != "d" && resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected 200 for tag %q, got %d", tag, resp.StatusCode)
+			}
+			if tag == "d" && resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("expected 400 once limit is reached, got %d", resp.StatusCode)
+			}
+		}
+	})
+
+	t.Run("add tag on missing product returns 404", func(t *testing.T) {
+		resp, err := http.Post(ts.URL+"/products/9999/tags", "application/json", strings.NewReader(`{"tag":"x"}`))
+		if err != nil {
+			t.Fatalf("http post: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404, got %d", resp.StatusCode)
+		}
+	})
+}
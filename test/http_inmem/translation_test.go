@@ -0,0 +1,170 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestProductTranslations_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	do := func(t *testing.T, method, path, contentType, body string, headers map[string]string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		for name, value := range headers {
+			if value != "" {
+				req.Header.Set(name, value)
+			}
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(resp.Body)
+		return resp, raw
+	}
+	get := func(t *testing.T, id int64, acceptLanguage string) (*http.Response, appshttp.Product) {
+		t.Helper()
+		resp, raw := do(t, http.MethodGet, fmt.Sprintf("/products/%d", id), "", "", map[string]string{"Accept-Language": acceptLanguage})
+		var p appshttp.Product
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &p) != nil {
+			t.Fatalf("get %d: %d %s", id, resp.StatusCode, raw)
+		}
+		return resp, p
+	}
+	put := func(t *testing.T, id int64, locale, body string) (*http.Response, []byte) {
+		t.Helper()
+		return do(t, http.MethodPut, fmt.Sprintf("/products/%d/translations/%s", id, locale), "application/json", body, nil)
+	}
+
+	resp, raw := do(t, http.MethodPost, "/products", "application/json", `{"name":"Garden Chair","priceCents":4500,"description":"Folding chair for the patio"}`, nil)
+	var chair appshttp.Product
+	if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &chair) != nil {
+		t.Fatalf("create: %d %s", resp.StatusCode, raw)
+	}
+	if chair.Description != "Folding chair for the patio" || chair.Locale != "en" {
+		t.Fatalf("unexpected product: %+v", chair)
+	}
+
+	t.Run("put creates then replaces", func(t *testing.T) {
+		resp, raw := put(t, chair.Id, "de", `{"name":"Gartenstuhl","description":"Klappstuhl"}`)
+		var tr appshttp.ProductTranslation
+		if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &tr) != nil || tr.Locale != "de" || tr.Name != "Gartenstuhl" {
+			t.Fatalf("expected 201, got %d %s", resp.StatusCode, raw)
+		}
+		if resp, raw := put(t, chair.Id, "DE", `{"name":"Gartenstuhl","description":"Klappstuhl für die Terrasse"}`); resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200 for an existing locale, got %d %s", resp.StatusCode, raw)
+		}
+		if resp, raw := put(t, chair.Id, "fr", `{"name":"Chaise de jardin"}`); resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected 201, got %d %s", resp.StatusCode, raw)
+		}
+
+		resp, raw = do(t, http.MethodGet, fmt.Sprintf("/products/%d/translations", chair.Id), "", "", nil)
+		var list appshttp.ProductTranslationList
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &list) != nil {
+			t.Fatalf("list: %d %s", resp.StatusCode, raw)
+		}
+		if len(list.Items) != 2 || list.Items[0].Locale != "de" || list.Items[0].Description != "Klappstuhl für die Terrasse" || list.Items[1].Locale != "fr" {
+			t.Fatalf("unexpected translations: %+v", list.Items)
+		}
+	})
+
+	t.Run("reads pick a locale from Accept-Language", func(t *testing.T) {
+		resp, p := get(t, chair.Id, "de-CH, fr;q=0.5")
+		if p.Locale != "de" || p.Name != "Gartenstuhl" || p.Description != "Klappstuhl für die Terrasse" {
+			t.Fatalf("expected the de fallback for de-CH, got %+v", p)
+		}
+		if !strings.Contains(resp.Header.Get("Vary"), "Accept-Language") {
+			t.Fatalf("expected Vary: Accept-Language, got %v", resp.Header)
+		}
+		if _, p := get(t, chair.Id, "it, fr;q=0.8, de;q=0.2"); p.Locale != "fr" || p.Name != "Chaise de jardin" || p.Description != "" {
+			t.Fatalf("expected fr, got %+v", p)
+		}
+		// en ends the chain even when a translation follows it
+		if _, p := get(t, chair.Id, "en-GB, de;q=0.5"); p.Locale != "en" || p.Name != "Garden Chair" {
+			t.Fatalf("expected the product's own text, got %+v", p)
+		}
+		if _, p := get(t, chair.Id, ""); p.Locale != "en" || p.Name != "Garden Chair" {
+			t.Fatalf("expected the product's own text, got %+v", p)
+		}
+
+		deResp, _ := get(t, chair.Id, "de")
+		frResp, _ := get(t, chair.Id, "fr")
+		if deResp.Header.Get("ETag") == frResp.Header.Get("ETag") {
+			t.Fatalf("expected locale-specific ETags, both were %s", deResp.Header.Get("ETag"))
+		}
+	})
+
+	t.Run("search matches translated names", func(t *testing.T) {
+		resp, raw := do(t, http.MethodGet, "/products/search?q=gartenstuhl&includeUnpublished=true", "", "", map[string]string{"Accept-Language": "de"})
+		var list appshttp.ProductList
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &list) != nil {
+			t.Fatalf("search: %d %s", resp.StatusCode, raw)
+		}
+		if len(list.Items) != 1 || list.Items[0].Id != chair.Id || list.Items[0].Locale != "de" {
+			t.Fatalf("expected the chair in de, got %s", raw)
+		}
+		if !strings.Contains(resp.Header.Get("Vary"), "Accept-Language") {
+			t.Fatalf("expected Vary: Accept-Language, got %v", resp.Header)
+		}
+		if _, raw := do(t, http.MethodGet, "/products/search?q=gartenstuhl&includeUnpublished=true", "", "", nil); !strings.Contains(string(raw), `"items":[]`) {
+			t.Fatalf("expected no match without Accept-Language, got %s", raw)
+		}
+	})
+
+	t.Run("writes bump the product version", func(t *testing.T) {
+		_, before := get(t, chair.Id, "")
+		if resp, raw := put(t, chair.Id, "es", `{"name":"Silla de jardín"}`); resp.StatusCode != http.StatusCreated {
+			t.Fatalf("put: %d %s", resp.StatusCode, raw)
+		}
+		_, after := get(t, chair.Id, "")
+		if after.Version <= before.Version {
+			t.Fatalf("expected the version to grow, got %d then %d", before.Version, after.Version)
+		}
+		if resp, raw := do(t, http.MethodDelete, fmt.Sprintf("/products/%d/translations/es", chair.Id), "", "", nil); resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete: %d %s", resp.StatusCode, raw)
+		}
+		if resp, _ := do(t, http.MethodDelete, fmt.Sprintf("/products/%d/translations/es", chair.Id), "", "", nil); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404 for a deleted translation, got %d", resp.StatusCode)
+		}
+		if _, p := get(t, chair.Id, "es"); p.Locale != "en" || p.Version <= after.Version {
+			t.Fatalf("expected the product's own text and a new version, got %+v", p)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			id     int64
+			locale string
+			body   string
+			status int
+		}{
+			"default locale":   {chair.Id, "en", `{"name":"Garden Chair"}`, http.StatusBadRequest},
+			"malformed locale": {chair.Id, "d_e!", `{"name":"Gartenstuhl"}`, http.StatusBadRequest},
+			"blank name":       {chair.Id, "it", `{"name":"  "}`, http.StatusBadRequest},
+			"long description": {chair.Id, "it", fmt.Sprintf(`{"name":"Sedia","description":%q}`, strings.Repeat("x", 2001)), http.StatusBadRequest},
+			"missing product":  {999999, "it", `{"name":"Sedia"}`, http.StatusNotFound},
+		} {
+			if resp, raw := put(t, tc.id, tc.locale, tc.body); resp.StatusCode != tc.status {
+				t.Fatalf("%s: expected %d, got %d %s", name, tc.status, resp.StatusCode, raw)
+			}
+		}
+		if resp, _ := do(t, http.MethodGet, "/products/999999/translations", "", "", nil); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404 for a missing product, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -2,7 +2,11 @@ package http_inmem_test
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 
 	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
@@ -12,7 +16,7 @@ import (
 
 func TestGetUserByID_InMem(t *testing.T) {
 	store := appsinmem.NewInMemRepo()
-	ts := testutil.NewHTTPServer(store, store, store)
+	ts := testutil.NewInMemHTTPServer(store)
 	t.Cleanup(ts.Close)
 
 	resp, err := http.Get(ts.URL + "/users/1")
@@ -43,3 +47,258 @@ func TestGetUserByID_InMem(t *testing.T) {
 		t.Fatalf("expected 404, got %d", resp404.StatusCode)
 	}
 }
+
+func TestUserWrites_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	do := func(t *testing.T, method, path, body string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(resp.Body)
+		return resp, raw
+	}
+
+	resp, raw := do(t, http.MethodPost, "/users", `{"name":"  Carol ","email":"carol@example.com"}`)
+	var carol appshttp.User
+	if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &carol) != nil {
+		t.Fatalf("create: %d %s", resp.StatusCode, raw)
+	}
+	if carol.Id == nil || carol.Name != "Carol" || carol.CreatedAt == nil || carol.CreatedAt.IsZero() {
+		t.Fatalf("unexpected user: %+v", carol)
+	}
+
+	t.Run("duplicate emails conflict case-insensitively", func(t *testing.T) {
+		if resp, raw := do(t, http.MethodPost, "/users", `{"name":"Other Alice","email":"ALICE@example.com"}`); resp.StatusCode != http.StatusConflict {
+			t.Fatalf("expected 409, got %d %s", resp.StatusCode, raw)
+		}
+		if resp, raw := do(t, http.MethodPut, fmt.Sprintf("/users/%d", *carol.Id), `{"name":"Carol","email":"Bob@Example.com"}`); resp.StatusCode != http.StatusConflict {
+			t.Fatalf("expected 409, got %d %s", resp.StatusCode, raw)
+		}
+	})
+
+	t.Run("update replaces name and email", func(t *testing.T) {
+		// keeping the own email, in another case, is not a conflict
+		resp, raw := do(t, http.MethodPut, fmt.Sprintf("/users/%d", *carol.Id), `{"name":"Carol King","email":"Carol@example.com"}`)
+		var updated appshttp.User
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &updated) != nil {
+			t.Fatalf("update: %d %s", resp.StatusCode, raw)
+		}
+		if updated.Name != "Carol King" || updated.Email != "Carol@example.com" || !updated.CreatedAt.Equal(*carol.CreatedAt) {
+			t.Fatalf("unexpected user: %+v", updated)
+		}
+		resp, raw = do(t, http.MethodGet, fmt.Sprintf("/users/%d", *carol.Id), "")
+		if resp.StatusCode != http.StatusOK || !strings.Contains(string(raw), `"Carol King"`) {
+			t.Fatalf("get: %d %s", resp.StatusCode, raw)
+		}
+	})
+
+	t.Run("delete frees the email", func(t *testing.T) {
+		if resp, raw := do(t, http.MethodDelete, fmt.Sprintf("/users/%d", *carol.Id), ""); resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("delete: %d %s", resp.StatusCode, raw)
+		}
+		if resp, _ := do(t, http.MethodGet, fmt.Sprintf("/users/%d", *carol.Id), ""); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404 after delete, got %d", resp.StatusCode)
+		}
+		if resp, raw := do(t, http.MethodPost, "/users", `{"name":"Carol","email":"carol@example.com"}`); resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected the email to be free again, got %d %s", resp.StatusCode, raw)
+		}
+	})
+
+	t.Run("users with orders are kept", func(t *testing.T) {
+		resp, raw := do(t, http.MethodPost, "/users", `{"name":"Dave","email":"dave@example.com"}`)
+		var dave appshttp.User
+		if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &dave) != nil {
+			t.Fatalf("create: %d %s", resp.StatusCode, raw)
+		}
+		store.RecordOrder(*dave.Id, "Blue Widget")
+		if resp, raw := do(t, http.MethodDelete, fmt.Sprintf("/users/%d", *dave.Id), ""); resp.StatusCode != http.StatusConflict {
+			t.Fatalf("expected 409 for a user with orders, got %d %s", resp.StatusCode, raw)
+		}
+		if resp, raw := do(t, http.MethodGet, fmt.Sprintf("/users/%d", *dave.Id), ""); resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected the user to survive, got %d %s", resp.StatusCode, raw)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			method, path, body string
+			status             int
+		}{
+			{http.MethodPost, "/users", `{"name":" ","email":"dave@example.com"}`, http.StatusBadRequest},
+			{http.MethodPost, "/users", `{"name":"Dave","email":"not-an-email"}`, http.StatusBadRequest},
+			{http.MethodPost, "/users", `{"name":"Dave"}`, http.StatusBadRequest},
+			{http.MethodPut, "/users/9999", `{"name":"Dave","email":"dave@example.com"}`, http.StatusNotFound},
+			{http.MethodPut, "/users/0", `{"name":"Dave","email":"dave@example.com"}`, http.StatusBadRequest},
+			{http.MethodDelete, "/users/9999", "", http.StatusNotFound},
+			{http.MethodDelete, "/users/0", "", http.StatusBadRequest},
+		} {
+			if resp, raw := do(t, tc.method, tc.path, tc.body); resp.StatusCode != tc.status {
+				t.Fatalf("%s %s %s: expected %d, got %d %s", tc.method, tc.path, tc.body, tc.status, resp.StatusCode, raw)
+			}
+		}
+	})
+}
+
+func TestListUsers_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	for _, body := range []string{
+		`{"name":"carol","email":"Carol@Example.org"}`,
+		`{"name":"Dave","email":"dave@example.net"}`,
+		`{"name":"Erin 100%","email":"erin@support.example.com"}`,
+	} {
+		resp, err := http.Post(ts.URL+"/users", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("create %s: %d", body, resp.StatusCode)
+		}
+	}
+
+	list := func(t *testing.T, query string) (int, appshttp.UserList) {
+		t.Helper()
+		resp, err := http.Get(ts.URL + "/users?" + query)
+		if err != nil {
+			t.Fatalf("list users: %v", err)
+		}
+		defer resp.Body.Close()
+		var out appshttp.UserList
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+				t.Fatalf("decode users: %v", err)
+			}
+		}
+		return resp.StatusCode, out
+	}
+	names := func(list appshttp.UserList) string {
+		out := make([]string, len(list.Items))
+		for i, u := range list.Items {
+			out[i] = u.Name
+		}
+		return strings.Join(out, ",")
+	}
+
+	for query, want := range map[string]string{
+		"":                               "Alice,Bob,carol,Dave,Erin 100%",
+		"q=EXAMPLE.COM":                  "Alice,Bob,Erin 100%",
+		"q=aRo":                          "carol",
+		"q=100%25":                       "Erin 100%",
+		"q=%25":                          "Erin 100%",
+		"q=_":                            "",
+		"sort=name":                      "Alice,Bob,carol,Dave,Erin 100%",
+		"sort=name&order=desc":           "Erin 100%,Dave,carol,Bob,Alice",
+		"sort=email&order=desc":          "Erin 100%,Dave,carol,Bob,Alice",
+		"sort=createdAt&order=desc":      "Erin 100%,Dave,carol,Bob,Alice",
+		"createdTo=2024-01-11T00:00:00Z": "Alice",
+		"createdFrom=2024-01-11T09:30:00Z&createdTo=2025-01-01T00:00:00Z": "Bob",
+		"q=example&createdFrom=2024-01-11T09:30:00Z&sort=name&order=desc": "Erin 100%,Dave,carol,Bob",
+	} {
+		status, got := list(t, query)
+		if status != http.StatusOK || names(got) != want {
+			t.Fatalf("%q: expected [%s], got %d [%s]", query, want, status, names(got))
+		}
+	}
+
+	t.Run("pagination", func(t *testing.T) {
+		status, page := list(t, "sort=name&page=2&pageSize=2")
+		if status != http.StatusOK || names(page) != "carol,Dave" || page.Page != 2 || page.PageSize != 2 || page.Total == nil || *page.Total != 5 {
+			t.Fatalf("unexpected page: %d %+v", status, page)
+		}
+		if _, page := list(t, "page=4&pageSize=2"); len(page.Items) != 0 || page.Total == nil || *page.Total != 5 {
+			t.Fatalf("expected an empty page past the end, got %+v", page)
+		}
+		if _, page := list(t, "q=example&includeTotal=false"); page.Total != nil || len(page.Items) != 5 {
+			t.Fatalf("expected no total, got %+v", page)
+		}
+	})
+
+	t.Run("cursor walks every page", func(t *testing.T) {
+		// a second carol ties on the name sort, so the id tie-breaker has to carry the cursor
+		resp, err := http.Post(ts.URL+"/users", "application/json", strings.NewReader(`{"name":"CAROL","email":"carol@example.com"}`))
+		if err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("create: %d", resp.StatusCode)
+		}
+		ids := func(list appshttp.UserList) []string {
+			out := make([]string, len(list.Items))
+			for i, u := range list.Items {
+				out[i] = fmt.Sprint(*u.Id)
+			}
+			return out
+		}
+
+		for _, sort := range []string{"id", "name", "email", "createdAt"} {
+			for _, order := range []string{"asc", "desc"} {
+				base := "sort=" + sort + "&order=" + order
+				_, full := list(t, base+"&pageSize=100")
+				if len(full.Items) != 6 || full.NextCursor != nil {
+					t.Fatalf("%s: unexpected single page %+v", base, full)
+				}
+
+				var walked []string
+				query := base + "&pageSize=3"
+				for pages := 0; ; pages++ {
+					if pages > len(full.Items) {
+						t.Fatalf("%s: cursor never ran out", base)
+					}
+					status, page := list(t, query)
+					if status != http.StatusOK || page.Total == nil || *page.Total != 6 {
+						t.Fatalf("%s: unexpected page %d %+v", base, status, page)
+					}
+					walked = append(walked, ids(page)...)
+					if page.NextCursor == nil {
+						break
+					}
+					query = base + "&pageSize=3&cursor=" + url.QueryEscape(*page.NextCursor)
+				}
+				if got, want := strings.Join(walked, ","), strings.Join(ids(full), ","); got != want {
+					t.Fatalf("%s: walked [%s], expected [%s]", base, got, want)
+				}
+			}
+		}
+
+		_, first := list(t, "sort=name&pageSize=2")
+		if first.NextCursor == nil {
+			t.Fatalf("expected a next cursor, got %+v", first)
+		}
+		for _, query := range []string{
+			"sort=email&cursor=" + url.QueryEscape(*first.NextCursor),
+			"sort=name&order=desc&cursor=" + url.QueryEscape(*first.NextCursor),
+			"cursor=not-a-cursor",
+		} {
+			if status, _ := list(t, query); status != http.StatusBadRequest {
+				t.Fatalf("%q: expected 400, got %d", query, status)
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, query := range []string{
+			"createdFrom=2024-02-01T00:00:00Z&createdTo=2024-01-01T00:00:00Z",
+			"createdFrom=yesterday",
+			"sort=password",
+			"pageSize=101",
+		} {
+			if status, _ := list(t, query); status != http.StatusBadRequest {
+				t.Fatalf("%q: expected 400, got %d", query, status)
+			}
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package http_inmem_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	"github.com/fightingBald/GoTuto/internal/testutil"
+)
+
+func TestProductVariants_InMem(t *testing.T) {
+	store := appsinmem.NewInMemRepo()
+	ts := testutil.NewInMemHTTPServer(store)
+	t.Cleanup(ts.Close)
+
+	do := func(t *testing.T, method, path, ifMatch, body string) (*http.Response, []byte) {
+		t.Helper()
+		req, _ := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", method, path, err)
+		}
+		defer resp.Body.Close()
+		raw, _ := io.ReadAll(resp.Body)
+		return resp, raw
+	}
+
+	resp, raw := do(t, http.MethodPost, "/products/1/variants", "", `{"sku":" bw-s-blue ","attributes":{"Size":"S","color":" blue "}}`)
+	var small appshttp.Variant
+	if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &small) != nil {
+		t.Fatalf("create: %d %s", resp.StatusCode, raw)
+	}
+	if small.Sku != "BW-S-BLUE" || small.Attributes["size"] != "S" || small.Attributes["color"] != "blue" || small.PriceCents != nil || resp.Header.Get("ETag") != `"1"` {
+		t.Fatalf("expected a normalized variant at the product's price, got %+v", small)
+	}
+
+	t.Run("per-variant price", func(t *testing.T) {
+		resp, raw := do(t, http.MethodPost, "/products/1/variants", "", `{"sku":"BW-XL-BLUE","attributes":{"size":"XL","color":"blue"},"priceCents":2499}`)
+		var xl appshttp.Variant
+		if resp.StatusCode != http.StatusCreated || json.Unmarshal(raw, &xl) != nil || xl.PriceCents == nil || *xl.PriceCents != 2499 {
+			t.Fatalf("unexpected variant: %d %s", resp.StatusCode, raw)
+		}
+		resp, raw = do(t, http.MethodGet, "/products/1/variants", "", "")
+		var list appshttp.VariantList
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &list) != nil || len(list.Items) != 2 || list.Items[0].Id != small.Id {
+			t.Fatalf("unexpected list: %d %s", resp.StatusCode, raw)
+		}
+	})
+
+	t.Run("skus and attribute sets are unique", func(t *testing.T) {
+		for _, c := range []struct {
+			path, body string
+			status     int
+		}{
+			{"/products/1/variants", `{"sku":"bw-s-blue","attributes":{"size":"M"}}`, http.StatusConflict},
+			{"/products/2/variants", `{"sku":"BW-S-BLUE"}`, http.StatusConflict},
+			{"/products/1/variants", `{"sku":"BW-S-BLUE-2","attributes":{"color":"blue","size":"S"}}`, http.StatusConflict},
+			{"/products/2/variants", `{"sku":"RG-S","attributes":{"size":"S"}}`, http.StatusCreated},
+			{"/products/1/variants", `{"sku":"no spaces"}`, http.StatusBadRequest},
+			{"/products/1/variants", `{"sku":"BW-NEG","priceCents":-1}`, http.StatusBadRequest},
+			{"/products/99/variants", `{"sku":"NOPE"}`, http.StatusNotFound},
+		} {
+			if resp, raw := do(t, http.MethodPost, c.path, "", c.body); resp.StatusCode != c.status {
+				t.Fatalf("POST %s %s: expected %d, got %d %s", c.path, c.body, c.status, resp.StatusCode, raw)
+			}
+		}
+	})
+
+	t.Run("variants are addressed through their product", func(t *testing.T) {
+		if resp, _ := do(t, http.MethodGet, "/products/2/variants/1", "", ""); resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404 for another product's variant, got %d", resp.StatusCode)
+		}
+		resp, raw := do(t, http.MethodGet, "/products/1/variants/1", "", "")
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") != `"1"` {
+			t.Fatalf("unexpected get: %d %s", resp.StatusCode, raw)
+		}
+	})
+
+	t.Run("replace and delete honour If-Match", func(t *testing.T) {
+		resp, raw := do(t, http.MethodPut, "/products/1/variants/1", `"1"`, `{"sku":"BW-S-BLUE","attributes":{"size":"S","color":"navy"},"priceCents":1899}`)
+		var updated appshttp.Variant
+		if resp.StatusCode != http.StatusOK || json.Unmarshal(raw, &updated) != nil || updated.Version != 2 || updated.Attributes["color"] != "navy" || *updated.PriceCents != 1899 {
+			t.Fatalf("unexpected update: %d %s", resp.StatusCode, raw)
+		}
+		if resp, _ := do(t, http.MethodPut, "/products/1/variants/1", `"1"`, `{"sku":"BW-S-BLUE"}`); resp.StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("expected 412 for a stale version, got %d", resp.StatusCode)
+		}
+		if resp, _ := do(t, http.MethodDelete, "/products/1/variants/1", `"1"`, ""); resp.StatusCode != http.StatusPreconditionFailed {
+			t.Fatalf("expected 412 for a stale delete, got %d", resp.StatusCode)
+		}
+		if resp, _ := do(t, http.MethodDelete, "/products/1/variants/1", `"2"`, ""); resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("expected 204, got %d", resp.StatusCode)
+		}
+		// the SKU is free again once its variant is gone
+		if resp, raw := do(t, http.MethodPost, "/products/2/variants", "", `{"sku":"BW-S-BLUE","attributes":{"size":"M"}}`); resp.StatusCode != http.StatusCreated {
+			t.Fatalf("expected the SKU to be reusable, got %d %s", resp.StatusCode, raw)
+		}
+	})
+}
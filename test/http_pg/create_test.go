@@ -4,17 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
-	appspg "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/postgres"
-	commentapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/comment"
-	productapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/product"
-	userapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/user"
 	"github.com/fightingBald/GoTuto/internal/testutil"
 )
 
@@ -29,20 +24,7 @@ func TestCreateProduct_Postgres(t *testing.T) {
 		testutil.ApplyMigrations(ctx, t, pool)
 	}
 
-	productRepo := appspg.NewProductRepository(pool)
-	userRepo := appspg.NewUserRepository(pool)
-	productSvc := productapp.NewService(productRepo)
-	userSvc := userapp.NewService(userRepo)
-	commentRepo := appspg.NewCommentRepository(pool)
-	commentSvc := commentapp.NewService(commentRepo, productRepo, userRepo)
-	server := appshttp.NewServer(productSvc, userSvc, commentSvc)
-
-	h, err := appshttp.NewAPIHandler(server, nil)
-	if err != nil {
-		t.Fatalf("new api handler: %v", err)
-	}
-
-	ts := httptest.NewServer(h)
+	ts := newPGServer(t, pool)
 	defer ts.Close()
 
 	var created appshttp.Product
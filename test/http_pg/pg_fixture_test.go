@@ -3,11 +3,25 @@ package http_pg_test
 import (
 	"context"
 	"log"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
+	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
+	appsinmem "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/inmem"
+	appspg "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/postgres"
+	categoryapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/category"
+	commentapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/comment"
+	imageapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/image"
+	inventoryapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/inventory"
+	productapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/product"
+	recommendationapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/recommendation"
+	translationapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/translation"
+	userapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/user"
+	variantapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/variant"
 	"github.com/fightingBald/GoTuto/internal/testutil"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var (
@@ -33,3 +47,31 @@ func TestMain(m *testing.M) {
 	}
 	os.Exit(code)
 }
+
+// newPGServer wires every service to the Postgres repositories behind pool, the way main does,
+// and serves the API from an httptest server. Image content stays in memory.
+func newPGServer(t *testing.T, pool *pgxpool.Pool) *httptest.Server {
+	t.Helper()
+	productRepo := appspg.NewProductRepository(pool)
+	userRepo := appspg.NewUserRepository(pool)
+	categoryRepo := appspg.NewCategoryRepository(pool)
+	translationRepo := appspg.NewTranslationRepository(pool)
+	variantRepo := appspg.NewVariantRepository(pool)
+	blobs := appsinmem.NewBlobStore()
+	server := appshttp.NewServer(
+		productapp.NewService(productRepo, categoryRepo, translationRepo, blobs, testutil.NewExchangeRates()),
+		userapp.NewService(userRepo),
+		commentapp.NewService(appspg.NewCommentRepository(pool), productRepo, userRepo),
+		categoryapp.NewService(categoryRepo),
+		variantapp.NewService(variantRepo, productRepo),
+		inventoryapp.NewService(appspg.NewInventoryRepository(pool), productRepo, variantRepo),
+		imageapp.NewService(appspg.NewImageRepository(pool), blobs, productRepo),
+		recommendationapp.NewService(appspg.NewRecommendationSource(pool), productRepo),
+		translationapp.NewService(translationRepo, productRepo),
+	)
+	h, err := appshttp.NewAPIHandler(server, nil)
+	if err != nil {
+		t.Fatalf("new api handler: %v", err)
+	}
+	return httptest.NewServer(h)
+}
@@ -4,15 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"net/http/httptest"
 	"testing"
 	"time"
 
 	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
-	appspg "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/postgres"
-	commentapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/comment"
-	productapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/product"
-	userapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/user"
 	"github.com/fightingBald/GoTuto/internal/testutil"
 )
 
@@ -28,20 +23,7 @@ func TestSearchProducts_Postgres(t *testing.T) {
 		testutil.ApplyMigrations(ctx, t, pool)
 	}
 
-	productRepo := appspg.NewProductRepository(pool)
-	userRepo := appspg.NewUserRepository(pool)
-	productSvc := productapp.NewService(productRepo)
-	userSvc := userapp.NewService(userRepo)
-	commentRepo := appspg.NewCommentRepository(pool)
-	commentSvc := commentapp.NewService(commentRepo, productRepo, userRepo)
-	server := appshttp.NewServer(productSvc, userSvc, commentSvc)
-
-	h, err := appshttp.NewAPIHandler(server, nil)
-	if err != nil {
-		t.Fatalf("new api handler: %v", err)
-	}
-
-	ts := httptest.NewServer(h)
+	ts := newPGServer(t, pool)
 	defer ts.Close()
 
 	t.Run("search wid returns 200", func(t *testing.T) {
@@ -61,8 +43,8 @@ func TestSearchProducts_Postgres(t *testing.T) {
 		if err := json.NewDecoder(resp.Body).Decode(&pl); err != nil {
 			t.Fatalf("decode: %v", err)
 		}
-		if pl.Total < len(pl.Items) {
-			t.Fatalf("expected total >= items length; got total=%d items=%d", pl.Total, len(pl.Items))
+		if pl.Total == nil || *pl.Total < len(pl.Items) {
+			t.Fatalf("expected total >= items length; got total=%v items=%d", pl.Total, len(pl.Items))
 		}
 	})
 }
@@ -5,17 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
-	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	appshttp "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/inbound/http"
-	appspg "github.com/fightingBald/GoTuto/apps/product-query-svc/adapters/outbound/postgres"
-	commentapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/comment"
-	productapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/product"
-	userapp "github.com/fightingBald/GoTuto/apps/product-query-svc/application/user"
 	"github.com/fightingBald/GoTuto/internal/testutil"
 	"github.com/jackc/pgconn"
 )
@@ -51,20 +46,7 @@ func TestGetUserByID_Postgres(t *testing.T) {
 		_, _ = pool.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
 	})
 
-	productRepo := appspg.NewProductRepository(pool)
-	userRepo := appspg.NewUserRepository(pool)
-	productSvc := productapp.NewService(productRepo)
-	userSvc := userapp.NewService(userRepo)
-	commentRepo := appspg.NewCommentRepository(pool)
-	commentSvc := commentapp.NewService(commentRepo, productRepo, userRepo)
-	server := appshttp.NewServer(productSvc, userSvc, commentSvc)
-
-	h, err := appshttp.NewAPIHandler(server, nil)
-	if err != nil {
-		t.Fatalf("new api handler: %v", err)
-	}
-
-	ts := httptest.NewServer(h)
+	ts := newPGServer(t, pool)
 	defer ts.Close()
 
 	resp, err := http.Get(ts.URL + "/users/" + strconv.FormatInt(userID, 10))